@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/sink"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var archiveCmd = &cobra.Command{
+	Use:   "archive",
+	Short: "Archive expired promises to files and delete them from the store",
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := bindStoreFlags(cmd.Flags()); err != nil {
+			return err
+		}
+
+		for _, binding := range [][2]string{
+			{"aio.subsystems.sink.config.dir", "aio-sink-dir"},
+			{"aio.subsystems.sink.config.format", "aio-sink-format"},
+		} {
+			if err := viper.BindPFlag(binding[0], cmd.Flags().Lookup(binding[1])); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		retention, err := cmd.Flags().GetDuration("retention")
+		if err != nil {
+			return err
+		}
+
+		batchSize, err := cmd.Flags().GetInt("batch-size")
+		if err != nil {
+			return err
+		}
+		if batchSize < 1 {
+			return fmt.Errorf("batch-size must be greater than zero")
+		}
+
+		config, err := NewConfig()
+		if err != nil {
+			return err
+		}
+
+		return withStore(func(store aio.Subsystem) error {
+			sink := sink.New(config.AIO.Subsystems.Sink.Config)
+			if err := sink.Start(); err != nil {
+				return err
+			}
+
+			if err := archive(store.NewWorker(0), sink.NewWorker(0), time.Now().UnixMilli(), t_aio.DefaultRetention(retention), batchSize); err != nil {
+				_ = sink.Stop()
+				return err
+			}
+
+			return sink.Stop()
+		})
+	},
+}
+
+// archive writes expired promises to the sink in batches and deletes
+// each batch from the store once written, until no expired promises
+// remain. Promises with pending or dead notifications do not expire,
+// so that notifications are never lost.
+func archive(store aio.Worker, sink aio.Worker, time int64, retention *int64, batchSize int) error {
+	var total int
+
+	for {
+		completion, err := process(store, &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.ReadExpiredPromises,
+							ReadExpiredPromises: &t_aio.ReadExpiredPromisesCommand{
+								Time:      time,
+								Retention: retention,
+								Limit:     batchSize,
+							},
+						},
+					},
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		records := completion.Store.Results[0].ReadExpiredPromises.Records
+		if len(records) == 0 {
+			break
+		}
+
+		completion, err = process(sink, &t_aio.Submission{
+			Kind: t_aio.Sink,
+			Sink: &t_aio.SinkSubmission{
+				Records: records,
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		path := completion.Sink.Path
+
+		completion, err = process(store, &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: deletePromises(records),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete promises archived to %s: %w", path, err)
+		}
+
+		// promises that received notifications since they were read are
+		// kept, along with their notifications, and archived again once
+		// the notifications are delivered or deleted
+		var deleted int
+		for _, result := range completion.Store.Results {
+			deleted += int(result.DeletePromise.RowsAffected)
+		}
+		if kept := len(records) - deleted; kept > 0 {
+			fmt.Printf("kept %d promises with pending or dead notifications archived to %s\n", kept, path)
+		}
+
+		fmt.Printf("archived %d promises to %s\n", deleted, path)
+		total += deleted
+	}
+
+	fmt.Printf("archived %d promises\n", total)
+	return nil
+}
+
+func deletePromises(records []*promise.PromiseRecord) []*t_aio.Command {
+	commands := make([]*t_aio.Command, len(records))
+	for i, record := range records {
+		commands[i] = &t_aio.Command{
+			Kind: t_aio.DeletePromise,
+			DeletePromise: &t_aio.DeletePromiseCommand{
+				Id: record.Id,
+			},
+		}
+	}
+
+	return commands
+}
+
+func process(worker aio.Worker, submission *t_aio.Submission) (*t_aio.Completion, error) {
+	cqes := worker.Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{
+		{Submission: submission},
+	})
+
+	if cqes[0].Error != nil {
+		return nil, cqes[0].Error
+	}
+
+	return cqes[0].Completion, nil
+}
+
+func init() {
+	archiveCmd.Flags().Duration("retention", 0, "time to keep completed promises, zero keeps promises without a resonate:retention tag forever")
+	archiveCmd.Flags().Int("batch-size", 100, "max number of promises written to each archive file")
+	archiveCmd.Flags().String("aio-sink-dir", "archive", "directory archived promises are written to")
+	archiveCmd.Flags().String("aio-sink-format", "ndjson", "archive file format")
+	addStoreFlags(archiveCmd.Flags())
+
+	archiveCmd.Flags().SortFlags = false
+	rootCmd.AddCommand(archiveCmd)
+}
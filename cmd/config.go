@@ -8,6 +8,9 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/network"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/sink"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/bolt"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/memory"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/postgres"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/sqlite"
 	"github.com/resonatehq/resonate/internal/app/subsystems/api/grpc"
@@ -45,6 +48,7 @@ type AIOSubsystems struct {
 	Store      *AIOSubsystemConfig[StoreConfig]
 	Network    *AIOSubsystemConfig[network.Config]
 	NetworkDST *AIOSubsystemConfig[network.ConfigDST]
+	Sink       *AIOSubsystemConfig[sink.Config]
 }
 
 type AIOSubsystemConfig[T any] struct {
@@ -126,12 +130,15 @@ type StoreKind string
 const (
 	Sqlite   StoreKind = "sqlite"
 	Postgres StoreKind = "postgres"
+	Memory   StoreKind = "memory"
+	Bolt     StoreKind = "bolt"
 )
 
 type StoreConfig struct {
 	Kind     StoreKind
 	Sqlite   *sqlite.Config
 	Postgres *postgres.Config
+	Bolt     *bolt.Config
 }
 
 func NewConfig() (*Config, error) {
@@ -166,6 +173,10 @@ func NewStore(config *AIOSubsystemConfig[StoreConfig]) (aio.Subsystem, error) {
 		return sqlite.New(config.Config.Sqlite)
 	case Postgres:
 		return postgres.New(config.Config.Postgres, config.Subsystem.Workers)
+	case Memory:
+		return memory.New()
+	case Bolt:
+		return bolt.New(config.Config.Bolt)
 	default:
 		return nil, fmt.Errorf("unsupported store '%s'", config.Config.Kind)
 	}
@@ -17,6 +17,7 @@ import (
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/api"
 	"github.com/resonatehq/resonate/internal/app/coroutines"
+	"github.com/resonatehq/resonate/internal/app/events"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/network"
 	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
@@ -132,6 +133,9 @@ var dstRunCmd = &cobra.Command{
 		api := api.New(config.API.Size, metrics)
 		aio := aio.NewDST(r, metrics)
 
+		// instantiate events, there are no in-process listeners in dst
+		config.System.Events = events.New()
+
 		// instatiate aio subsystems
 		network := network.NewDST(config.AIO.Subsystems.NetworkDST.Config, rand.New(rand.NewSource(r.Int63())))
 		store, err := NewStore(config.AIO.Subsystems.Store)
@@ -273,6 +277,8 @@ func init() {
 	dstRunCmd.Flags().String("aio-store-postgres-password", "", "postgres password")
 	dstRunCmd.Flags().String("aio-store-postgres-database", "resonate_dst", "postgres database name")
 	dstRunCmd.Flags().Duration("aio-store-postgres-tx-timeout", 2*time.Second, "postgres transaction timeout")
+	dstRunCmd.Flags().String("aio-store-bolt-path", "resonate_dst.bolt", "bolt database path")
+	dstRunCmd.Flags().Duration("aio-store-bolt-lock-timeout", 1*time.Second, "bolt database file lock timeout")
 	dstRunCmd.Flags().Float32("aio-network-success-rate", 0.5, "simulated success rate of http requests")
 
 	_ = viper.BindPFlag("dst.aio.size", dstRunCmd.Flags().Lookup("aio-size"))
@@ -286,6 +292,8 @@ func init() {
 	_ = viper.BindPFlag("dst.aio.subsystems.store.config.postgres.password", dstRunCmd.Flags().Lookup("aio-store-postgres-password"))
 	_ = viper.BindPFlag("dst.aio.subsystems.store.config.postgres.database", dstRunCmd.Flags().Lookup("aio-store-postgres-database"))
 	_ = viper.BindPFlag("dst.aio.subsystems.store.config.postgres.txTimeout", dstRunCmd.Flags().Lookup("aio-store-postgres-tx-timeout"))
+	_ = viper.BindPFlag("dst.aio.subsystems.store.config.bolt.path", dstRunCmd.Flags().Lookup("aio-store-bolt-path"))
+	_ = viper.BindPFlag("dst.aio.subsystems.store.config.bolt.lockTimeout", dstRunCmd.Flags().Lookup("aio-store-bolt-lock-timeout"))
 	_ = viper.BindPFlag("dst.aio.subsystems.networkDST.config.p", dstRunCmd.Flags().Lookup("aio-network-success-rate"))
 
 	// system
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/transfer"
+	"github.com/spf13/cobra"
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export promises, subscriptions, and notifications as ndjson",
+	Long: `Export promises, subscriptions, pending and dead notifications, and
+notification attempts as newline delimited json that can be imported
+into any store with resonate import.
+Stop the server before exporting, writes during an export may be missed.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return bindStoreFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+
+		batchSize, err := cmd.Flags().GetInt("batch-size")
+		if err != nil {
+			return err
+		}
+		if batchSize < 1 {
+			return fmt.Errorf("batch-size must be greater than zero")
+		}
+
+		var out io.Writer = os.Stdout
+		if file != "-" {
+			f, err := os.Create(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			out = f
+		}
+
+		return withStore(func(store aio.Subsystem) error {
+			w := bufio.NewWriter(out)
+
+			stats, err := transfer.Export(store.NewWorker(0), w, batchSize)
+			if err != nil {
+				return err
+			}
+
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			// stdout may hold the export, report on stderr
+			fmt.Fprintf(os.Stderr, "exported %s\n", stats)
+			return nil
+		})
+	},
+}
+
+// withStore opens and starts the configured store, calls f, and stops
+// the store.
+func withStore(f func(aio.Subsystem) error) error {
+	config, err := NewConfig()
+	if err != nil {
+		return err
+	}
+
+	store, err := NewStore(config.AIO.Subsystems.Store)
+	if err != nil {
+		return err
+	}
+	if err := store.Start(); err != nil {
+		return err
+	}
+
+	if err := f(store); err != nil {
+		_ = store.Stop()
+		return err
+	}
+
+	return store.Stop()
+}
+
+func init() {
+	exportCmd.Flags().StringP("file", "f", "-", "file to write the export to, - for stdout")
+	exportCmd.Flags().Int("batch-size", 100, "max number of promises read from the store at once")
+	addStoreFlags(exportCmd.Flags())
+
+	exportCmd.Flags().SortFlags = false
+	rootCmd.AddCommand(exportCmd)
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/transfer"
+	"github.com/spf13/cobra"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "Import promises, subscriptions, and notifications from ndjson",
+	Long: `Import an export created with resonate export into a store. The store
+must not contain any promises, the import stops on the first line that
+fails.`,
+	PreRunE: func(cmd *cobra.Command, args []string) error {
+		return bindStoreFlags(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		file, err := cmd.Flags().GetString("file")
+		if err != nil {
+			return err
+		}
+
+		batchSize, err := cmd.Flags().GetInt("batch-size")
+		if err != nil {
+			return err
+		}
+		if batchSize < 1 {
+			return fmt.Errorf("batch-size must be greater than zero")
+		}
+
+		var in io.Reader = os.Stdin
+		if file != "-" {
+			f, err := os.Open(file)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			in = f
+		}
+
+		return withStore(func(store aio.Subsystem) error {
+			stats, err := transfer.Import(store.NewWorker(0), bufio.NewReader(in), batchSize)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("imported %s\n", stats)
+			return nil
+		})
+	},
+}
+
+func init() {
+	importCmd.Flags().StringP("file", "f", "-", "file to read the export from, - for stdin")
+	importCmd.Flags().Int("batch-size", 100, "max number of lines written to the store at once")
+	addStoreFlags(importCmd.Flags())
+
+	importCmd.Flags().SortFlags = false
+	rootCmd.AddCommand(importCmd)
+}
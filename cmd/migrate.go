@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/migrations"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Manage the store schema",
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// the store flags share their config keys with the serve
+		// command, bind them only when a migrate command is run
+		return bindStoreFlags(cmd.Flags())
+	},
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply all pending migrations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(m *migrations.Migrator) error {
+			applied, err := m.Up()
+			if err != nil {
+				return err
+			}
+
+			if len(applied) == 0 {
+				fmt.Println("no pending migrations")
+			}
+			for _, migration := range applied {
+				fmt.Printf("applied %s\n", migration)
+			}
+
+			return nil
+		})
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "Revert the most recently applied migrations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		steps, err := cmd.Flags().GetInt("steps")
+		if err != nil {
+			return err
+		}
+		if steps < 1 {
+			return fmt.Errorf("steps must be greater than zero")
+		}
+
+		force, err := cmd.Flags().GetBool("force")
+		if err != nil {
+			return err
+		}
+
+		return withMigrator(func(m *migrations.Migrator) error {
+			if !force {
+				statuses, err := m.Status()
+				if err != nil {
+					return err
+				}
+
+				// the initial migration creates the tables, reverting it
+				// drops all data
+				n := 0
+				for i := len(statuses) - 1; i >= 0 && n < steps; i-- {
+					if !statuses[i].Applied() {
+						continue
+					}
+					if statuses[i].Migration.Version == 1 {
+						return fmt.Errorf("reverting migration %s drops all tables, use --force to revert it", statuses[i].Migration)
+					}
+					n++
+				}
+			}
+
+			reverted, err := m.Down(steps)
+			if err != nil {
+				return err
+			}
+
+			if len(reverted) == 0 {
+				fmt.Println("no applied migrations")
+			}
+			for _, migration := range reverted {
+				fmt.Printf("reverted %s\n", migration)
+			}
+
+			return nil
+		})
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the status of all migrations",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return withMigrator(func(m *migrations.Migrator) error {
+			statuses, err := m.Status()
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED ON")
+
+			for _, status := range statuses {
+				appliedOn := "pending"
+				if status.Applied() {
+					appliedOn = time.UnixMilli(*status.AppliedOn).UTC().Format(time.RFC3339)
+				}
+
+				fmt.Fprintf(w, "%d\t%s\t%s\n", status.Migration.Version, status.Migration.Name, appliedOn)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func withMigrator(f func(*migrations.Migrator) error) error {
+	config, err := NewConfig()
+	if err != nil {
+		return err
+	}
+
+	store, err := NewStore(config.AIO.Subsystems.Store)
+	if err != nil {
+		return err
+	}
+
+	s, ok := store.(interface {
+		aio.Subsystem
+		Migrator() *migrations.Migrator
+	})
+	if !ok {
+		return fmt.Errorf("store '%s' does not support migrations", config.AIO.Subsystems.Store.Config.Kind)
+	}
+
+	if err := f(s.Migrator()); err != nil {
+		_ = store.Stop()
+		return err
+	}
+
+	return store.Stop()
+}
+
+// addStoreFlags adds the flags needed to open a store outside of the
+// serve command.
+func addStoreFlags(flags *pflag.FlagSet) {
+	flags.String("aio-store", "sqlite", "promise store type")
+	flags.String("aio-store-sqlite-path", "resonate.db", "sqlite database path")
+	flags.Duration("aio-store-sqlite-tx-timeout", 250*time.Millisecond, "sqlite transaction timeout")
+	flags.String("aio-store-postgres-host", "localhost", "postgres host")
+	flags.String("aio-store-postgres-port", "5432", "postgres port")
+	flags.String("aio-store-postgres-username", "", "postgres username")
+	flags.String("aio-store-postgres-password", "", "postgres password")
+	flags.String("aio-store-postgres-database", "resonate", "postgres database name")
+	flags.Duration("aio-store-postgres-tx-timeout", 250*time.Millisecond, "postgres transaction timeout")
+	flags.String("aio-store-bolt-path", "resonate.bolt", "bolt database path")
+	flags.Duration("aio-store-bolt-lock-timeout", 1*time.Second, "bolt database file lock timeout")
+}
+
+func bindStoreFlags(flags *pflag.FlagSet) error {
+	for _, binding := range [][2]string{
+		{"aio.subsystems.store.config.kind", "aio-store"},
+		{"aio.subsystems.store.config.sqlite.path", "aio-store-sqlite-path"},
+		{"aio.subsystems.store.config.sqlite.txTimeout", "aio-store-sqlite-tx-timeout"},
+		{"aio.subsystems.store.config.postgres.host", "aio-store-postgres-host"},
+		{"aio.subsystems.store.config.postgres.port", "aio-store-postgres-port"},
+		{"aio.subsystems.store.config.postgres.username", "aio-store-postgres-username"},
+		{"aio.subsystems.store.config.postgres.password", "aio-store-postgres-password"},
+		{"aio.subsystems.store.config.postgres.database", "aio-store-postgres-database"},
+		{"aio.subsystems.store.config.postgres.txTimeout", "aio-store-postgres-tx-timeout"},
+		{"aio.subsystems.store.config.bolt.path", "aio-store-bolt-path"},
+		{"aio.subsystems.store.config.bolt.lockTimeout", "aio-store-bolt-lock-timeout"},
+	} {
+		if err := viper.BindPFlag(binding[0], flags.Lookup(binding[1])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func init() {
+	addStoreFlags(migrateCmd.PersistentFlags())
+
+	migrateDownCmd.Flags().Int("steps", 1, "number of migrations to revert")
+	migrateDownCmd.Flags().Bool("force", false, "allow reverting the initial migration, which drops all tables")
+
+	migrateCmd.AddCommand(migrateUpCmd)
+	migrateCmd.AddCommand(migrateDownCmd)
+	migrateCmd.AddCommand(migrateStatusCmd)
+	rootCmd.AddCommand(migrateCmd)
+}
@@ -14,13 +14,16 @@ import (
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/api"
 	"github.com/resonatehq/resonate/internal/app/coroutines"
+	"github.com/resonatehq/resonate/internal/app/events"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/network"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/sink"
 	"github.com/resonatehq/resonate/internal/app/subsystems/api/grpc"
 	"github.com/resonatehq/resonate/internal/app/subsystems/api/http"
 	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/metrics"
+	"github.com/resonatehq/resonate/pkg/subscription"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -35,6 +38,12 @@ var serveCmd = &cobra.Command{
 			return err
 		}
 
+		for _, secret := range config.System.NotificationSecrets {
+			if _, err := subscription.ParseSecret(secret); err != nil {
+				return fmt.Errorf("invalid notification secret: %w", err)
+			}
+		}
+
 		// logger
 		logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: config.Log.Level}))
 		slog.SetDefault(logger)
@@ -47,12 +56,16 @@ var serveCmd = &cobra.Command{
 		api := api.New(config.API.Size, metrics)
 		aio := aio.New(config.AIO.Size, metrics)
 
+		// instantiate events, shared by the kernel and the api
+		// subsystems
+		config.System.Events = events.New()
+
 		// instatiate api subsystems
-		http := http.New(api, config.API.Subsystems.Http)
-		grpc := grpc.New(api, config.API.Subsystems.Grpc)
+		http := http.New(api, config.System.Events, config.API.Subsystems.Http)
+		grpc := grpc.New(api, config.System.Events, config.API.Subsystems.Grpc)
 
 		// instatiate aio subsystems
-		network := network.New(config.AIO.Subsystems.Network.Config)
+		network := network.New(config.AIO.Subsystems.Network.Config, metrics)
 		store, err := NewStore(config.AIO.Subsystems.Store)
 		if err != nil {
 			return err
@@ -66,6 +79,13 @@ var serveCmd = &cobra.Command{
 		aio.AddSubsystem(t_aio.Network, network, config.AIO.Subsystems.Network.Subsystem)
 		aio.AddSubsystem(t_aio.Store, store, config.AIO.Subsystems.Store.Subsystem)
 
+		// completed promises are only written to the sink when archiving
+		// is enabled
+		if config.System.Archive {
+			sink := sink.New(config.AIO.Subsystems.Sink.Config)
+			aio.AddSubsystem(t_aio.Sink, sink, config.AIO.Subsystems.Sink.Subsystem)
+		}
+
 		// start api/aio
 		if err := api.Start(); err != nil {
 			slog.Error("failed to start api", "error", err)
@@ -84,11 +104,25 @@ var serveCmd = &cobra.Command{
 		system.AddOnRequest(t_api.ResolvePromise, coroutines.ResolvePromise)
 		system.AddOnRequest(t_api.RejectPromise, coroutines.RejectPromise)
 		system.AddOnRequest(t_api.CancelPromise, coroutines.CancelPromise)
+		system.AddOnRequest(t_api.Batch, coroutines.Batch)
+		system.AddOnRequest(t_api.CompleteAndCreatePromises, coroutines.CompleteAndCreatePromises)
+		system.AddOnRequest(t_api.ReadPromisesByIdempotencyKey, coroutines.ReadPromisesByIdempotencyKey)
 		system.AddOnRequest(t_api.ReadSubscriptions, coroutines.ReadSubscriptions)
 		system.AddOnRequest(t_api.CreateSubscription, coroutines.CreateSubscription)
 		system.AddOnRequest(t_api.DeleteSubscription, coroutines.DeleteSubscription)
+		system.AddOnRequest(t_api.ReadDeadNotifications, coroutines.ReadDeadNotifications)
+		system.AddOnRequest(t_api.ReadDeadNotification, coroutines.ReadDeadNotification)
+		system.AddOnRequest(t_api.ReplayDeadNotification, coroutines.ReplayDeadNotification)
+		system.AddOnRequest(t_api.DeleteDeadNotification, coroutines.DeleteDeadNotification)
+		system.AddOnRequest(t_api.DeleteDeadNotifications, coroutines.DeleteDeadNotifications)
+		system.AddOnRequest(t_api.ReadNotificationAttempts, coroutines.ReadNotificationAttempts)
 		system.AddOnTick(2, coroutines.TimeoutPromises)
 		system.AddOnTick(1, coroutines.NotifySubscriptions)
+		if config.System.Archive {
+			system.AddOnTick(100, coroutines.NewArchivePromises())
+		} else {
+			system.AddOnTick(100, coroutines.DeletePromises)
+		}
 
 		// metrics server
 		mux := netHttp.NewServeMux()
@@ -186,10 +220,20 @@ func init() {
 	serveCmd.Flags().String("aio-store-postgres-password", "", "postgres password")
 	serveCmd.Flags().String("aio-store-postgres-database", "resonate", "postgres database name")
 	serveCmd.Flags().Duration("aio-store-postgres-tx-timeout", 250*time.Millisecond, "postgres transaction timeout")
+	serveCmd.Flags().String("aio-store-bolt-path", "resonate.bolt", "bolt database path")
+	serveCmd.Flags().Duration("aio-store-bolt-lock-timeout", 1*time.Second, "bolt database file lock timeout")
 	serveCmd.Flags().Int("aio-network-size", 100, "size of network submission queue buffered channel")
 	serveCmd.Flags().Int("aio-network-workers", 3, "number of concurrent http requests")
 	serveCmd.Flags().Int("aio-network-batch-size", 100, "max submissions processed each tick by a network worker")
 	serveCmd.Flags().Duration("aio-network-timeout", 10*time.Second, "network request timeout")
+	serveCmd.Flags().Int("aio-network-host-concurrency", 0, "max concurrent http requests per host, zero is unlimited")
+	serveCmd.Flags().Int("aio-network-breaker-failures", 0, "consecutive failures that open the circuit of a host, zero disables circuit breaking")
+	serveCmd.Flags().Duration("aio-network-breaker-timeout", 30*time.Second, "time the circuit of a host stays open before it is probed")
+	serveCmd.Flags().Int("aio-sink-size", 100, "size of sink submission queue buffered channel")
+	serveCmd.Flags().Int("aio-sink-workers", 1, "number of concurrent sink file writers")
+	serveCmd.Flags().Int("aio-sink-batch-size", 100, "max submissions processed each tick by a sink worker")
+	serveCmd.Flags().String("aio-sink-dir", "archive", "directory archived promises are written to")
+	serveCmd.Flags().String("aio-sink-format", "ndjson", "archive file format")
 
 	_ = viper.BindPFlag("aio.size", serveCmd.Flags().Lookup("aio-size"))
 	_ = viper.BindPFlag("aio.subsystems.store.config.kind", serveCmd.Flags().Lookup("aio-store"))
@@ -205,19 +249,39 @@ func init() {
 	_ = viper.BindPFlag("aio.subsystems.store.config.postgres.database", serveCmd.Flags().Lookup("aio-store-postgres-database"))
 	_ = viper.BindPFlag("aio.subsystems.store.config.postgres.database", serveCmd.Flags().Lookup("aio-store-postgres-database"))
 	_ = viper.BindPFlag("aio.subsystems.store.config.postgres.txTimeout", serveCmd.Flags().Lookup("aio-store-postgres-tx-timeout"))
+	_ = viper.BindPFlag("aio.subsystems.store.config.bolt.path", serveCmd.Flags().Lookup("aio-store-bolt-path"))
+	_ = viper.BindPFlag("aio.subsystems.store.config.bolt.lockTimeout", serveCmd.Flags().Lookup("aio-store-bolt-lock-timeout"))
 	_ = viper.BindPFlag("aio.subsystems.network.subsystem.size", serveCmd.Flags().Lookup("aio-network-size"))
 	_ = viper.BindPFlag("aio.subsystems.network.subsystem.workers", serveCmd.Flags().Lookup("aio-network-workers"))
 	_ = viper.BindPFlag("aio.subsystems.network.subsystem.batchSize", serveCmd.Flags().Lookup("aio-network-batch-size"))
 	_ = viper.BindPFlag("aio.subsystems.network.config.timeout", serveCmd.Flags().Lookup("aio-network-timeout"))
+	_ = viper.BindPFlag("aio.subsystems.network.config.hostConcurrency", serveCmd.Flags().Lookup("aio-network-host-concurrency"))
+	_ = viper.BindPFlag("aio.subsystems.network.config.breakerFailures", serveCmd.Flags().Lookup("aio-network-breaker-failures"))
+	_ = viper.BindPFlag("aio.subsystems.network.config.breakerTimeout", serveCmd.Flags().Lookup("aio-network-breaker-timeout"))
+	_ = viper.BindPFlag("aio.subsystems.sink.subsystem.size", serveCmd.Flags().Lookup("aio-sink-size"))
+	_ = viper.BindPFlag("aio.subsystems.sink.subsystem.workers", serveCmd.Flags().Lookup("aio-sink-workers"))
+	_ = viper.BindPFlag("aio.subsystems.sink.subsystem.batchSize", serveCmd.Flags().Lookup("aio-sink-batch-size"))
+	_ = viper.BindPFlag("aio.subsystems.sink.config.dir", serveCmd.Flags().Lookup("aio-sink-dir"))
+	_ = viper.BindPFlag("aio.subsystems.sink.config.format", serveCmd.Flags().Lookup("aio-sink-format"))
 
 	// system
 	serveCmd.Flags().Int("system-notification-cache-size", 100, "max number of notifications to keep in cache")
 	serveCmd.Flags().Int("system-submission-batch-size", 100, "max number of submissions to process on each tick")
 	serveCmd.Flags().Int("system-completion-batch-size", 100, "max number of completions to process on each tick")
+	serveCmd.Flags().Duration("system-retention", 0, "time to keep completed promises, zero keeps promises without a resonate:retention tag forever")
+	serveCmd.Flags().Int("system-retention-batch-size", 100, "max number of completed promises to delete on each retention tick")
+	serveCmd.Flags().Bool("system-archive", false, "archive completed promises to the sink before they are deleted")
+	serveCmd.Flags().Duration("system-idempotency-key-ttl", 0, "time an idempotency key matches after the create or complete that stored it, zero never expires keys")
+	serveCmd.Flags().StringSlice("system-notification-secrets", nil, "whsec_ prefixed secrets used to sign notifications of subscriptions without a secret, each secret adds a signature to allow key rotation")
 
 	_ = viper.BindPFlag("system.notificationCacheSize", serveCmd.Flags().Lookup("system-notification-cache-size"))
 	_ = viper.BindPFlag("system.submissionBatchSize", serveCmd.Flags().Lookup("system-submission-batch-size"))
 	_ = viper.BindPFlag("system.completionBatchSize", serveCmd.Flags().Lookup("system-completion-batch-size"))
+	_ = viper.BindPFlag("system.retention", serveCmd.Flags().Lookup("system-retention"))
+	_ = viper.BindPFlag("system.retentionBatchSize", serveCmd.Flags().Lookup("system-retention-batch-size"))
+	_ = viper.BindPFlag("system.archive", serveCmd.Flags().Lookup("system-archive"))
+	_ = viper.BindPFlag("system.idempotencyKeyTTL", serveCmd.Flags().Lookup("system-idempotency-key-ttl"))
+	_ = viper.BindPFlag("system.notificationSecrets", serveCmd.Flags().Lookup("system-notification-secrets"))
 
 	// metrics
 	serveCmd.Flags().Int("metrics-port", 9090, "prometheus metrics server port")
@@ -35,10 +35,12 @@ type subsystemWrapper struct {
 
 type workerWrapper struct {
 	Worker
+	kind      t_aio.Kind
 	sq        <-chan *bus.SQE[t_aio.Submission, t_aio.Completion]
 	cq        chan<- *bus.CQE[t_aio.Submission, t_aio.Completion]
 	flushCh   chan int64
 	batchSize int
+	metrics   *metrics.Metrics
 }
 
 func New(size int, metrics *metrics.Metrics) *aio {
@@ -57,10 +59,12 @@ func (a *aio) AddSubsystem(kind t_aio.Kind, subsystem Subsystem, config *Subsyst
 	for i := 0; i < config.Workers; i++ {
 		workers[i] = &workerWrapper{
 			Worker:    subsystem.NewWorker(i),
+			kind:      kind,
 			sq:        sq,
 			cq:        a.cq,
 			flushCh:   make(chan int64, 1),
 			batchSize: config.BatchSize,
+			metrics:   a.metrics,
 		}
 	}
 
@@ -173,7 +177,10 @@ func (w *workerWrapper) start() {
 	for {
 		sqes, ok := w.collect()
 		if len(sqes) > 0 {
-			for _, cqe := range w.Process(sqes) {
+			cqes := w.Process(sqes)
+			w.metrics.AioBatchTotal.WithLabelValues(w.kind.String(), batchStatus(cqes)).Inc()
+
+			for _, cqe := range cqes {
 				w.cq <- cqe
 			}
 		}
@@ -209,3 +216,23 @@ func (w *workerWrapper) collect() ([]*bus.SQE[t_aio.Submission, t_aio.Completion
 
 	return sqes, true
 }
+
+// batchStatus returns failure if all completions of a batch failed and
+// partial if only some of the completions failed.
+func batchStatus(cqes []*bus.CQE[t_aio.Submission, t_aio.Completion]) string {
+	failed := 0
+	for _, cqe := range cqes {
+		if cqe.Error != nil {
+			failed++
+		}
+	}
+
+	switch failed {
+	case 0:
+		return "success"
+	case len(cqes):
+		return "failure"
+	default:
+		return "partial"
+	}
+}
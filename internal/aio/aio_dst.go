@@ -93,7 +93,10 @@ func (a *aioDST) Flush(t int64) {
 
 	for _, sqes := range util.OrderedRangeKV(flush) {
 		if subsystem, ok := a.subsystems[sqes.Key]; ok {
-			a.cqes = append(a.cqes, subsystem.NewWorker(0).Process(sqes.Value)...)
+			cqes := subsystem.NewWorker(0).Process(sqes.Value)
+			a.metrics.AioBatchTotal.WithLabelValues(sqes.Key.String(), batchStatus(cqes)).Inc()
+
+			a.cqes = append(a.cqes, cqes...)
 		} else {
 			panic("invalid aio submission")
 		}
@@ -99,12 +99,30 @@ func (a *api) Enqueue(sqe *bus.SQE[t_api.Request, t_api.Response]) {
 				status = int(res.ResolvePromise.Status)
 			case t_api.RejectPromise:
 				status = int(res.RejectPromise.Status)
+			case t_api.Batch:
+				status = int(res.Batch.Status)
+			case t_api.CompleteAndCreatePromises:
+				status = int(res.CompleteAndCreatePromises.Status)
+			case t_api.ReadPromisesByIdempotencyKey:
+				status = int(res.ReadPromisesByIdempotencyKey.Status)
 			case t_api.ReadSubscriptions:
 				status = int(res.ReadSubscriptions.Status)
 			case t_api.CreateSubscription:
 				status = int(res.CreateSubscription.Status)
 			case t_api.DeleteSubscription:
 				status = int(res.DeleteSubscription.Status)
+			case t_api.ReadDeadNotifications:
+				status = int(res.ReadDeadNotifications.Status)
+			case t_api.ReadDeadNotification:
+				status = int(res.ReadDeadNotification.Status)
+			case t_api.ReplayDeadNotification:
+				status = int(res.ReplayDeadNotification.Status)
+			case t_api.DeleteDeadNotification:
+				status = int(res.DeleteDeadNotification.Status)
+			case t_api.DeleteDeadNotifications:
+				status = int(res.DeleteDeadNotifications.Status)
+			case t_api.ReadNotificationAttempts:
+				status = int(res.ReadNotificationAttempts.Status)
 			default:
 				status = 200
 			}
@@ -0,0 +1,122 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+// NewArchivePromises returns a constructor for archive coroutines, the
+// coroutines of a constructor do not run concurrently so that the same
+// promises are not written to the sink twice.
+func NewArchivePromises() func(*system.Config) *scheduler.Coroutine {
+	archiving := false
+
+	return func(config *system.Config) *scheduler.Coroutine {
+		return archivePromises(config, &archiving)
+	}
+}
+
+func archivePromises(config *system.Config, archiving *bool) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("ArchivePromises", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		if *archiving {
+			return
+		}
+
+		*archiving = true
+		c.OnDone(func() { *archiving = false })
+
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.ReadExpiredPromises,
+							ReadExpiredPromises: &t_aio.ReadExpiredPromisesCommand{
+								Time:      s.Time(),
+								Retention: t_aio.DefaultRetention(config.Retention),
+								Limit:     config.RetentionBatchSize,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to read expired promises", "err", err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			records := completion.Store.Results[0].ReadExpiredPromises.Records
+			if len(records) == 0 {
+				return
+			}
+
+			submission := &t_aio.Submission{
+				Kind: t_aio.Sink,
+				Sink: &t_aio.SinkSubmission{
+					Records: records,
+				},
+			}
+
+			c.Yield(submission, func(completion *t_aio.Completion, err error) {
+				if err != nil {
+					slog.Error("failed to archive promises", "err", err)
+					return
+				}
+
+				util.Assert(completion.Sink != nil, "completion must not be nil")
+				path := completion.Sink.Path
+
+				// promises are only deleted once archived
+				commands := make([]*t_aio.Command, len(records))
+				for i, record := range records {
+					commands[i] = &t_aio.Command{
+						Kind: t_aio.DeletePromise,
+						DeletePromise: &t_aio.DeletePromiseCommand{
+							Id: record.Id,
+						},
+					}
+				}
+
+				submission := &t_aio.Submission{
+					Kind: t_aio.Store,
+					Store: &t_aio.StoreSubmission{
+						Transaction: &t_aio.Transaction{
+							Commands: commands,
+						},
+					},
+				}
+
+				c.Yield(submission, func(completion *t_aio.Completion, err error) {
+					if err != nil {
+						slog.Error("failed to delete archived promises", "path", path, "err", err)
+						return
+					}
+
+					util.Assert(completion.Store != nil, "completion must not be nil")
+
+					// promises that received notifications since they were
+					// read are kept and archived again later
+					var deleted int64
+					for _, result := range completion.Store.Results {
+						deleted += result.DeletePromise.RowsAffected
+					}
+					if kept := int64(len(records)) - deleted; kept > 0 {
+						slog.Warn("kept archived promises with pending or dead notifications", "path", path, "n", kept)
+					}
+
+					slog.Debug("archived promises", "path", path, "n", deleted)
+				})
+			})
+		})
+	})
+}
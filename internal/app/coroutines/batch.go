@@ -0,0 +1,133 @@
+package coroutines
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+// Batch reads the promises of all requests of a batch in a single
+// transaction and then runs each request as its own coroutine, starting
+// from the promise that was read. Requests are written independently, a
+// request that fails has an error in the response and does not affect
+// the other requests.
+func Batch(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("Batch", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		n := len(req.Batch.Requests)
+
+		responses := make([]*t_api.Response, n)
+		errs := make([]error, n)
+		pending := n
+
+		done := func() {
+			res(&t_api.Response{
+				Kind: t_api.Batch,
+				Batch: &t_api.BatchResponse{
+					Status:    t_api.ResponseOK,
+					Responses: responses,
+					Errors:    errs,
+				},
+			}, nil)
+		}
+
+		if n == 0 {
+			done()
+			return
+		}
+
+		commands := make([]*t_aio.Command, n)
+		for i, r := range req.Batch.Requests {
+			commands[i] = &t_aio.Command{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: batchId(r),
+				},
+			}
+		}
+
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: commands,
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				// nothing has been written
+				slog.Error("failed to read promises", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+			util.Assert(len(completion.Store.Results) == n, "completion must have a result for each request")
+
+			for i, r := range req.Batch.Requests {
+				i := i
+
+				callback := func(resp *t_api.Response, err error) {
+					if err != nil {
+						slog.Error("failed batch request", "req", req.Batch.Requests[i], "err", err)
+					}
+
+					responses[i] = resp
+					errs[i] = err
+					pending--
+
+					if pending == 0 {
+						done()
+					}
+				}
+
+				read := completion.Store.Results[i]
+
+				switch r.Kind {
+				case t_api.CreatePromise:
+					s.Add(createPromise(config, r, read, callback))
+				case t_api.CancelPromise:
+					s.Add(cancelPromise(config, r, read, callback))
+				case t_api.ResolvePromise:
+					s.Add(resolvePromise(config, r, read, callback))
+				case t_api.RejectPromise:
+					s.Add(rejectPromise(config, r, read, callback))
+				default:
+					panic(fmt.Sprintf("invalid batch request kind: %d", r.Kind))
+				}
+			}
+		})
+	})
+}
+
+func batchId(req *t_api.Request) string {
+	switch req.Kind {
+	case t_api.CreatePromise:
+		return req.CreatePromise.Id
+	case t_api.CancelPromise:
+		return req.CancelPromise.Id
+	case t_api.ResolvePromise:
+		return req.ResolvePromise.Id
+	case t_api.RejectPromise:
+		return req.RejectPromise.Id
+	default:
+		panic(fmt.Sprintf("invalid batch request kind: %d", req.Kind))
+	}
+}
+
+// storeCompletion wraps the result of a read that has already been
+// completed so that it can be passed to a continuation.
+func storeCompletion(result *t_aio.Result) *t_aio.Completion {
+	return &t_aio.Completion{
+		Kind: t_aio.Store,
+		Store: &t_aio.StoreCompletion{
+			Results: []*t_aio.Result{result},
+		},
+	}
+}
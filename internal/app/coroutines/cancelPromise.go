@@ -4,13 +4,20 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 )
 
-func CancelPromise(req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+func CancelPromise(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return cancelPromise(config, req, nil, res)
+}
+
+// cancelPromise skips reading the promise if the promise has already been
+// read, retries always read the promise again.
+func cancelPromise(config *system.Config, req *t_api.Request, read *t_aio.Result, res func(*t_api.Response, error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("CancelPromise", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		if req.CancelPromise.Value.Headers == nil {
 			req.CancelPromise.Value.Headers = map[string]string{}
@@ -35,7 +42,7 @@ func CancelPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 			},
 		}
 
-		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+		continuation := func(completion *t_aio.Completion, err error) {
 			if err != nil {
 				slog.Error("failed to read promise", "req", req, "err", err)
 				res(nil, err)
@@ -64,7 +71,7 @@ func CancelPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 
 				if p.State == promise.Pending {
 					if s.Time() >= p.Timeout {
-						s.Add(TimeoutPromise(p, CancelPromise(req, res), func(err error) {
+						s.Add(TimeoutPromise(config, p, CancelPromise(config, req, res), func(err error) {
 							if err != nil {
 								slog.Error("failed to timeout promise", "req", req, "err", err)
 								res(nil, err)
@@ -141,26 +148,31 @@ func CancelPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 							util.Assert(result.RowsAffected == 0 || result.RowsAffected == 1, "result must return 0 or 1 rows")
 
 							if result.RowsAffected == 1 {
+								completed := &promise.Promise{
+									Id:                        p.Id,
+									State:                     promise.Canceled,
+									Param:                     p.Param,
+									Value:                     req.CancelPromise.Value,
+									Timeout:                   p.Timeout,
+									IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
+									IdempotencyKeyForComplete: req.CancelPromise.IdempotencyKey,
+									Tags:                      p.Tags,
+									CreatedOn:                 p.CreatedOn,
+									CompletedOn:               &completedOn,
+								}
+
+								// notify in-process listeners
+								config.Events.Publish(completed)
+
 								res(&t_api.Response{
 									Kind: t_api.CancelPromise,
 									CancelPromise: &t_api.CancelPromiseResponse{
-										Status: t_api.ResponseCreated,
-										Promise: &promise.Promise{
-											Id:                        p.Id,
-											State:                     promise.Canceled,
-											Param:                     p.Param,
-											Value:                     req.CancelPromise.Value,
-											Timeout:                   p.Timeout,
-											IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
-											IdempotencyKeyForComplete: req.CancelPromise.IdempotencyKey,
-											Tags:                      p.Tags,
-											CreatedOn:                 p.CreatedOn,
-											CompletedOn:               &completedOn,
-										},
+										Status:  t_api.ResponseCreated,
+										Promise: completed,
 									},
 								}, nil)
 							} else {
-								s.Add(CancelPromise(req, res))
+								s.Add(CancelPromise(config, req, res))
 							}
 						})
 					}
@@ -168,7 +180,7 @@ func CancelPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 					status := t_api.ResponseForbidden
 					strict := req.CancelPromise.Strict && p.State != promise.Canceled
 
-					if !strict && p.IdempotencyKeyForComplete.Match(req.CancelPromise.IdempotencyKey) {
+					if !strict && idempotencyKeyMatch(config, p.IdempotencyKeyForComplete, req.CancelPromise.IdempotencyKey, p.CompletedOn, s.Time()) {
 						status = t_api.ResponseOK
 					}
 
@@ -181,6 +193,12 @@ func CancelPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 					}, nil)
 				}
 			}
-		})
+		}
+
+		if read != nil {
+			continuation(storeCompletion(read), nil)
+		} else {
+			c.Yield(submission, continuation)
+		}
 	})
 }
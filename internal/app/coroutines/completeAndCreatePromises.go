@@ -0,0 +1,446 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/promise"
+)
+
+type completeRequest struct {
+	id             string
+	state          promise.State
+	value          promise.Value
+	idempotencyKey *promise.IdempotencyKey
+	strict         bool
+}
+
+// completeAndCreatePromisesAttempts is the number of times a request is
+// attempted when promises change between the read and the write.
+const completeAndCreatePromisesAttempts = 3
+
+func CompleteAndCreatePromises(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return completeAndCreatePromises(config, req, res, 1)
+}
+
+func completeAndCreatePromises(config *system.Config, req *t_api.Request, res func(*t_api.Response, error), attempt int) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("CompleteAndCreatePromises", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		complete := newCompleteRequest(req.CompleteAndCreatePromises.Complete)
+		creates := req.CompleteAndCreatePromises.Creates
+
+		for _, create := range creates {
+			if create.Param.Headers == nil {
+				create.Param.Headers = map[string]string{}
+			}
+			if create.Param.Data == nil {
+				create.Param.Data = []byte{}
+			}
+			if create.Tags == nil {
+				create.Tags = map[string]string{}
+			}
+		}
+
+		// read all promises in a single transaction
+		commands := []*t_aio.Command{
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: complete.id,
+				},
+			},
+		}
+
+		for _, create := range creates {
+			commands = append(commands, &t_aio.Command{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: create.Id,
+				},
+			})
+		}
+
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: commands,
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to read promises", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+			util.Assert(len(completion.Store.Results) == len(creates)+1, "completion must have a result for each promise")
+
+			promises := make([]*promise.Promise, len(completion.Store.Results))
+			for i, result := range completion.Store.Results {
+				util.Assert(result.ReadPromise.RowsReturned == 0 || result.ReadPromise.RowsReturned == 1, "result must return 0 or 1 rows")
+
+				if result.ReadPromise.RowsReturned == 1 {
+					p, err := result.ReadPromise.Records[0].Promise()
+					if err != nil {
+						slog.Error("failed to parse promise record", "record", result.ReadPromise.Records[0], "err", err)
+						res(nil, err)
+						return
+					}
+
+					promises[i] = p
+				}
+			}
+
+			now := s.Time()
+			conflict := false
+			writes := 0
+
+			// complete
+			completeStatus, completePromise := complete.status(config, promises[0], now)
+			switch completeStatus {
+			case t_api.ResponseCreated:
+				writes++
+			case t_api.ResponseForbidden, t_api.ResponseNotFound:
+				conflict = true
+			}
+
+			// creates
+			createResponses := make([]*t_api.CreatePromiseResponse, len(creates))
+			for i, create := range creates {
+				p := promises[i+1]
+
+				if p == nil {
+					writes++
+					createResponses[i] = &t_api.CreatePromiseResponse{
+						Status: t_api.ResponseCreated,
+						Promise: &promise.Promise{
+							Id:                      create.Id,
+							State:                   promise.Pending,
+							Param:                   create.Param,
+							Timeout:                 create.Timeout,
+							IdempotencyKeyForCreate: create.IdempotencyKey,
+							Tags:                    create.Tags,
+							CreatedOn:               &now,
+						},
+					}
+					continue
+				}
+
+				status := t_api.ResponseForbidden
+				strict := create.Strict && p.State != promise.Pending
+
+				if !strict && idempotencyKeyMatch(config, p.IdempotencyKeyForCreate, create.IdempotencyKey, p.CreatedOn, now) {
+					status = t_api.ResponseOK
+				} else {
+					conflict = true
+				}
+
+				if p.State == promise.Pending && now >= p.Timeout {
+					p = p.Timedout()
+				}
+
+				createResponses[i] = &t_api.CreatePromiseResponse{
+					Status:  status,
+					Promise: p,
+				}
+			}
+
+			// nothing is written on a conflict, items that would have
+			// been written are reported as conflicts
+			conflicted := func() *t_api.Response {
+				if completeStatus == t_api.ResponseCreated {
+					completeStatus, completePromise = t_api.ResponseConflict, nil
+				}
+				for _, r := range createResponses {
+					if r.Status == t_api.ResponseCreated {
+						r.Status, r.Promise = t_api.ResponseConflict, nil
+					}
+				}
+
+				return completeAndCreatePromisesResponse(req, t_api.ResponseConflict, completeStatus, completePromise, createResponses)
+			}
+
+			// promises changed since they were read, try again until the
+			// attempts are exhausted
+			retry := func() {
+				if attempt < completeAndCreatePromisesAttempts {
+					s.Add(completeAndCreatePromises(config, req, res, attempt+1))
+				} else {
+					res(conflicted(), nil)
+				}
+			}
+
+			write := func() {
+				if conflict {
+					res(conflicted(), nil)
+					return
+				}
+
+				if writes == 0 {
+					res(completeAndCreatePromisesResponse(req, t_api.ResponseOK, completeStatus, completePromise, createResponses), nil)
+					return
+				}
+
+				commands := []*t_aio.Command{}
+
+				if completeStatus == t_api.ResponseCreated {
+					commands = append(commands,
+						&t_aio.Command{
+							Kind: t_aio.UpdatePromise,
+							UpdatePromise: &t_aio.UpdatePromiseCommand{
+								Id:             complete.id,
+								State:          complete.state,
+								Value:          complete.value,
+								IdempotencyKey: complete.idempotencyKey,
+								CompletedOn:    now,
+							},
+						},
+						&t_aio.Command{
+							Kind: t_aio.CreateNotifications,
+							CreateNotifications: &t_aio.CreateNotificationsCommand{
+								PromiseId: complete.id,
+								Time:      now,
+							},
+						},
+						&t_aio.Command{
+							Kind: t_aio.DeleteSubscriptions,
+							DeleteSubscriptions: &t_aio.DeleteSubscriptionsCommand{
+								PromiseId: complete.id,
+							},
+						},
+					)
+				}
+
+				for i, create := range creates {
+					if createResponses[i].Status == t_api.ResponseCreated {
+						commands = append(commands, &t_aio.Command{
+							Kind: t_aio.CreatePromise,
+							CreatePromise: &t_aio.CreatePromiseCommand{
+								Id:             create.Id,
+								Param:          create.Param,
+								Timeout:        create.Timeout,
+								IdempotencyKey: create.IdempotencyKey,
+								Tags:           create.Tags,
+								CreatedOn:      now,
+							},
+						})
+					}
+				}
+
+				submission := &t_aio.Submission{
+					Kind: t_aio.Store,
+					Store: &t_aio.StoreSubmission{
+						Transaction: &t_aio.Transaction{
+							Commands: commands,
+							Atomic:   true,
+						},
+					},
+				}
+
+				c.Yield(submission, func(completion *t_aio.Completion, err error) {
+					if err != nil {
+						slog.Error("failed to complete and create promises", "req", req, "err", err)
+						res(nil, err)
+						return
+					}
+
+					util.Assert(completion.Store != nil, "completion must not be nil")
+
+					for _, result := range completion.Store.Results {
+						var rowsAffected int64
+
+						switch result.Kind {
+						case t_aio.UpdatePromise:
+							rowsAffected = result.UpdatePromise.RowsAffected
+						case t_aio.CreatePromise:
+							rowsAffected = result.CreatePromise.RowsAffected
+						default:
+							continue
+						}
+
+						// the transaction was rolled back
+						if rowsAffected == 0 {
+							retry()
+							return
+						}
+					}
+
+					if completeStatus == t_api.ResponseCreated {
+						// notify in-process listeners
+						config.Events.Publish(completePromise)
+					}
+
+					res(completeAndCreatePromisesResponse(req, t_api.ResponseCreated, completeStatus, completePromise, createResponses), nil)
+				})
+			}
+
+			// pending promises that have timed out are reported as timed
+			// out, the timeouts are written before anything else
+			var timedout []*promise.Promise
+			var timeouts []*t_aio.Command
+			var updates []int
+
+			seen := map[string]bool{}
+			for _, p := range promises {
+				if p != nil && p.State == promise.Pending && now >= p.Timeout && !seen[p.Id] {
+					seen[p.Id] = true
+					timedout = append(timedout, p)
+					updates = append(updates, len(timeouts))
+					timeouts = append(timeouts, timeoutCommands(p, now)...)
+				}
+			}
+
+			if len(timedout) == 0 {
+				write()
+				return
+			}
+
+			submission := &t_aio.Submission{
+				Kind: t_aio.Store,
+				Store: &t_aio.StoreSubmission{
+					Transaction: &t_aio.Transaction{
+						Commands: timeouts,
+					},
+				},
+			}
+
+			c.Yield(submission, func(completion *t_aio.Completion, err error) {
+				if err != nil {
+					slog.Error("failed to timeout promises", "req", req, "err", err)
+					res(nil, err)
+					return
+				}
+
+				util.Assert(completion.Store != nil, "completion must not be nil")
+				util.Assert(len(completion.Store.Results) == len(timeouts), "completion must have a result for each command")
+
+				changed := false
+				for i, p := range timedout {
+					result := completion.Store.Results[updates[i]].UpdatePromise
+					util.Assert(result.RowsAffected == 0 || result.RowsAffected == 1, "result must return 0 or 1 rows")
+
+					if result.RowsAffected == 1 {
+						// notify in-process listeners
+						config.Events.Publish(p.Timedout())
+					} else {
+						changed = true
+					}
+				}
+
+				if changed {
+					retry()
+					return
+				}
+
+				write()
+			})
+		})
+	})
+}
+
+func newCompleteRequest(req *t_api.Request) *completeRequest {
+	var r *completeRequest
+
+	switch req.Kind {
+	case t_api.ResolvePromise:
+		r = &completeRequest{
+			id:             req.ResolvePromise.Id,
+			state:          promise.Resolved,
+			value:          req.ResolvePromise.Value,
+			idempotencyKey: req.ResolvePromise.IdempotencyKey,
+			strict:         req.ResolvePromise.Strict,
+		}
+	case t_api.RejectPromise:
+		r = &completeRequest{
+			id:             req.RejectPromise.Id,
+			state:          promise.Rejected,
+			value:          req.RejectPromise.Value,
+			idempotencyKey: req.RejectPromise.IdempotencyKey,
+			strict:         req.RejectPromise.Strict,
+		}
+	case t_api.CancelPromise:
+		r = &completeRequest{
+			id:             req.CancelPromise.Id,
+			state:          promise.Canceled,
+			value:          req.CancelPromise.Value,
+			idempotencyKey: req.CancelPromise.IdempotencyKey,
+			strict:         req.CancelPromise.Strict,
+		}
+	default:
+		panic("invalid complete request kind")
+	}
+
+	if r.value.Headers == nil {
+		r.value.Headers = map[string]string{}
+	}
+	if r.value.Data == nil {
+		r.value.Data = []byte{}
+	}
+
+	return r
+}
+
+// status returns the status the complete request would have on its own,
+// and the promise as it would be after the request.
+func (r *completeRequest) status(config *system.Config, p *promise.Promise, now int64) (t_api.ResponseStatus, *promise.Promise) {
+	if p == nil {
+		return t_api.ResponseNotFound, nil
+	}
+
+	if p.State == promise.Pending {
+		if now >= p.Timeout {
+			return t_api.ResponseForbidden, p.Timedout()
+		}
+
+		return t_api.ResponseCreated, &promise.Promise{
+			Id:                        p.Id,
+			State:                     r.state,
+			Param:                     p.Param,
+			Value:                     r.value,
+			Timeout:                   p.Timeout,
+			IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
+			IdempotencyKeyForComplete: r.idempotencyKey,
+			Tags:                      p.Tags,
+			CreatedOn:                 p.CreatedOn,
+			CompletedOn:               &now,
+		}
+	}
+
+	status := t_api.ResponseForbidden
+	strict := r.strict && p.State != r.state
+
+	if !strict && idempotencyKeyMatch(config, p.IdempotencyKeyForComplete, r.idempotencyKey, p.CompletedOn, now) {
+		status = t_api.ResponseOK
+	}
+
+	return status, p
+}
+
+func completeAndCreatePromisesResponse(req *t_api.Request, status t_api.ResponseStatus, completeStatus t_api.ResponseStatus, completePromise *promise.Promise, creates []*t_api.CreatePromiseResponse) *t_api.Response {
+	complete := &t_api.Response{Kind: req.CompleteAndCreatePromises.Complete.Kind}
+
+	switch complete.Kind {
+	case t_api.ResolvePromise:
+		complete.ResolvePromise = &t_api.ResolvePromiseResponse{Status: completeStatus, Promise: completePromise}
+	case t_api.RejectPromise:
+		complete.RejectPromise = &t_api.RejectPromiseResponse{Status: completeStatus, Promise: completePromise}
+	case t_api.CancelPromise:
+		complete.CancelPromise = &t_api.CancelPromiseResponse{Status: completeStatus, Promise: completePromise}
+	}
+
+	return &t_api.Response{
+		Kind: t_api.CompleteAndCreatePromises,
+		CompleteAndCreatePromises: &t_api.CompleteAndCreatePromisesResponse{
+			Status:   status,
+			Complete: complete,
+			Creates:  creates,
+		},
+	}
+}
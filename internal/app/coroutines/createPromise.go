@@ -4,13 +4,20 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 )
 
-func CreatePromise(req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+func CreatePromise(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return createPromise(config, req, nil, res)
+}
+
+// createPromise skips reading the promise if the promise has already been
+// read, retries always read the promise again.
+func createPromise(config *system.Config, req *t_api.Request, read *t_aio.Result, res func(*t_api.Response, error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("CreatePromise", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		if req.CreatePromise.Param.Headers == nil {
 			req.CreatePromise.Param.Headers = map[string]string{}
@@ -38,7 +45,7 @@ func CreatePromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 			},
 		}
 
-		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+		continuation := func(completion *t_aio.Completion, err error) {
 			if err != nil {
 				slog.Error("failed to read promise", "req", req, "err", err)
 				res(nil, err)
@@ -102,7 +109,7 @@ func CreatePromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 							},
 						}, nil)
 					} else {
-						s.Add(CreatePromise(req, res))
+						s.Add(CreatePromise(config, req, res))
 					}
 				})
 			} else {
@@ -116,12 +123,12 @@ func CreatePromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 				status := t_api.ResponseForbidden
 				strict := req.CreatePromise.Strict && p.State != promise.Pending
 
-				if !strict && p.IdempotencyKeyForCreate.Match(req.CreatePromise.IdempotencyKey) {
+				if !strict && idempotencyKeyMatch(config, p.IdempotencyKeyForCreate, req.CreatePromise.IdempotencyKey, p.CreatedOn, s.Time()) {
 					status = t_api.ResponseOK
 				}
 
 				if p.State == promise.Pending && s.Time() >= p.Timeout {
-					s.Add(TimeoutPromise(p, CreatePromise(req, res), func(err error) {
+					s.Add(TimeoutPromise(config, p, CreatePromise(config, req, res), func(err error) {
 						if err != nil {
 							slog.Error("failed to timeout promise", "req", req, "err", err)
 							res(nil, err)
@@ -160,6 +167,12 @@ func CreatePromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 					}, nil)
 				}
 			}
-		})
+		}
+
+		if read != nil {
+			continuation(storeCompletion(read), nil)
+		} else {
+			c.Yield(submission, continuation)
+		}
 	})
 }
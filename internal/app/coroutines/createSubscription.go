@@ -4,13 +4,14 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/subscription"
 )
 
-func CreateSubscription(req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+func CreateSubscription(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("CreateSubscription", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		// default retry policy
 		if req.CreateSubscription.RetryPolicy == nil {
@@ -32,6 +33,10 @@ func CreateSubscription(req *t_api.Request, res func(*t_api.Response, error)) *s
 								Id:          req.CreateSubscription.Id,
 								PromiseId:   req.CreateSubscription.PromiseId,
 								Url:         req.CreateSubscription.Url,
+								Method:      req.CreateSubscription.Method,
+								Headers:     req.CreateSubscription.Headers,
+								Payload:     req.CreateSubscription.Payload,
+								Secret:      req.CreateSubscription.Secret,
 								RetryPolicy: req.CreateSubscription.RetryPolicy,
 								CreatedOn:   createdOn,
 							},
@@ -62,6 +67,10 @@ func CreateSubscription(req *t_api.Request, res func(*t_api.Response, error)) *s
 							Id:          req.CreateSubscription.Id,
 							PromiseId:   req.CreateSubscription.PromiseId,
 							Url:         req.CreateSubscription.Url,
+							Method:      req.CreateSubscription.Method,
+							Headers:     req.CreateSubscription.Headers,
+							Payload:     req.CreateSubscription.Payload,
+							Secret:      req.CreateSubscription.Secret,
 							RetryPolicy: req.CreateSubscription.RetryPolicy,
 							CreatedOn:   createdOn,
 						},
@@ -113,7 +122,7 @@ func CreateSubscription(req *t_api.Request, res func(*t_api.Response, error)) *s
 							},
 						}, nil)
 					} else {
-						s.Add(CreateSubscription(req, res))
+						s.Add(CreateSubscription(config, req, res))
 					}
 				})
 			}
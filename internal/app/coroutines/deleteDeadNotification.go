@@ -0,0 +1,60 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+func DeleteDeadNotification(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("DeleteDeadNotification", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.DeleteDeadNotification,
+							DeleteDeadNotification: &t_aio.DeleteDeadNotificationCommand{
+								Id:        req.DeleteDeadNotification.Id,
+								PromiseId: req.DeleteDeadNotification.PromiseId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to delete dead notification", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			result := completion.Store.Results[0].DeleteDeadNotification
+			util.Assert(result.RowsAffected == 0 || result.RowsAffected == 1, "result must return 0 or 1 rows")
+
+			var status t_api.ResponseStatus
+
+			if result.RowsAffected == 1 {
+				status = t_api.ResponseNoContent
+			} else {
+				status = t_api.ResponseNotFound
+			}
+
+			res(&t_api.Response{
+				Kind: t_api.DeleteDeadNotification,
+				DeleteDeadNotification: &t_api.DeleteDeadNotificationResponse{
+					Status: status,
+				},
+			}, nil)
+		})
+	})
+}
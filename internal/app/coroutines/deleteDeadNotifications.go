@@ -0,0 +1,49 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+func DeleteDeadNotifications(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("DeleteDeadNotifications", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind:                    t_aio.DeleteDeadNotifications,
+							DeleteDeadNotifications: &t_aio.DeleteDeadNotificationsCommand{},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to delete dead notifications", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			result := completion.Store.Results[0].DeleteDeadNotifications
+
+			res(&t_api.Response{
+				Kind: t_api.DeleteDeadNotifications,
+				DeleteDeadNotifications: &t_api.DeleteDeadNotificationsResponse{
+					Status:  t_api.ResponseOK,
+					Deleted: result.RowsAffected,
+				},
+			}, nil)
+		})
+	})
+}
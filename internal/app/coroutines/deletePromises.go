@@ -0,0 +1,46 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+func DeletePromises(config *system.Config) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("DeletePromises", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.DeletePromises,
+							DeletePromises: &t_aio.DeletePromisesCommand{
+								Time:      s.Time(),
+								Retention: t_aio.DefaultRetention(config.Retention),
+								Limit:     config.RetentionBatchSize,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to delete promises", "err", err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+			util.Assert(len(completion.Store.Results) == 1, "completion must have one result")
+
+			if n := completion.Store.Results[0].DeletePromises.RowsAffected; n > 0 {
+				slog.Debug("deleted promises", "n", n)
+			}
+		})
+	})
+}
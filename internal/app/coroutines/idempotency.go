@@ -0,0 +1,22 @@
+package coroutines
+
+import (
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/pkg/promise"
+)
+
+// idempotencyKeyMatch returns true if the idempotency key of a request
+// matches the idempotency key stored on a promise and the stored key has
+// not expired. A key expires the configured ttl after the create or
+// complete that stored it, keys never expire when the ttl is zero.
+func idempotencyKeyMatch(config *system.Config, stored *promise.IdempotencyKey, key *promise.IdempotencyKey, storedOn *int64, now int64) bool {
+	if !stored.Match(key) {
+		return false
+	}
+
+	if config.IdempotencyKeyTTL <= 0 || storedOn == nil {
+		return true
+	}
+
+	return now < *storedOn+config.IdempotencyKeyTTL.Milliseconds()
+}
@@ -0,0 +1,37 @@
+package coroutines
+
+import (
+	"testing"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyKeyMatch(t *testing.T) {
+	foo := promise.IdempotencyKey("foo")
+	bar := promise.IdempotencyKey("bar")
+	storedOn := int64(100)
+
+	for _, tc := range []struct {
+		name     string
+		ttl      time.Duration
+		key      *promise.IdempotencyKey
+		storedOn *int64
+		now      int64
+		match    bool
+	}{
+		{name: "InsideTTL", ttl: 10 * time.Millisecond, key: &foo, storedOn: &storedOn, now: 109, match: true},
+		{name: "PastTTL", ttl: 10 * time.Millisecond, key: &foo, storedOn: &storedOn, now: 110, match: false},
+		{name: "NoTTL", ttl: 0, key: &foo, storedOn: &storedOn, now: 1000, match: true},
+		{name: "NilStoredOn", ttl: 10 * time.Millisecond, key: &foo, storedOn: nil, now: 1000, match: true},
+		{name: "Mismatch", ttl: 10 * time.Millisecond, key: &bar, storedOn: &storedOn, now: 100, match: false},
+		{name: "NilKey", ttl: 10 * time.Millisecond, key: nil, storedOn: &storedOn, now: 100, match: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &system.Config{IdempotencyKeyTTL: tc.ttl}
+			assert.Equal(t, tc.match, idempotencyKeyMatch(config, &foo, tc.key, tc.storedOn, tc.now))
+		})
+	}
+}
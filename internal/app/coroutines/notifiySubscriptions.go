@@ -3,15 +3,22 @@ package coroutines
 import (
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
+	"io"
 	"log/slog"
+	"maps"
 	"math"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
 	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/notification"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/subscription"
 )
 
 var inflights = inflight{}
@@ -65,14 +72,14 @@ func NotifySubscriptions(config *system.Config) *scheduler.Coroutine {
 				}
 
 				if s.Time() >= record.Time && !inflights.get(id(notification)) {
-					s.Add(notifySubscription(notification))
+					s.Add(notifySubscription(config, notification))
 				}
 			}
 		})
 	})
 }
 
-func notifySubscription(notification *notification.Notification) *scheduler.Coroutine {
+func notifySubscription(config *system.Config, notification *notification.Notification) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("NotifySubscription", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		// handle inflight cache
 		inflights.add(id(notification))
@@ -119,48 +126,103 @@ func notifySubscription(notification *notification.Notification) *scheduler.Coro
 				return
 			}
 
-			body, err := json.Marshal(promise)
-			if err != nil {
-				slog.Warn("failed to serialize promise, aborting notification", "promise", promise)
-				abort(c, notification)
-				return
-			}
-
 			submission := &t_aio.Submission{
 				Kind: t_aio.Network,
-				Network: &t_aio.NetworkSubmission{
+			}
+
+			if isGrpc(notification) {
+				submission.Network = &t_aio.NetworkSubmission{
+					Kind: t_aio.Grpc,
+					Grpc: &t_aio.GrpcRequest{
+						Headers: notification.Headers,
+						Url:     notification.Url,
+						Promise: payload(promise, notification.Payload),
+					},
+				}
+			} else {
+				body, err := json.Marshal(payload(promise, notification.Payload))
+				if err != nil {
+					slog.Warn("failed to serialize promise, aborting notification", "promise", promise)
+					abort(c, notification)
+					return
+				}
+
+				headers, err := sign(config, notification, s.Time(), body)
+				if err != nil {
+					slog.Warn("failed to sign notification, aborting notification", "notification", notification, "err", err)
+					abort(c, notification)
+					return
+				}
+
+				submission.Network = &t_aio.NetworkSubmission{
 					Kind: t_aio.Http,
 					Http: &t_aio.HttpRequest{
-						Method: "POST",
-						Url:    notification.Url,
-						Body:   body,
+						Headers: headers,
+						Method:  method(notification),
+						Url:     notification.Url,
+						Body:    body,
 					},
-				},
+				}
 			}
 
+			// the attempt is recorded at the time it is sent
+			start := s.Time()
+
 			c.Yield(submission, func(completion *t_aio.Completion, err error) {
 				if err != nil {
 					slog.Warn("failed to send notification", "promise", promise, "url", notification.Url)
 				}
 
-				var command *t_aio.Command
-				if (err != nil || !isSuccessful(completion.Network.Http)) && notification.Attempt < notification.RetryPolicy.Attempts {
-					command = &t_aio.Command{
-						Kind: t_aio.UpdateNotification,
-						UpdateNotification: &t_aio.UpdateNotificationCommand{
-							Id:        notification.Id,
-							PromiseId: notification.PromiseId,
-							Time:      backoff(notification.RetryPolicy.Delay, notification.Attempt),
-							Attempt:   notification.Attempt + 1,
+				var commands []*t_aio.Command
+
+				if err == nil && completion.Network.Deferred {
+					// the host of the subscription is unavailable and the
+					// notification was not sent, defer the notification
+					// without using up an attempt
+					commands = []*t_aio.Command{
+						{
+							Kind: t_aio.UpdateNotification,
+							UpdateNotification: &t_aio.UpdateNotificationCommand{
+								Id:        notification.Id,
+								PromiseId: notification.PromiseId,
+								Time:      s.Time() + completion.Network.Delay,
+								Attempt:   notification.Attempt,
+							},
 						},
 					}
 				} else {
-					command = &t_aio.Command{
-						Kind: t_aio.DeleteNotification,
-						DeleteNotification: &t_aio.DeleteNotificationCommand{
-							Id:        notification.Id,
-							PromiseId: notification.PromiseId,
-						},
+					failed := err != nil || !isSuccessful(completion.Network)
+
+					// every attempt is recorded, regardless of the outcome
+					commands = []*t_aio.Command{
+						attempt(notification, completion, err, start),
+					}
+
+					if failed && notification.Attempt < notification.RetryPolicy.Attempts {
+						commands = append(commands, &t_aio.Command{
+							Kind: t_aio.UpdateNotification,
+							UpdateNotification: &t_aio.UpdateNotificationCommand{
+								Id:        notification.Id,
+								PromiseId: notification.PromiseId,
+								Time:      next(notification, completion, err, s.Time()),
+								Attempt:   notification.Attempt + 1,
+							},
+						})
+					} else {
+						commands = append(commands, &t_aio.Command{
+							Kind: t_aio.DeleteNotification,
+							DeleteNotification: &t_aio.DeleteNotificationCommand{
+								Id:        notification.Id,
+								PromiseId: notification.PromiseId,
+							},
+						})
+
+						// retries are exhausted, move the notification to the
+						// dead notifications so that it can be inspected and
+						// replayed
+						if failed {
+							commands = append(commands, dead(notification, completion, err, s.Time()))
+						}
 					}
 				}
 
@@ -168,7 +230,7 @@ func notifySubscription(notification *notification.Notification) *scheduler.Coro
 					Kind: t_aio.Store,
 					Store: &t_aio.StoreSubmission{
 						Transaction: &t_aio.Transaction{
-							Commands: []*t_aio.Command{command},
+							Commands: commands,
 						},
 					},
 				}
@@ -208,19 +270,214 @@ func abort(c *scheduler.Coroutine, notification *notification.Notification) {
 	})
 }
 
+// dead returns the command to create a dead notification, the status
+// code of the last attempt is recorded if an http response was received
+// and the error otherwise.
+func dead(notification *notification.Notification, completion *t_aio.Completion, err error, time int64) *t_aio.Command {
+	command := &t_aio.CreateDeadNotificationCommand{
+		Id:          notification.Id,
+		PromiseId:   notification.PromiseId,
+		Url:         notification.Url,
+		Method:      notification.Method,
+		Headers:     notification.Headers,
+		Payload:     notification.Payload,
+		Secret:      notification.Secret,
+		RetryPolicy: notification.RetryPolicy,
+		Attempts:    notification.Attempt + 1,
+		CreatedOn:   time,
+	}
+
+	if err != nil {
+		msg := err.Error()
+		command.Error = &msg
+	} else if completion.Network.Http != nil {
+		command.StatusCode = &completion.Network.Http.StatusCode
+	}
+
+	return &t_aio.Command{
+		Kind:                   t_aio.CreateDeadNotification,
+		CreateDeadNotification: command,
+	}
+}
+
+// attempt returns the command to record a delivery attempt, the status
+// code and the beginning of the response body are recorded if an http
+// response was received and the error otherwise. The latency is the
+// latency measured by the network subsystem.
+func attempt(notification *notification.Notification, completion *t_aio.Completion, err error, time int64) *t_aio.Command {
+	command := &t_aio.CreateNotificationAttemptCommand{
+		Id:        notification.Id,
+		PromiseId: notification.PromiseId,
+		Attempt:   notification.Attempt + 1,
+		Url:       notification.Url,
+		Time:      time,
+	}
+
+	// a submission that is not processed completes without a completion
+	if completion != nil {
+		command.Latency = completion.Network.Latency
+	}
+
+	if err != nil {
+		msg := err.Error()
+		command.Error = &msg
+	} else if completion.Network.Http != nil {
+		command.StatusCode = &completion.Network.Http.StatusCode
+
+		// the network subsystem truncates the body
+		if completion.Network.Http.Body != nil {
+			body, err := io.ReadAll(completion.Network.Http.Body)
+			if err != nil {
+				slog.Warn("failed to read notification response", "notification", notification, "err", err)
+			}
+			command.Body = body
+		}
+	}
+
+	return &t_aio.Command{
+		Kind:                      t_aio.CreateNotificationAttempt,
+		CreateNotificationAttempt: command,
+	}
+}
+
 func id(notification *notification.Notification) string {
 	return fmt.Sprintf("%s:%s", notification.Id, notification.PromiseId)
 }
 
-func isSuccessful(res *http.Response) bool {
-	// svix only checks for 2xx response codes and retries under all
-	// other circumstances
-	return res.StatusCode >= 200 && res.StatusCode < 300
+func isGrpc(notification *notification.Notification) bool {
+	u, err := url.Parse(notification.Url)
+	return err == nil && u.Scheme == subscription.GrpcScheme
 }
 
-func backoff(delay int64, attempt int64) int64 {
+func method(notification *notification.Notification) string {
+	if notification.Method == "" {
+		return http.MethodPost
+	}
+
+	return notification.Method
+}
+
+// sign returns the headers of a notification, when a secret is set
+// on the subscription or the server the notification is signed and
+// the webhook-id, webhook-timestamp and webhook-signature headers are
+// added. The secret of a subscription takes precedence over the
+// secrets of the server. Notifications sent over grpc are not signed.
+func sign(config *system.Config, notification *notification.Notification, time int64, body []byte) (map[string]string, error) {
+	secrets := config.NotificationSecrets
+	if notification.Secret != "" {
+		secrets = []string{notification.Secret}
+	}
+
+	if len(secrets) == 0 {
+		return notification.Headers, nil
+	}
+
+	keys := make([][]byte, len(secrets))
+	for i, secret := range secrets {
+		key, err := subscription.ParseSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	headers := maps.Clone(notification.Headers)
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	// the timestamp is in seconds
+	timestamp := time / 1000
+
+	headers["webhook-id"] = id(notification)
+	headers["webhook-timestamp"] = strconv.FormatInt(timestamp, 10)
+	headers["webhook-signature"] = subscription.Sign(keys, id(notification), timestamp, body)
+
+	return headers, nil
+}
+
+// payload applies the payload template of a subscription to a promise,
+// the promise is copied so that excluded data is only omitted from the
+// notification body.
+func payload(p *promise.Promise, template *subscription.Payload) *promise.Promise {
+	if template == nil {
+		return p
+	}
+
+	redacted := *p
+	if template.ExcludeParamData {
+		redacted.Param.Data = nil
+	}
+	if template.ExcludeValueData {
+		redacted.Value.Data = nil
+	}
+
+	return &redacted
+}
+
+func isSuccessful(completion *t_aio.NetworkCompletion) bool {
+	switch completion.Kind {
+	case t_aio.Http:
+		// svix only checks for 2xx response codes and retries under all
+		// other circumstances
+		return completion.Http.StatusCode >= 200 && completion.Http.StatusCode < 300
+	case t_aio.Grpc:
+		// grpc requests that do not succeed complete with an error
+		return true
+	default:
+		panic("invalid network completion")
+	}
+}
+
+// next returns the time of the next attempt of a notification, a
+// Retry-After header sent by the receiver takes precedence over the
+// retry policy of the subscription.
+func next(notification *notification.Notification, completion *t_aio.Completion, err error, time int64) int64 {
+	if err == nil {
+		if t, ok := retryAfter(completion.Network.Http, time); ok {
+			return t
+		}
+	}
+
+	delay := notification.RetryPolicy.Backoff(notification.Attempt, jitter(notification))
 	util.Assert(delay >= 0, "delay must be non-negative")
-	util.Assert(attempt >= 0, "delay must be non-negative")
 
-	return delay * int64(math.Pow(2, float64(attempt)))
+	if delay > math.MaxInt64-time {
+		return math.MaxInt64
+	}
+
+	return time + delay
+}
+
+// retryAfter parses the Retry-After header of a response, the header
+// is either a number of seconds or an http date.
+func retryAfter(res *http.Response, time int64) (int64, bool) {
+	header := res.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if seconds < 0 || seconds > (math.MaxInt64-time)/1000 {
+			return 0, false
+		}
+		return time + seconds*1000, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		return max(date.UnixMilli(), time), true
+	}
+
+	return 0, false
+}
+
+// jitter returns a value in [0, 1) derived from the notification and
+// attempt, jitter is deterministic so that retries can be reproduced
+// in deterministic simulation.
+func jitter(notification *notification.Notification) float64 {
+	h := fnv.New64a()
+	h.Write([]byte(id(notification)))
+	h.Write([]byte(strconv.FormatInt(notification.Attempt, 10)))
+
+	return float64(h.Sum64()>>11) / (1 << 53)
 }
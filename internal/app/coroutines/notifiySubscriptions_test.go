@@ -0,0 +1,127 @@
+package coroutines
+
+import (
+	"errors"
+	"math"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/pkg/notification"
+	"github.com/resonatehq/resonate/pkg/subscription"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := int64(1_000_000)
+
+	for _, tc := range []struct {
+		name   string
+		header string
+		time   int64
+		ok     bool
+	}{
+		{name: "None", header: "", ok: false},
+		{name: "Seconds", header: "5", time: now + 5000, ok: true},
+		{name: "Zero", header: "0", time: now, ok: true},
+		{name: "Negative", header: "-1", ok: false},
+		{name: "Overflow", header: strconv.FormatInt(math.MaxInt64/1000, 10), ok: false},
+		{name: "OverflowParse", header: "99999999999999999999", ok: false},
+		{name: "Date", header: time.UnixMilli(now + 10_000).UTC().Format(http.TimeFormat), time: now + 10_000, ok: true},
+		{name: "DatePast", header: time.UnixMilli(now - 10_000).UTC().Format(http.TimeFormat), time: now, ok: true},
+		{name: "Garbage", header: "soon", ok: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if tc.header != "" {
+				res.Header.Set("Retry-After", tc.header)
+			}
+
+			time, ok := retryAfter(res, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.time, time)
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	now := int64(1_000_000)
+
+	completion := func(status int, retryAfter string) *t_aio.Completion {
+		res := &http.Response{StatusCode: status, Header: http.Header{}}
+		if retryAfter != "" {
+			res.Header.Set("Retry-After", retryAfter)
+		}
+
+		return &t_aio.Completion{
+			Kind: t_aio.Network,
+			Network: &t_aio.NetworkCompletion{
+				Kind: t_aio.Http,
+				Http: res,
+			},
+		}
+	}
+
+	n := &notification.Notification{
+		Id:          "foo",
+		PromiseId:   "bar",
+		RetryPolicy: &subscription.RetryPolicy{Delay: 100},
+		Attempt:     2,
+	}
+
+	jittered := &notification.Notification{
+		Id:          "foo",
+		PromiseId:   "bar",
+		RetryPolicy: &subscription.RetryPolicy{Delay: 100, Jitter: true},
+		Attempt:     2,
+	}
+
+	saturated := &notification.Notification{
+		Id:          "foo",
+		PromiseId:   "bar",
+		RetryPolicy: &subscription.RetryPolicy{Delay: 100},
+		Attempt:     100,
+	}
+
+	for _, tc := range []struct {
+		name         string
+		notification *notification.Notification
+		completion   *t_aio.Completion
+		err          error
+		time         int64
+	}{
+		{name: "Backoff", notification: n, completion: completion(500, ""), time: now + 400},
+		{name: "Error", notification: n, err: errors.New("connection refused"), time: now + 400},
+		{name: "RetryAfterSeconds", notification: n, completion: completion(429, "5"), time: now + 5000},
+		{name: "RetryAfterDate", notification: n, completion: completion(503, time.UnixMilli(now+10_000).UTC().Format(http.TimeFormat)), time: now + 10_000},
+		{name: "RetryAfterNegative", notification: n, completion: completion(429, "-5"), time: now + 400},
+		{name: "RetryAfterOverflow", notification: n, completion: completion(429, strconv.FormatInt(math.MaxInt64, 10)), time: now + 400},
+		{name: "RetryAfterGarbage", notification: n, completion: completion(429, "soon"), time: now + 400},
+		{name: "Jitter", notification: jittered, completion: completion(500, ""), time: now + int64(400*jitter(jittered))},
+		{name: "Saturated", notification: saturated, completion: completion(500, ""), time: math.MaxInt64},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.time, next(tc.notification, tc.completion, tc.err, now))
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	n := &notification.Notification{Id: "foo", PromiseId: "bar"}
+
+	seen := map[float64]bool{}
+	for attempt := int64(0); attempt < 10; attempt++ {
+		n.Attempt = attempt
+
+		j := jitter(n)
+		assert.GreaterOrEqual(t, j, 0.0)
+		assert.Less(t, j, 1.0)
+
+		// jitter is deterministic and varies between attempts
+		assert.Equal(t, j, jitter(n))
+		assert.False(t, seen[j])
+		seen[j] = true
+	}
+}
@@ -0,0 +1,70 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+func ReadDeadNotification(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("ReadDeadNotification", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.ReadDeadNotification,
+							ReadDeadNotification: &t_aio.ReadDeadNotificationCommand{
+								Id:        req.ReadDeadNotification.Id,
+								PromiseId: req.ReadDeadNotification.PromiseId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to read dead notification", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			result := completion.Store.Results[0].ReadDeadNotification
+			util.Assert(result.RowsReturned == 0 || result.RowsReturned == 1, "result must return 0 or 1 rows")
+
+			if result.RowsReturned == 0 {
+				res(&t_api.Response{
+					Kind: t_api.ReadDeadNotification,
+					ReadDeadNotification: &t_api.ReadDeadNotificationResponse{
+						Status: t_api.ResponseNotFound,
+					},
+				}, nil)
+				return
+			}
+
+			deadNotification, err := result.Records[0].DeadNotification()
+			if err != nil {
+				slog.Error("failed to parse dead notification record", "record", result.Records[0], "err", err)
+				res(nil, err)
+				return
+			}
+
+			res(&t_api.Response{
+				Kind: t_api.ReadDeadNotification,
+				ReadDeadNotification: &t_api.ReadDeadNotificationResponse{
+					Status:           t_api.ResponseOK,
+					DeadNotification: deadNotification,
+				},
+			}, nil)
+		})
+	})
+}
@@ -0,0 +1,76 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/notification"
+)
+
+func ReadDeadNotifications(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("ReadDeadNotifications", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.ReadDeadNotifications,
+							ReadDeadNotifications: &t_aio.ReadDeadNotificationsCommand{
+								Limit:  req.ReadDeadNotifications.Limit,
+								SortId: req.ReadDeadNotifications.SortId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to read dead notifications", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			result := completion.Store.Results[0].ReadDeadNotifications
+			deadNotifications := []*notification.DeadNotification{}
+
+			for _, record := range result.Records {
+				deadNotification, err := record.DeadNotification()
+				if err != nil {
+					slog.Warn("failed to parse dead notification record", "record", record, "err", err)
+					continue
+				}
+
+				deadNotifications = append(deadNotifications, deadNotification)
+			}
+
+			// set cursor only if there are more results
+			var cursor *t_api.Cursor[t_api.ReadDeadNotificationsRequest]
+			if result.RowsReturned == int64(req.ReadDeadNotifications.Limit) {
+				cursor = &t_api.Cursor[t_api.ReadDeadNotificationsRequest]{
+					Next: &t_api.ReadDeadNotificationsRequest{
+						Limit:  req.ReadDeadNotifications.Limit,
+						SortId: &result.LastSortId,
+					},
+				}
+			}
+
+			res(&t_api.Response{
+				Kind: t_api.ReadDeadNotifications,
+				ReadDeadNotifications: &t_api.ReadDeadNotificationsResponse{
+					Status:            t_api.ResponseOK,
+					Cursor:            cursor,
+					DeadNotifications: deadNotifications,
+				},
+			}, nil)
+		})
+	})
+}
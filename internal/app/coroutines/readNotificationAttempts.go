@@ -0,0 +1,74 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/notification"
+)
+
+func ReadNotificationAttempts(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("ReadNotificationAttempts", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.ReadNotificationAttempts,
+							ReadNotificationAttempts: &t_aio.ReadNotificationAttemptsCommand{
+								Id:        req.ReadNotificationAttempts.Id,
+								PromiseId: req.ReadNotificationAttempts.PromiseId,
+								Limit:     req.ReadNotificationAttempts.Limit,
+								SortId:    req.ReadNotificationAttempts.SortId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to read notification attempts", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			result := completion.Store.Results[0].ReadNotificationAttempts
+			attempts := []*notification.NotificationAttempt{}
+
+			for _, record := range result.Records {
+				attempts = append(attempts, record.NotificationAttempt())
+			}
+
+			// set cursor only if there are more results
+			var cursor *t_api.Cursor[t_api.ReadNotificationAttemptsRequest]
+			if result.RowsReturned == int64(req.ReadNotificationAttempts.Limit) {
+				cursor = &t_api.Cursor[t_api.ReadNotificationAttemptsRequest]{
+					Next: &t_api.ReadNotificationAttemptsRequest{
+						Id:        req.ReadNotificationAttempts.Id,
+						PromiseId: req.ReadNotificationAttempts.PromiseId,
+						Limit:     req.ReadNotificationAttempts.Limit,
+						SortId:    &result.LastSortId,
+					},
+				}
+			}
+
+			res(&t_api.Response{
+				Kind: t_api.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_api.ReadNotificationAttemptsResponse{
+					Status:   t_api.ResponseOK,
+					Cursor:   cursor,
+					Attempts: attempts,
+				},
+			}, nil)
+		})
+	})
+}
@@ -4,13 +4,14 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 )
 
-func ReadPromise(req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+func ReadPromise(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("ReadPromise", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		submission := &t_aio.Submission{
 			Kind: t_aio.Store,
@@ -56,7 +57,7 @@ func ReadPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedule
 				}
 
 				if p.State == promise.Pending && s.Time() >= p.Timeout {
-					s.Add(TimeoutPromise(p, ReadPromise(req, res), func(err error) {
+					s.Add(TimeoutPromise(config, p, ReadPromise(config, req, res), func(err error) {
 						if err != nil {
 							slog.Error("failed to timeout promise", "req", req, "err", err)
 							res(nil, err)
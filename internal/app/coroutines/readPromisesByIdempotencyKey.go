@@ -0,0 +1,78 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/promise"
+)
+
+func ReadPromisesByIdempotencyKey(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("ReadPromisesByIdempotencyKey", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.ReadPromisesByIdempotencyKey,
+							ReadPromisesByIdempotencyKey: &t_aio.ReadPromisesByIdempotencyKeyCommand{
+								IdempotencyKey: req.ReadPromisesByIdempotencyKey.IdempotencyKey,
+								Limit:          req.ReadPromisesByIdempotencyKey.Limit,
+								SortId:         req.ReadPromisesByIdempotencyKey.SortId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to read promises by idempotency key", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			result := completion.Store.Results[0].ReadPromisesByIdempotencyKey
+			promises := []*promise.Promise{}
+
+			for _, record := range result.Records {
+				promise, err := record.Promise()
+				if err != nil {
+					slog.Warn("failed to parse promise record", "record", record, "err", err)
+					continue
+				}
+
+				promises = append(promises, promise)
+			}
+
+			// set cursor only if there are more results
+			var cursor *t_api.Cursor[t_api.ReadPromisesByIdempotencyKeyRequest]
+			if result.RowsReturned == int64(req.ReadPromisesByIdempotencyKey.Limit) {
+				cursor = &t_api.Cursor[t_api.ReadPromisesByIdempotencyKeyRequest]{
+					Next: &t_api.ReadPromisesByIdempotencyKeyRequest{
+						IdempotencyKey: req.ReadPromisesByIdempotencyKey.IdempotencyKey,
+						Limit:          req.ReadPromisesByIdempotencyKey.Limit,
+						SortId:         &result.LastSortId,
+					},
+				}
+			}
+
+			res(&t_api.Response{
+				Kind: t_api.ReadPromisesByIdempotencyKey,
+				ReadPromisesByIdempotencyKey: &t_api.ReadPromisesByIdempotencyKeyResponse{
+					Status:   t_api.ResponseOK,
+					Cursor:   cursor,
+					Promises: promises,
+				},
+			}, nil)
+		})
+	})
+}
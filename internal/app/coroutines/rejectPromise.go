@@ -4,13 +4,20 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 )
 
-func RejectPromise(req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+func RejectPromise(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return rejectPromise(config, req, nil, res)
+}
+
+// rejectPromise skips reading the promise if the promise has already been
+// read, retries always read the promise again.
+func rejectPromise(config *system.Config, req *t_api.Request, read *t_aio.Result, res func(*t_api.Response, error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("RejectPromise", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		if req.RejectPromise.Value.Headers == nil {
 			req.RejectPromise.Value.Headers = map[string]string{}
@@ -35,7 +42,7 @@ func RejectPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 			},
 		}
 
-		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+		continuation := func(completion *t_aio.Completion, err error) {
 			if err != nil {
 				slog.Error("failed to read promise", "req", req, "err", err)
 				res(nil, err)
@@ -64,7 +71,7 @@ func RejectPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 
 				if p.State == promise.Pending {
 					if s.Time() >= p.Timeout {
-						s.Add(TimeoutPromise(p, RejectPromise(req, res), func(err error) {
+						s.Add(TimeoutPromise(config, p, RejectPromise(config, req, res), func(err error) {
 							if err != nil {
 								slog.Error("failed to timeout promise", "req", req, "err", err)
 								res(nil, err)
@@ -141,26 +148,31 @@ func RejectPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 							util.Assert(result.RowsAffected == 0 || result.RowsAffected == 1, "result must return 0 or 1 rows")
 
 							if result.RowsAffected == 1 {
+								completed := &promise.Promise{
+									Id:                        p.Id,
+									State:                     promise.Rejected,
+									Param:                     p.Param,
+									Value:                     req.RejectPromise.Value,
+									Timeout:                   p.Timeout,
+									IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
+									IdempotencyKeyForComplete: req.RejectPromise.IdempotencyKey,
+									Tags:                      p.Tags,
+									CreatedOn:                 p.CreatedOn,
+									CompletedOn:               &completedOn,
+								}
+
+								// notify in-process listeners
+								config.Events.Publish(completed)
+
 								res(&t_api.Response{
 									Kind: t_api.RejectPromise,
 									RejectPromise: &t_api.RejectPromiseResponse{
-										Status: t_api.ResponseCreated,
-										Promise: &promise.Promise{
-											Id:                        p.Id,
-											State:                     promise.Rejected,
-											Param:                     p.Param,
-											Value:                     req.RejectPromise.Value,
-											Timeout:                   p.Timeout,
-											IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
-											IdempotencyKeyForComplete: req.RejectPromise.IdempotencyKey,
-											Tags:                      p.Tags,
-											CreatedOn:                 p.CreatedOn,
-											CompletedOn:               &completedOn,
-										},
+										Status:  t_api.ResponseCreated,
+										Promise: completed,
 									},
 								}, nil)
 							} else {
-								s.Add(RejectPromise(req, res))
+								s.Add(RejectPromise(config, req, res))
 							}
 						})
 					}
@@ -168,7 +180,7 @@ func RejectPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 					status := t_api.ResponseForbidden
 					strict := req.RejectPromise.Strict && p.State != promise.Rejected
 
-					if !strict && p.IdempotencyKeyForComplete.Match(req.RejectPromise.IdempotencyKey) {
+					if !strict && idempotencyKeyMatch(config, p.IdempotencyKeyForComplete, req.RejectPromise.IdempotencyKey, p.CompletedOn, s.Time()) {
 						status = t_api.ResponseOK
 					}
 
@@ -181,6 +193,12 @@ func RejectPromise(req *t_api.Request, res func(*t_api.Response, error)) *schedu
 					}, nil)
 				}
 			}
-		})
+		}
+
+		if read != nil {
+			continuation(storeCompletion(read), nil)
+		} else {
+			c.Yield(submission, continuation)
+		}
 	})
 }
@@ -0,0 +1,129 @@
+package coroutines
+
+import (
+	"log/slog"
+
+	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+func ReplayDeadNotification(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return scheduler.NewCoroutine("ReplayDeadNotification", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
+		submission := &t_aio.Submission{
+			Kind: t_aio.Store,
+			Store: &t_aio.StoreSubmission{
+				Transaction: &t_aio.Transaction{
+					Commands: []*t_aio.Command{
+						{
+							Kind: t_aio.ReadDeadNotification,
+							ReadDeadNotification: &t_aio.ReadDeadNotificationCommand{
+								Id:        req.ReplayDeadNotification.Id,
+								PromiseId: req.ReplayDeadNotification.PromiseId,
+							},
+						},
+					},
+				},
+			},
+		}
+
+		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+			if err != nil {
+				slog.Error("failed to read dead notification", "req", req, "err", err)
+				res(nil, err)
+				return
+			}
+
+			util.Assert(completion.Store != nil, "completion must not be nil")
+
+			result := completion.Store.Results[0].ReadDeadNotification
+			util.Assert(result.RowsReturned == 0 || result.RowsReturned == 1, "result must return 0 or 1 rows")
+
+			if result.RowsReturned == 0 {
+				res(&t_api.Response{
+					Kind: t_api.ReplayDeadNotification,
+					ReplayDeadNotification: &t_api.ReplayDeadNotificationResponse{
+						Status: t_api.ResponseNotFound,
+					},
+				}, nil)
+				return
+			}
+
+			deadNotification, err := result.Records[0].DeadNotification()
+			if err != nil {
+				slog.Error("failed to parse dead notification record", "record", result.Records[0], "err", err)
+				res(nil, err)
+				return
+			}
+
+			// the transaction is atomic so that the notification is only
+			// created if the dead notification was not deleted in the
+			// meantime
+			submission := &t_aio.Submission{
+				Kind: t_aio.Store,
+				Store: &t_aio.StoreSubmission{
+					Transaction: &t_aio.Transaction{
+						Atomic: true,
+						Commands: []*t_aio.Command{
+							{
+								Kind: t_aio.DeleteDeadNotification,
+								DeleteDeadNotification: &t_aio.DeleteDeadNotificationCommand{
+									Id:        deadNotification.Id,
+									PromiseId: deadNotification.PromiseId,
+								},
+							},
+							{
+								Kind: t_aio.CreateNotification,
+								CreateNotification: &t_aio.CreateNotificationCommand{
+									Id:          deadNotification.Id,
+									PromiseId:   deadNotification.PromiseId,
+									Url:         deadNotification.Url,
+									Method:      deadNotification.Method,
+									Headers:     deadNotification.Headers,
+									Payload:     deadNotification.Payload,
+									Secret:      deadNotification.Secret,
+									RetryPolicy: deadNotification.RetryPolicy,
+									Time:        s.Time(),
+									Attempt:     0,
+								},
+							},
+						},
+					},
+				},
+			}
+
+			c.Yield(submission, func(completion *t_aio.Completion, err error) {
+				if err != nil {
+					slog.Error("failed to replay dead notification", "req", req, "err", err)
+					res(nil, err)
+					return
+				}
+
+				util.Assert(completion.Store != nil, "completion must not be nil")
+
+				result := completion.Store.Results[0].DeleteDeadNotification
+				util.Assert(result.RowsAffected == 0 || result.RowsAffected == 1, "result must return 0 or 1 rows")
+
+				if result.RowsAffected == 0 {
+					res(&t_api.Response{
+						Kind: t_api.ReplayDeadNotification,
+						ReplayDeadNotification: &t_api.ReplayDeadNotificationResponse{
+							Status: t_api.ResponseNotFound,
+						},
+					}, nil)
+					return
+				}
+
+				res(&t_api.Response{
+					Kind: t_api.ReplayDeadNotification,
+					ReplayDeadNotification: &t_api.ReplayDeadNotificationResponse{
+						Status:           t_api.ResponseOK,
+						DeadNotification: deadNotification,
+					},
+				}, nil)
+			})
+		})
+	})
+}
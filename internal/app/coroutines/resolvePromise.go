@@ -4,13 +4,20 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 )
 
-func ResolvePromise(req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+func ResolvePromise(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+	return resolvePromise(config, req, nil, res)
+}
+
+// resolvePromise skips reading the promise if the promise has already been
+// read, retries always read the promise again.
+func resolvePromise(config *system.Config, req *t_api.Request, read *t_aio.Result, res func(*t_api.Response, error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("ResolvePromise", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		if req.ResolvePromise.Value.Headers == nil {
 			req.ResolvePromise.Value.Headers = map[string]string{}
@@ -35,7 +42,7 @@ func ResolvePromise(req *t_api.Request, res func(*t_api.Response, error)) *sched
 			},
 		}
 
-		c.Yield(submission, func(completion *t_aio.Completion, err error) {
+		continuation := func(completion *t_aio.Completion, err error) {
 			if err != nil {
 				slog.Error("failed to read promise", "req", req, "err", err)
 				res(nil, err)
@@ -64,7 +71,7 @@ func ResolvePromise(req *t_api.Request, res func(*t_api.Response, error)) *sched
 
 				if p.State == promise.Pending {
 					if s.Time() >= p.Timeout {
-						s.Add(TimeoutPromise(p, ResolvePromise(req, res), func(err error) {
+						s.Add(TimeoutPromise(config, p, ResolvePromise(config, req, res), func(err error) {
 							if err != nil {
 								slog.Error("failed to timeout promise", "req", req, "err", err)
 								res(nil, err)
@@ -141,25 +148,31 @@ func ResolvePromise(req *t_api.Request, res func(*t_api.Response, error)) *sched
 							util.Assert(result.RowsAffected == 0 || result.RowsAffected == 1, "result must return 0 or 1 rows")
 
 							if result.RowsAffected == 1 {
+								completed := &promise.Promise{
+									Id:                        p.Id,
+									State:                     promise.Resolved,
+									Param:                     p.Param,
+									Value:                     req.ResolvePromise.Value,
+									Timeout:                   p.Timeout,
+									IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
+									IdempotencyKeyForComplete: req.ResolvePromise.IdempotencyKey,
+									Tags:                      p.Tags,
+									CreatedOn:                 p.CreatedOn,
+									CompletedOn:               &completedOn,
+								}
+
+								// notify in-process listeners
+								config.Events.Publish(completed)
+
 								res(&t_api.Response{
 									Kind: t_api.ResolvePromise,
 									ResolvePromise: &t_api.ResolvePromiseResponse{
-										Status: t_api.ResponseCreated,
-										Promise: &promise.Promise{
-											Id:                        p.Id,
-											State:                     promise.Resolved,
-											Param:                     p.Param,
-											Value:                     req.ResolvePromise.Value,
-											Timeout:                   p.Timeout,
-											IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
-											IdempotencyKeyForComplete: req.ResolvePromise.IdempotencyKey,
-											CreatedOn:                 p.CreatedOn,
-											CompletedOn:               &completedOn,
-										},
+										Status:  t_api.ResponseCreated,
+										Promise: completed,
 									},
 								}, nil)
 							} else {
-								s.Add(ResolvePromise(req, res))
+								s.Add(ResolvePromise(config, req, res))
 							}
 						})
 					}
@@ -167,7 +180,7 @@ func ResolvePromise(req *t_api.Request, res func(*t_api.Response, error)) *sched
 					status := t_api.ResponseForbidden
 					strict := req.ResolvePromise.Strict && p.State != promise.Resolved
 
-					if !strict && p.IdempotencyKeyForComplete.Match(req.ResolvePromise.IdempotencyKey) {
+					if !strict && idempotencyKeyMatch(config, p.IdempotencyKeyForComplete, req.ResolvePromise.IdempotencyKey, p.CompletedOn, s.Time()) {
 						status = t_api.ResponseOK
 					}
 
@@ -180,6 +193,12 @@ func ResolvePromise(req *t_api.Request, res func(*t_api.Response, error)) *sched
 					}, nil)
 				}
 			}
-		})
+		}
+
+		if read != nil {
+			continuation(storeCompletion(read), nil)
+		} else {
+			c.Yield(submission, continuation)
+		}
 	})
 }
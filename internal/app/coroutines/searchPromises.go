@@ -4,13 +4,14 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 )
 
-func SearchPromises(req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
+func SearchPromises(config *system.Config, req *t_api.Request, res func(*t_api.Response, error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("SearchPromises", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		util.Assert(req.SearchPromises.Q != "", "query must not be empty")
 		util.Assert(req.SearchPromises.Limit > 0, "limit must be greater than zero")
@@ -41,10 +42,19 @@ func SearchPromises(req *t_api.Request, res func(*t_api.Response, error)) *sched
 						{
 							Kind: t_aio.SearchPromises,
 							SearchPromises: &t_aio.SearchPromisesCommand{
-								Q:      req.SearchPromises.Q,
-								States: req.SearchPromises.States,
-								Limit:  req.SearchPromises.Limit,
-								SortId: req.SearchPromises.SortId,
+								Q:               req.SearchPromises.Q,
+								States:          req.SearchPromises.States,
+								Tags:            req.SearchPromises.Tags,
+								CreatedAfter:    req.SearchPromises.CreatedAfter,
+								CreatedBefore:   req.SearchPromises.CreatedBefore,
+								CompletedAfter:  req.SearchPromises.CompletedAfter,
+								CompletedBefore: req.SearchPromises.CompletedBefore,
+								TimeoutBefore:   req.SearchPromises.TimeoutBefore,
+								SortBy:          req.SearchPromises.SortBy,
+								SortOrder:       req.SearchPromises.SortOrder,
+								Limit:           req.SearchPromises.Limit,
+								SortId:          req.SearchPromises.SortId,
+								SortValue:       req.SearchPromises.SortValue,
 							},
 						},
 					},
@@ -78,12 +88,23 @@ func SearchPromises(req *t_api.Request, res func(*t_api.Response, error)) *sched
 			// set cursor only if there are more results
 			var cursor *t_api.Cursor[t_api.SearchPromisesRequest]
 			if result.RowsReturned == int64(req.SearchPromises.Limit) {
+				sortValue := req.SearchPromises.SortBy.Value(result.Records[len(result.Records)-1])
+
 				cursor = &t_api.Cursor[t_api.SearchPromisesRequest]{
 					Next: &t_api.SearchPromisesRequest{
-						Q:      req.SearchPromises.Q,
-						States: req.SearchPromises.States,
-						Limit:  req.SearchPromises.Limit,
-						SortId: &result.LastSortId,
+						Q:               req.SearchPromises.Q,
+						States:          req.SearchPromises.States,
+						Tags:            req.SearchPromises.Tags,
+						CreatedAfter:    req.SearchPromises.CreatedAfter,
+						CreatedBefore:   req.SearchPromises.CreatedBefore,
+						CompletedAfter:  req.SearchPromises.CompletedAfter,
+						CompletedBefore: req.SearchPromises.CompletedBefore,
+						TimeoutBefore:   req.SearchPromises.TimeoutBefore,
+						SortBy:          req.SearchPromises.SortBy,
+						SortOrder:       req.SearchPromises.SortOrder,
+						Limit:           req.SearchPromises.Limit,
+						SortId:          &result.LastSortId,
+						SortValue:       &sortValue,
 					},
 				}
 			}
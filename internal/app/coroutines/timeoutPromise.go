@@ -4,44 +4,19 @@ import (
 	"log/slog"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
+	"github.com/resonatehq/resonate/internal/kernel/system"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 )
 
-func TimeoutPromise(p *promise.Promise, retry *scheduler.Coroutine, res func(error)) *scheduler.Coroutine {
+func TimeoutPromise(config *system.Config, p *promise.Promise, retry *scheduler.Coroutine, res func(error)) *scheduler.Coroutine {
 	return scheduler.NewCoroutine("TimeoutPromise", func(s *scheduler.Scheduler, c *scheduler.Coroutine) {
 		submission := &t_aio.Submission{
 			Kind: t_aio.Store,
 			Store: &t_aio.StoreSubmission{
 				Transaction: &t_aio.Transaction{
-					Commands: []*t_aio.Command{
-						{
-							Kind: t_aio.UpdatePromise,
-							UpdatePromise: &t_aio.UpdatePromiseCommand{
-								Id:    p.Id,
-								State: promise.Timedout,
-								Value: promise.Value{
-									Headers: map[string]string{},
-									Data:    []byte{},
-								},
-								CompletedOn: p.Timeout,
-							},
-						},
-						{
-							Kind: t_aio.CreateNotifications,
-							CreateNotifications: &t_aio.CreateNotificationsCommand{
-								PromiseId: p.Id,
-								Time:      s.Time(),
-							},
-						},
-						{
-							Kind: t_aio.DeleteSubscriptions,
-							DeleteSubscriptions: &t_aio.DeleteSubscriptionsCommand{
-								PromiseId: p.Id,
-							},
-						},
-					},
+					Commands: timeoutCommands(p, s.Time()),
 				},
 			},
 		}
@@ -59,6 +34,9 @@ func TimeoutPromise(p *promise.Promise, retry *scheduler.Coroutine, res func(err
 			util.Assert(result.RowsAffected == 0 || result.RowsAffected == 1, "result must return 0 or 1 rows")
 
 			if result.RowsAffected == 1 {
+				// notify in-process listeners
+				config.Events.Publish(p.Timedout())
+
 				res(nil)
 			} else {
 				s.Add(retry)
@@ -66,3 +44,35 @@ func TimeoutPromise(p *promise.Promise, retry *scheduler.Coroutine, res func(err
 		})
 	})
 }
+
+// timeoutCommands returns the commands that time out a pending promise,
+// the first command updates the promise.
+func timeoutCommands(p *promise.Promise, time int64) []*t_aio.Command {
+	return []*t_aio.Command{
+		{
+			Kind: t_aio.UpdatePromise,
+			UpdatePromise: &t_aio.UpdatePromiseCommand{
+				Id:    p.Id,
+				State: promise.Timedout,
+				Value: promise.Value{
+					Headers: map[string]string{},
+					Data:    []byte{},
+				},
+				CompletedOn: p.Timeout,
+			},
+		},
+		{
+			Kind: t_aio.CreateNotifications,
+			CreateNotifications: &t_aio.CreateNotificationsCommand{
+				PromiseId: p.Id,
+				Time:      time,
+			},
+		},
+		{
+			Kind: t_aio.DeleteSubscriptions,
+			DeleteSubscriptions: &t_aio.DeleteSubscriptionsCommand{
+				PromiseId: p.Id,
+			},
+		},
+	}
+}
@@ -56,6 +56,10 @@ func TimeoutPromises(config *system.Config) *scheduler.Coroutine {
 			if promises == 0 {
 				util.Assert(subscriptions == 0 && notifications == 0, "must not create notifications when no promises timed out")
 			}
+
+			// notify in-process listeners, this includes listeners
+			// on promises that timed out on a previous tick
+			config.Events.Timeout(s.Time())
 		})
 	})
 }
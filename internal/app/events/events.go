@@ -0,0 +1,109 @@
+package events
+
+import (
+	"sync"
+
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/promise"
+)
+
+// Events fans out promise completions, as observed by the kernel, to
+// in-process listeners. Each subscription receives at most one
+// promise, the promise in its final state.
+type Events struct {
+	mu            sync.Mutex
+	subscriptions map[string][]*Subscription
+}
+
+type Subscription struct {
+	C       <-chan *promise.Promise
+	c       chan *promise.Promise
+	id      string
+	promise *promise.Promise
+}
+
+func New() *Events {
+	return &Events{
+		subscriptions: map[string][]*Subscription{},
+	}
+}
+
+func (e *Events) Subscribe(id string) *Subscription {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	c := make(chan *promise.Promise, 1)
+	s := &Subscription{C: c, c: c, id: id}
+	e.subscriptions[id] = append(e.subscriptions[id], s)
+
+	return s
+}
+
+func (e *Events) Unsubscribe(s *Subscription) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	subscriptions := e.subscriptions[s.id]
+	for i, sub := range subscriptions {
+		if sub == s {
+			subscriptions = append(subscriptions[:i], subscriptions[i+1:]...)
+			break
+		}
+	}
+
+	if len(subscriptions) == 0 {
+		delete(e.subscriptions, s.id)
+	} else {
+		e.subscriptions[s.id] = subscriptions
+	}
+}
+
+// Pending records the pending promise a subscription is waiting on,
+// this is required to publish timeouts that are detected in bulk.
+func (e *Events) Pending(s *Subscription, p *promise.Promise) {
+	util.Assert(s.id == p.Id, "promise id must match subscription")
+	util.Assert(p.State == promise.Pending, "promise must be pending")
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	s.promise = p
+}
+
+func (e *Events) Publish(p *promise.Promise) {
+	util.Assert(p.State != promise.Pending, "promise must not be pending")
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, s := range e.subscriptions[p.Id] {
+		s.c <- p
+	}
+
+	delete(e.subscriptions, p.Id)
+}
+
+// Timeout publishes a timedout promise to all subscriptions waiting on
+// a pending promise with a timeout less than or equal to t.
+func (e *Events) Timeout(t int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, kv := range util.OrderedRangeKV(e.subscriptions) {
+		var subscriptions []*Subscription
+
+		for _, s := range kv.Value {
+			if s.promise != nil && s.promise.Timeout <= t {
+				s.c <- s.promise.Timedout()
+			} else {
+				subscriptions = append(subscriptions, s)
+			}
+		}
+
+		if len(subscriptions) == 0 {
+			delete(e.subscriptions, kv.Key)
+		} else {
+			e.subscriptions[kv.Key] = subscriptions
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPublish(t *testing.T) {
+	events := New()
+
+	s1 := events.Subscribe("foo")
+	s2 := events.Subscribe("foo")
+	s3 := events.Subscribe("bar")
+
+	p := &promise.Promise{Id: "foo", State: promise.Resolved}
+	events.Publish(p)
+
+	assert.Equal(t, p, <-s1.C)
+	assert.Equal(t, p, <-s2.C)
+	assert.Len(t, s3.C, 0)
+
+	events.Unsubscribe(s3)
+	assert.Len(t, events.subscriptions, 0)
+}
+
+func TestTimeout(t *testing.T) {
+	events := New()
+
+	s1 := events.Subscribe("foo")
+	s2 := events.Subscribe("bar")
+	s3 := events.Subscribe("baz")
+
+	events.Pending(s1, &promise.Promise{Id: "foo", State: promise.Pending, Timeout: 1})
+	events.Pending(s2, &promise.Promise{Id: "bar", State: promise.Pending, Timeout: 2})
+
+	events.Timeout(1)
+
+	p := <-s1.C
+	assert.Equal(t, "foo", p.Id)
+	assert.Equal(t, promise.Timedout, p.State)
+	assert.Equal(t, int64(1), *p.CompletedOn)
+
+	// bar has not timed out and the timeout of baz is unknown
+	assert.Len(t, s2.C, 0)
+	assert.Len(t, s3.C, 0)
+	assert.Len(t, events.subscriptions, 2)
+}
@@ -0,0 +1,92 @@
+package network
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/receiver"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// conns caches a client connection per host, conns is shared by all
+// workers.
+type conns struct {
+	sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func newConns() *conns {
+	return &conns{
+		conns: map[string]*grpc.ClientConn{},
+	}
+}
+
+func (c *conns) get(host string) (*grpc.ClientConn, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	if conn, ok := c.conns[host]; ok {
+		return conn, nil
+	}
+
+	// connections are established lazily
+	conn, err := grpc.Dial(host, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	c.conns[host] = conn
+	return conn, nil
+}
+
+func (c *conns) close() error {
+	c.Lock()
+	defer c.Unlock()
+
+	var errs []error
+	for _, conn := range util.OrderedRange(c.conns) {
+		errs = append(errs, conn.Close())
+	}
+
+	c.conns = map[string]*grpc.ClientConn{}
+	return errors.Join(errs...)
+}
+
+func (d *NetworkDevice) grpcRequest(host string, r *t_aio.GrpcRequest) error {
+	conn, err := d.conns.get(host)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if d.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, d.timeout)
+		defer cancel()
+	}
+
+	// headers are sent as metadata
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(r.Headers))
+
+	_, err = receiver.NewNotificationReceiverClient(conn).Notify(ctx, receiver.FromPromise(r.Promise))
+	return err
+}
+
+// isHealthy returns false for grpc errors that indicate that the host
+// is unavailable or overloaded, the counterpart of server errors and
+// throttling for http requests.
+func isHealthy(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,146 @@
+package network
+
+import (
+	"sync"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/metrics"
+)
+
+// busyDelay is the time a request is deferred when its host is at
+// capacity or when the circuit of its host is probed.
+const busyDelay = 100 * time.Millisecond
+
+type circuitState int
+
+const (
+	closed circuitState = iota
+	open
+	halfOpen
+)
+
+// hosts limits the number of concurrent requests per host and tracks a
+// circuit breaker per host, hosts is shared by all workers.
+type hosts struct {
+	sync.Mutex
+	config   *Config
+	metrics  *metrics.Metrics
+	now      func() time.Time
+	inflight map[string]int
+	circuits map[string]*circuit
+}
+
+// circuit is only tracked for a host from the first failure until the
+// circuit is closed again.
+type circuit struct {
+	state    circuitState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+func newHosts(config *Config, metrics *metrics.Metrics) *hosts {
+	return &hosts{
+		config:   config,
+		metrics:  metrics,
+		now:      time.Now,
+		inflight: map[string]int{},
+		circuits: map[string]*circuit{},
+	}
+}
+
+// acquire reserves a request slot for a host, if a request must not be
+// sent acquire returns false and the time to wait before trying again.
+func (h *hosts) acquire(host string) (time.Duration, bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.config.HostConcurrency > 0 && h.inflight[host] >= h.config.HostConcurrency {
+		return busyDelay, false
+	}
+
+	if c, ok := h.circuits[host]; ok {
+		switch c.state {
+		case open:
+			if elapsed := h.now().Sub(c.openedAt); elapsed < h.config.BreakerTimeout {
+				return h.config.BreakerTimeout - elapsed, false
+			}
+
+			// let a single request probe the host
+			h.transition(host, c, halfOpen)
+			c.probing = true
+		case halfOpen:
+			if c.probing {
+				return busyDelay, false
+			}
+			c.probing = true
+		}
+	}
+
+	h.inflight[host]++
+	return 0, true
+}
+
+// release frees the request slot of a host and records the outcome of
+// the request with the circuit breaker of the host.
+func (h *hosts) release(host string, success bool) {
+	h.Lock()
+	defer h.Unlock()
+
+	if h.inflight[host]--; h.inflight[host] == 0 {
+		delete(h.inflight, host)
+	}
+
+	if h.config.BreakerFailures <= 0 {
+		return
+	}
+
+	c, ok := h.circuits[host]
+	if !ok {
+		if success {
+			return
+		}
+
+		c = &circuit{}
+		h.circuits[host] = c
+	}
+
+	switch c.state {
+	case closed:
+		if success {
+			delete(h.circuits, host)
+			return
+		}
+
+		if c.failures++; c.failures >= h.config.BreakerFailures {
+			h.transition(host, c, open)
+		}
+	case halfOpen:
+		c.probing = false
+
+		if success {
+			delete(h.circuits, host)
+			h.transition(host, c, closed)
+		} else {
+			h.transition(host, c, open)
+		}
+	case open:
+		// requests sent before the circuit opened do not affect the
+		// circuit
+	}
+}
+
+func (h *hosts) transition(host string, c *circuit, state circuitState) {
+	c.state = state
+	if state == open {
+		c.openedAt = h.now()
+	}
+
+	// only hosts with a circuit that is not closed have a series, this
+	// bounds the number of series by the number of unavailable hosts
+	if state == closed {
+		h.metrics.AioNetworkCircuitState.DeleteLabelValues(host)
+	} else {
+		h.metrics.AioNetworkCircuitState.WithLabelValues(host).Set(float64(state))
+	}
+}
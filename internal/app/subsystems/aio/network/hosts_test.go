@@ -0,0 +1,87 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/resonatehq/resonate/internal/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostsConcurrency(t *testing.T) {
+	h := newHosts(&Config{HostConcurrency: 2}, metrics.New(prometheus.NewRegistry()))
+
+	for i := 0; i < 2; i++ {
+		_, ok := h.acquire("foo")
+		assert.True(t, ok)
+	}
+
+	// foo is at capacity, bar is not affected
+	delay, ok := h.acquire("foo")
+	assert.False(t, ok)
+	assert.Equal(t, busyDelay, delay)
+
+	_, ok = h.acquire("bar")
+	assert.True(t, ok)
+
+	h.release("foo", true)
+
+	_, ok = h.acquire("foo")
+	assert.True(t, ok)
+}
+
+func TestHostsCircuitBreaker(t *testing.T) {
+	m := metrics.New(prometheus.NewRegistry())
+	h := newHosts(&Config{BreakerFailures: 2, BreakerTimeout: 10 * time.Second}, m)
+
+	now := time.Unix(0, 0)
+	h.now = func() time.Time { return now }
+
+	send := func(success bool) {
+		_, ok := h.acquire("foo")
+		assert.True(t, ok)
+		h.release("foo", success)
+	}
+
+	// a success resets the consecutive failures
+	send(false)
+	send(true)
+	send(false)
+	assert.Equal(t, 0, testutil.CollectAndCount(m.AioNetworkCircuitState))
+
+	// the circuit opens after consecutive failures
+	send(false)
+	assert.Equal(t, float64(open), testutil.ToFloat64(m.AioNetworkCircuitState.WithLabelValues("foo")))
+
+	now = now.Add(4 * time.Second)
+	delay, ok := h.acquire("foo")
+	assert.False(t, ok)
+	assert.Equal(t, 6*time.Second, delay)
+
+	// once the timeout elapses a single request probes the host
+	now = now.Add(6 * time.Second)
+	_, ok = h.acquire("foo")
+	assert.True(t, ok)
+	assert.Equal(t, float64(halfOpen), testutil.ToFloat64(m.AioNetworkCircuitState.WithLabelValues("foo")))
+
+	delay, ok = h.acquire("foo")
+	assert.False(t, ok)
+	assert.Equal(t, busyDelay, delay)
+
+	// a failed probe opens the circuit again
+	h.release("foo", false)
+	assert.Equal(t, float64(open), testutil.ToFloat64(m.AioNetworkCircuitState.WithLabelValues("foo")))
+
+	_, ok = h.acquire("foo")
+	assert.False(t, ok)
+
+	// a successful probe closes the circuit
+	now = now.Add(10 * time.Second)
+	send(true)
+	assert.Equal(t, 0, testutil.CollectAndCount(m.AioNetworkCircuitState))
+
+	send(false)
+	send(true)
+}
@@ -2,30 +2,47 @@ package network
 
 import (
 	"bytes"
+	"io"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/kernel/bus"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/metrics"
 	"github.com/resonatehq/resonate/internal/util"
 )
 
+// bodyLimit is the maximum number of bytes read from a response body,
+// the remainder of the body is discarded.
+const bodyLimit = 1024
+
 type Config struct {
-	Timeout time.Duration
+	Timeout         time.Duration
+	HostConcurrency int
+	BreakerFailures int
+	BreakerTimeout  time.Duration
 }
 
 type Network struct {
 	config *Config
+	hosts  *hosts
+	conns  *conns
 }
 
 type NetworkDevice struct {
-	client *http.Client
+	client  *http.Client
+	timeout time.Duration
+	hosts   *hosts
+	conns   *conns
 }
 
-func New(config *Config) aio.Subsystem {
+func New(config *Config, metrics *metrics.Metrics) aio.Subsystem {
 	return &Network{
 		config: config,
+		hosts:  newHosts(config, metrics),
+		conns:  newConns(),
 	}
 }
 
@@ -38,7 +55,7 @@ func (n *Network) Start() error {
 }
 
 func (n *Network) Stop() error {
-	return nil
+	return n.conns.close()
 }
 
 func (n *Network) Reset() error {
@@ -50,6 +67,9 @@ func (n *Network) NewWorker(int) aio.Worker {
 		client: &http.Client{
 			Timeout: n.config.Timeout,
 		},
+		timeout: n.config.Timeout,
+		hosts:   n.hosts,
+		conns:   n.conns,
 	}
 }
 
@@ -59,35 +79,89 @@ func (d *NetworkDevice) Process(sqes []*bus.SQE[t_aio.Submission, t_aio.Completi
 	for i, sqe := range sqes {
 		util.Assert(sqe.Submission.Network != nil, "submission must not be nil")
 
+		cqe := &bus.CQE[t_aio.Submission, t_aio.Completion]{
+			Tags:     sqe.Tags,
+			Callback: sqe.Callback,
+		}
+
 		switch sqe.Submission.Network.Kind {
 		case t_aio.Http:
-			cqe := &bus.CQE[t_aio.Submission, t_aio.Completion]{
-				Tags:     sqe.Tags,
-				Callback: sqe.Callback,
+			r := sqe.Submission.Network.Http
+
+			var res *http.Response
+			sent, delay, latency, err := d.send(r.Url, func(*url.URL) (bool, error) {
+				var err error
+				res, err = d.httpRequest(r)
+
+				// transport errors, server errors, and throttling count as
+				// failures towards the circuit of the host
+				return err == nil && res.StatusCode < 500 && res.StatusCode != http.StatusTooManyRequests, err
+			})
+
+			// the latency is reported even if the request fails
+			cqe.Error = err
+			cqe.Completion = &t_aio.Completion{
+				Kind: t_aio.Network,
+				Network: &t_aio.NetworkCompletion{
+					Kind:     t_aio.Http,
+					Http:     res,
+					Latency:  latency.Milliseconds(),
+					Deferred: !sent,
+					Delay:    delay.Milliseconds(),
+				},
 			}
-
-			res, err := d.httpRequest(sqe.Submission.Network.Http)
-			if err != nil {
-				cqe.Error = err
-			} else {
-				cqe.Completion = &t_aio.Completion{
-					Kind: t_aio.Network,
-					Network: &t_aio.NetworkCompletion{
-						Kind: t_aio.Http,
-						Http: res,
-					},
-				}
+		case t_aio.Grpc:
+			r := sqe.Submission.Network.Grpc
+
+			sent, delay, latency, err := d.send(r.Url, func(u *url.URL) (bool, error) {
+				err := d.grpcRequest(u.Host, r)
+				return isHealthy(err), err
+			})
+
+			cqe.Error = err
+			cqe.Completion = &t_aio.Completion{
+				Kind: t_aio.Network,
+				Network: &t_aio.NetworkCompletion{
+					Kind:     t_aio.Grpc,
+					Latency:  latency.Milliseconds(),
+					Deferred: !sent,
+					Delay:    delay.Milliseconds(),
+				},
 			}
-
-			cqes[i] = cqe
 		default:
 			panic("invalid network submission")
 		}
+
+		cqes[i] = cqe
 	}
 
 	return cqes
 }
 
+// send sends a request unless the host of the url is at capacity or
+// its circuit is open, in which case the request is not sent and the
+// time to wait before trying again is returned. Otherwise the latency
+// of the request is returned. The request reports whether its outcome
+// counts as a success towards the circuit of the host.
+func (d *NetworkDevice) send(rawUrl string, request func(*url.URL) (bool, error)) (bool, time.Duration, time.Duration, error) {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false, 0, 0, err
+	}
+
+	if delay, ok := d.hosts.acquire(u.Host); !ok {
+		return false, delay, 0, nil
+	}
+
+	start := time.Now()
+	success, err := request(u)
+	latency := time.Since(start)
+
+	d.hosts.release(u.Host, success)
+
+	return true, 0, latency, err
+}
+
 func (d *NetworkDevice) httpRequest(r *t_aio.HttpRequest) (*http.Response, error) {
 	req, err := http.NewRequest(r.Method, r.Url, bytes.NewBuffer(r.Body))
 	if err != nil {
@@ -98,5 +172,19 @@ func (d *NetworkDevice) httpRequest(r *t_aio.HttpRequest) (*http.Response, error
 		req.Header.Set(key, value)
 	}
 
-	return d.client.Do(req)
+	res, err := d.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	// buffer the response body so that the connection is always closed,
+	// only the first bytes of the body are of interest
+	body, err := io.ReadAll(io.LimitReader(res.Body, bodyLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	return res, nil
 }
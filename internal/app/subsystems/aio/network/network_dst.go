@@ -8,6 +8,8 @@ import (
 	"github.com/resonatehq/resonate/internal/kernel/bus"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 	"github.com/resonatehq/resonate/internal/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ConfigDST struct {
@@ -83,6 +85,24 @@ func (d *NetworkDSTDevice) Process(sqes []*bus.SQE[t_aio.Submission, t_aio.Compl
 				},
 			}
 
+			cqes[i] = cqe
+		case t_aio.Grpc:
+			cqe := &bus.CQE[t_aio.Submission, t_aio.Completion]{
+				Tags:     sqe.Tags,
+				Callback: sqe.Callback,
+			}
+
+			if d.r.Float32() < d.config.P {
+				cqe.Completion = &t_aio.Completion{
+					Kind: t_aio.Network,
+					Network: &t_aio.NetworkCompletion{
+						Kind: t_aio.Grpc,
+					},
+				}
+			} else {
+				cqe.Error = status.Error(codes.Unavailable, "unavailable")
+			}
+
 			cqes[i] = cqe
 		default:
 			panic("invalid network submission")
@@ -1,16 +1,28 @@
 package network
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/resonatehq/resonate/internal/kernel/bus"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/metrics"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/receiver"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestNetworkHttpRequest(t *testing.T) {
@@ -88,7 +100,7 @@ func TestNetworkHttpRequest(t *testing.T) {
 			}
 
 			config := &Config{Timeout: 0}
-			worker := New(config).NewWorker(0)
+			worker := New(config, metrics.New(prometheus.NewRegistry())).NewWorker(0)
 			cqes := worker.Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{sqe})
 
 			res := cqes[0].Completion.Network.Http
@@ -109,6 +121,177 @@ func TestNetworkHttpRequest(t *testing.T) {
 	}
 }
 
+func TestNetworkHttpRequestBodyLimit(t *testing.T) {
+	r := setup()
+	s := httptest.NewServer(r)
+	defer s.Close()
+
+	sqe := &bus.SQE[t_aio.Submission, t_aio.Completion]{
+		Submission: &t_aio.Submission{
+			Kind: t_aio.Network,
+			Network: &t_aio.NetworkSubmission{
+				Kind: t_aio.Http,
+				Http: &t_aio.HttpRequest{
+					Method: "POST",
+					Url:    fmt.Sprintf("%s/echo", s.URL),
+					Body:   bytes.Repeat([]byte("a"), 2*bodyLimit),
+				},
+			},
+		},
+	}
+
+	config := &Config{Timeout: 0}
+	worker := New(config, metrics.New(prometheus.NewRegistry())).NewWorker(0)
+	cqes := worker.Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{sqe})
+
+	res := cqes[0].Completion.Network.Http
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, bytes.Repeat([]byte("a"), bodyLimit), body)
+}
+
+func TestNetworkLatency(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	// a closed server refuses connections
+	closed := httptest.NewServer(http.NotFoundHandler())
+	closed.Close()
+
+	for _, url := range []string{s.URL, closed.URL} {
+		sqe := &bus.SQE[t_aio.Submission, t_aio.Completion]{
+			Submission: &t_aio.Submission{
+				Kind: t_aio.Network,
+				Network: &t_aio.NetworkSubmission{
+					Kind: t_aio.Http,
+					Http: &t_aio.HttpRequest{
+						Method: "GET",
+						Url:    url,
+					},
+				},
+			},
+		}
+
+		worker := New(&Config{Timeout: 0}, metrics.New(prometheus.NewRegistry())).NewWorker(0)
+		cqes := worker.Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{sqe})
+
+		// the latency is reported for failed requests too
+		assert.NotNil(t, cqes[0].Completion)
+		if url == s.URL {
+			assert.Nil(t, cqes[0].Error)
+			assert.GreaterOrEqual(t, cqes[0].Completion.Network.Latency, int64(20))
+		} else {
+			assert.NotNil(t, cqes[0].Error)
+			assert.GreaterOrEqual(t, cqes[0].Completion.Network.Latency, int64(0))
+		}
+	}
+}
+
+func TestNetworkGrpcRequest(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := &notificationReceiver{promises: make(chan *receiver.Promise, 1), metadata: make(chan metadata.MD, 1)}
+
+	s := grpc.NewServer()
+	receiver.RegisterNotificationReceiverServer(s, r)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Error(err)
+		}
+	}()
+	defer s.Stop()
+
+	testCases := []struct {
+		name string
+		id   string
+		code codes.Code
+	}{
+		{
+			name: "ok",
+			id:   "foo",
+			code: codes.OK,
+		},
+		{
+			name: "unavailable",
+			id:   "unavailable",
+			code: codes.Unavailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sqe := &bus.SQE[t_aio.Submission, t_aio.Completion]{
+				Submission: &t_aio.Submission{
+					Kind: t_aio.Network,
+					Network: &t_aio.NetworkSubmission{
+						Kind: t_aio.Grpc,
+						Grpc: &t_aio.GrpcRequest{
+							Headers: map[string]string{"a": "a"},
+							Url:     fmt.Sprintf("grpc://%s", lis.Addr().String()),
+							Promise: &promise.Promise{
+								Id:    tc.id,
+								State: promise.Resolved,
+								Value: promise.Value{Headers: map[string]string{}, Data: []byte("bar")},
+							},
+						},
+					},
+				},
+			}
+
+			n := New(&Config{Timeout: 5 * time.Second}, metrics.New(prometheus.NewRegistry()))
+			cqes := n.NewWorker(0).Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{sqe})
+
+			if err := n.Stop(); err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.code == codes.OK {
+				assert.Nil(t, cqes[0].Error)
+				assert.Equal(t, t_aio.Grpc, cqes[0].Completion.Network.Kind)
+			} else {
+				assert.Equal(t, tc.code, status.Code(cqes[0].Error))
+			}
+
+			p := <-r.promises
+			assert.Equal(t, tc.id, p.Id)
+			assert.Equal(t, receiver.State_RESOLVED, p.State)
+			assert.Equal(t, []byte("bar"), p.Value.Data)
+
+			md := <-r.metadata
+			assert.Equal(t, []string{"a"}, md.Get("a"))
+		})
+	}
+}
+
+type notificationReceiver struct {
+	receiver.UnimplementedNotificationReceiverServer
+	promises chan *receiver.Promise
+	metadata chan metadata.MD
+}
+
+func (r *notificationReceiver) Notify(ctx context.Context, p *receiver.Promise) (*receiver.NotifyResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	r.promises <- p
+	r.metadata <- md
+
+	if p.Id == "unavailable" {
+		return nil, status.Error(codes.Unavailable, "unavailable")
+	}
+
+	return &receiver.NotifyResponse{}, nil
+}
+
 type server struct{}
 
 func (s *server) echo(c *gin.Context) {
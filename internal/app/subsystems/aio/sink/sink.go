@@ -0,0 +1,166 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/promise"
+)
+
+type Format string
+
+const (
+	NDJSON Format = "ndjson"
+)
+
+type Config struct {
+	Dir    string
+	Format Format
+}
+
+// Sink writes promises to files in a local directory, each submission
+// is written to a new file which is synced to disk before the
+// submission completes.
+type Sink struct {
+	config *Config
+	seq    atomic.Int64
+}
+
+type SinkDevice struct {
+	*Sink
+	id int
+}
+
+func New(config *Config) aio.Subsystem {
+	return &Sink{
+		config: config,
+	}
+}
+
+func (s *Sink) String() string {
+	return "sink"
+}
+
+func (s *Sink) Start() error {
+	switch s.config.Format {
+	case NDJSON:
+	default:
+		return fmt.Errorf("unsupported sink format %q", s.config.Format)
+	}
+
+	return os.MkdirAll(s.config.Dir, 0755)
+}
+
+func (s *Sink) Stop() error {
+	return nil
+}
+
+func (s *Sink) Reset() error {
+	return nil
+}
+
+func (s *Sink) NewWorker(id int) aio.Worker {
+	return &SinkDevice{s, id}
+}
+
+func (d *SinkDevice) Process(sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]) []*bus.CQE[t_aio.Submission, t_aio.Completion] {
+	cqes := make([]*bus.CQE[t_aio.Submission, t_aio.Completion], len(sqes))
+
+	for i, sqe := range sqes {
+		util.Assert(sqe.Submission.Sink != nil, "submission must not be nil")
+
+		cqe := &bus.CQE[t_aio.Submission, t_aio.Completion]{
+			Tags:     sqe.Tags,
+			Callback: sqe.Callback,
+		}
+
+		path, err := d.write(sqe.Submission.Sink.Records)
+		if err != nil {
+			cqe.Error = err
+		} else {
+			cqe.Completion = &t_aio.Completion{
+				Kind: t_aio.Sink,
+				Sink: &t_aio.SinkCompletion{
+					Path:        path,
+					RowsWritten: int64(len(sqe.Submission.Sink.Records)),
+				},
+			}
+		}
+
+		cqes[i] = cqe
+	}
+
+	return cqes
+}
+
+// write writes the records to a temporary file which is renamed once
+// all records are synced, a file in the directory is always complete.
+func (d *SinkDevice) write(records []*promise.PromiseRecord) (string, error) {
+	name := fmt.Sprintf("promises-%d-%d-%d.%s", time.Now().UnixMilli(), d.id, d.seq.Add(1), d.config.Format)
+	path := filepath.Join(d.config.Dir, name)
+	tmp := path + ".tmp"
+
+	f, err := os.Create(tmp)
+	if err != nil {
+		return "", err
+	}
+
+	if err := encode(f, records); err != nil {
+		_ = f.Close()
+		_ = os.Remove(tmp)
+		return "", err
+	}
+
+	if err := f.Close(); err != nil {
+		_ = os.Remove(tmp)
+		return "", err
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		_ = os.Remove(tmp)
+		return "", err
+	}
+
+	return path, syncDir(d.config.Dir)
+}
+
+func encode(f *os.File, records []*promise.PromiseRecord) error {
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+
+	for _, record := range records {
+		p, err := record.Promise()
+		if err != nil {
+			return err
+		}
+
+		if err := enc.Encode(p); err != nil {
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	return f.Sync()
+}
+
+func syncDir(dir string) error {
+	f, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return f.Sync()
+}
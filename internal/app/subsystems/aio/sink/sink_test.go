@@ -0,0 +1,99 @@
+package sink
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSinkNDJSON(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "archive")
+
+	sink := New(&Config{Dir: dir, Format: NDJSON})
+	if err := sink.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	completedOn := int64(2)
+	records := []*promise.PromiseRecord{
+		{
+			Id:           "foo",
+			State:        promise.Resolved,
+			ParamHeaders: []byte(`{"a":"a"}`),
+			ParamData:    []byte("param"),
+			ValueHeaders: []byte(`{"b":"b"}`),
+			ValueData:    []byte("value"),
+			Timeout:      10,
+			Tags:         []byte(`{"c":"c"}`),
+			CompletedOn:  &completedOn,
+		},
+		{
+			Id:           "bar",
+			State:        promise.Rejected,
+			ParamHeaders: []byte(`{"d":"d"}`),
+			ValueHeaders: []byte(`{"e":"e"}`),
+			Tags:         []byte("{}"),
+			CompletedOn:  &completedOn,
+		},
+	}
+
+	cqes := sink.NewWorker(0).Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{
+		{
+			Submission: &t_aio.Submission{
+				Kind: t_aio.Sink,
+				Sink: &t_aio.SinkSubmission{
+					Records: records,
+				},
+			},
+		},
+	})
+
+	assert.Len(t, cqes, 1)
+	assert.Nil(t, cqes[0].Error)
+	assert.Equal(t, int64(2), cqes[0].Completion.Sink.RowsWritten)
+
+	// only the complete file remains in the directory
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Len(t, entries, 1)
+	assert.Equal(t, filepath.Join(dir, entries[0].Name()), cqes[0].Completion.Sink.Path)
+
+	f, err := os.Open(cqes[0].Completion.Sink.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var promises []*promise.Promise
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		p := &promise.Promise{}
+		if err := json.Unmarshal(scanner.Bytes(), p); err != nil {
+			t.Fatal(err)
+		}
+		promises = append(promises, p)
+	}
+
+	assert.Len(t, promises, 2)
+	for i, record := range records {
+		expected, err := record.Promise()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, expected, promises[i])
+	}
+}
+
+func TestSinkUnsupportedFormat(t *testing.T) {
+	sink := New(&Config{Dir: t.TempDir(), Format: "csv"})
+	assert.Error(t, sink.Start())
+}
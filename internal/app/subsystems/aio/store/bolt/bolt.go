@@ -0,0 +1,1381 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/notification"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/subscription"
+	"github.com/resonatehq/resonate/pkg/timeout"
+
+	"go.etcd.io/bbolt"
+)
+
+// Buckets, keys are composed of ids separated by a zero byte and of
+// integers encoded so that byte order matches numeric order.
+var (
+	// id -> promise
+	PROMISES = []byte("promises")
+
+	// sort id -> id
+	PROMISES_BY_SORT_ID = []byte("promises_by_sort_id")
+
+	// timeout, id -> nil, pending promises only
+	PROMISES_BY_TIMEOUT = []byte("promises_by_timeout")
+
+	// completed on, id -> nil, completed promises without a retention
+	PROMISES_BY_COMPLETED_ON = []byte("promises_by_completed_on")
+
+	// completed on + retention, id -> nil, completed promises with a
+	// retention
+	PROMISES_BY_EXPIRY = []byte("promises_by_expiry")
+
+	// id -> time
+	TIMEOUTS = []byte("timeouts")
+
+	// time, id -> nil
+	TIMEOUTS_BY_TIME = []byte("timeouts_by_time")
+
+	// promise id, id -> subscription
+	SUBSCRIPTIONS = []byte("subscriptions")
+
+	// promise id, sort id -> id
+	SUBSCRIPTIONS_BY_SORT_ID = []byte("subscriptions_by_sort_id")
+
+	// promise id, id -> notification
+	NOTIFICATIONS = []byte("notifications")
+
+	// time, promise id, id -> nil
+	NOTIFICATIONS_BY_TIME = []byte("notifications_by_time")
+
+	// promise id, id -> dead notification
+	DEAD_NOTIFICATIONS = []byte("dead_notifications")
+
+	// sort id -> promise id, id
+	DEAD_NOTIFICATIONS_BY_SORT_ID = []byte("dead_notifications_by_sort_id")
+
+	// promise id, id, sort id -> notification attempt
+	NOTIFICATION_ATTEMPTS = []byte("notification_attempts")
+
+	BUCKETS = [][]byte{
+		PROMISES,
+		PROMISES_BY_SORT_ID,
+		PROMISES_BY_TIMEOUT,
+		PROMISES_BY_COMPLETED_ON,
+		PROMISES_BY_EXPIRY,
+		TIMEOUTS,
+		TIMEOUTS_BY_TIME,
+		SUBSCRIPTIONS,
+		SUBSCRIPTIONS_BY_SORT_ID,
+		NOTIFICATIONS,
+		NOTIFICATIONS_BY_TIME,
+		DEAD_NOTIFICATIONS,
+		DEAD_NOTIFICATIONS_BY_SORT_ID,
+		NOTIFICATION_ATTEMPTS,
+	}
+)
+
+type Config struct {
+	Path        string
+	LockTimeout time.Duration
+}
+
+type BoltStore struct {
+	config *Config
+	db     *bbolt.DB
+}
+
+type BoltStoreWorker struct {
+	*BoltStore
+}
+
+// promiseRow is the value of the promises bucket, tags are stored
+// decoded so that searches do not need to unmarshal the record tags.
+type promiseRow struct {
+	Record    *promise.PromiseRecord
+	Tags      map[string]string
+	Retention *int64
+}
+
+func New(config *Config) (aio.Subsystem, error) {
+	db, err := bbolt.Open(config.Path, 0600, &bbolt.Options{Timeout: config.LockTimeout})
+	if err != nil {
+		return nil, err
+	}
+
+	return &BoltStore{
+		config: config,
+		db:     db,
+	}, nil
+}
+
+func (s *BoltStore) String() string {
+	return "store:bolt"
+}
+
+func (s *BoltStore) Start() error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		for _, bucket := range BUCKETS {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (s *BoltStore) Stop() error {
+	return s.db.Close()
+}
+
+func (s *BoltStore) Reset() error {
+	if _, err := os.Stat(s.config.Path); err != nil {
+		return nil
+	}
+
+	return os.Remove(s.config.Path)
+}
+
+func (s *BoltStore) NewWorker(int) aio.Worker {
+	return &BoltStoreWorker{s}
+}
+
+func (w *BoltStoreWorker) Process(sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]) []*bus.CQE[t_aio.Submission, t_aio.Completion] {
+	return store.Process(w, sqes)
+}
+
+func (w *BoltStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
+	util.Assert(len(transactions) > 0, "expected a transaction")
+
+	var results [][]*t_aio.Result
+	var errs []error
+
+	// the bolt transaction is rolled back if an error is returned, or
+	// if a command panics
+	err := w.db.Update(func(tx *bbolt.Tx) error {
+		var err error
+		results, errs, err = w.performCommands(&transaction{tx: tx}, transactions)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return results, errs, nil
+}
+
+func (w *BoltStoreWorker) performCommands(tx *transaction, transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
+	results := make([][]*t_aio.Result, len(transactions))
+	errs := make([]error, len(transactions))
+
+	for i, transaction := range transactions {
+		util.Assert(len(transaction.Commands) > 0, "expected a command")
+		results[i] = make([]*t_aio.Result, len(transaction.Commands))
+
+		// an error only rolls back the transaction that caused it
+		savepoint := tx.savepoint()
+
+		var err error
+
+		for j, command := range transaction.Commands {
+			switch command.Kind {
+			// Promise
+			case t_aio.ReadPromise:
+				util.Assert(command.ReadPromise != nil, "command must not be nil")
+				results[i][j], err = w.readPromise(tx, command.ReadPromise)
+			case t_aio.SearchPromises:
+				util.Assert(command.SearchPromises != nil, "command must not be nil")
+				results[i][j], err = w.searchPromises(tx, command.SearchPromises)
+			case t_aio.CreatePromise:
+				util.Assert(command.CreatePromise != nil, "command must not be nil")
+				results[i][j], err = w.createPromise(tx, command.CreatePromise)
+			case t_aio.UpdatePromise:
+				util.Assert(command.UpdatePromise != nil, "command must not be nil")
+				results[i][j], err = w.updatePromise(tx, command.UpdatePromise)
+			case t_aio.TimeoutPromises:
+				util.Assert(command.TimeoutPromises != nil, "command must not be nil")
+				results[i][j], err = w.timeoutPromises(tx, command.TimeoutPromises)
+			case t_aio.DeletePromises:
+				util.Assert(command.DeletePromises != nil, "command must not be nil")
+				results[i][j], err = w.deletePromises(tx, command.DeletePromises)
+			case t_aio.ReadExpiredPromises:
+				util.Assert(command.ReadExpiredPromises != nil, "command must not be nil")
+				results[i][j], err = w.readExpiredPromises(tx, command.ReadExpiredPromises)
+			case t_aio.ReadPromisesByIdempotencyKey:
+				util.Assert(command.ReadPromisesByIdempotencyKey != nil, "command must not be nil")
+				results[i][j], err = w.readPromisesByIdempotencyKey(tx, command.ReadPromisesByIdempotencyKey)
+			case t_aio.DeletePromise:
+				util.Assert(command.DeletePromise != nil, "command must not be nil")
+				results[i][j], err = w.deletePromise(tx, command.DeletePromise)
+
+			// Timeout
+			case t_aio.ReadTimeouts:
+				util.Assert(command.ReadTimeouts != nil, "command must not be nil")
+				results[i][j], err = w.readTimeouts(tx, command.ReadTimeouts)
+			case t_aio.CreateTimeout:
+				util.Assert(command.CreateTimeout != nil, "command must not be nil")
+				results[i][j], err = w.createTimeout(tx, command.CreateTimeout)
+			case t_aio.DeleteTimeout:
+				util.Assert(command.DeleteTimeout != nil, "command must not be nil")
+				results[i][j], err = w.deleteTimeout(tx, command.DeleteTimeout)
+
+			// Subscription
+			case t_aio.ReadSubscription:
+				util.Assert(command.ReadSubscription != nil, "command must not be nil")
+				results[i][j], err = w.readSubscription(tx, command.ReadSubscription)
+			case t_aio.ReadSubscriptions:
+				util.Assert(command.ReadSubscriptions != nil, "command must not be nil")
+				results[i][j], err = w.readSubscriptions(tx, command.ReadSubscriptions)
+			case t_aio.CreateSubscription:
+				util.Assert(command.CreateSubscription != nil, "command must not be nil")
+				results[i][j], err = w.createSubscription(tx, command.CreateSubscription)
+			case t_aio.DeleteSubscription:
+				util.Assert(command.DeleteSubscription != nil, "command must not be nil")
+				results[i][j], err = w.deleteSubscription(tx, command.DeleteSubscription)
+			case t_aio.DeleteSubscriptions:
+				util.Assert(command.DeleteSubscriptions != nil, "command must not be nil")
+				results[i][j], err = w.deleteSubscriptions(tx, command.DeleteSubscriptions)
+			case t_aio.TimeoutDeleteSubscriptions:
+				util.Assert(command.TimeoutDeleteSubscriptions != nil, "command must not be nil")
+				results[i][j], err = w.timeoutDeleteSubscriptions(tx, command.TimeoutDeleteSubscriptions)
+
+			// Notification
+			case t_aio.ReadNotifications:
+				util.Assert(command.ReadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.readNotifications(tx, command.ReadNotifications)
+			case t_aio.CreateNotifications:
+				util.Assert(command.CreateNotifications != nil, "command must not be nil")
+				results[i][j], err = w.createNotifications(tx, command.CreateNotifications)
+			case t_aio.CreateNotification:
+				util.Assert(command.CreateNotification != nil, "command must not be nil")
+				results[i][j], err = w.createNotification(tx, command.CreateNotification)
+			case t_aio.UpdateNotification:
+				util.Assert(command.UpdateNotification != nil, "command must not be nil")
+				results[i][j], err = w.updateNotification(tx, command.UpdateNotification)
+			case t_aio.DeleteNotification:
+				util.Assert(command.DeleteNotification != nil, "command must not be nil")
+				results[i][j], err = w.deleteNotification(tx, command.DeleteNotification)
+			case t_aio.TimeoutCreateNotifications:
+				util.Assert(command.TimeoutCreateNotifications != nil, "command must not be nil")
+				results[i][j], err = w.timeoutCreateNotifications(tx, command.TimeoutCreateNotifications)
+
+			// Dead notification
+			case t_aio.ReadDeadNotification:
+				util.Assert(command.ReadDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotification(tx, command.ReadDeadNotification)
+			case t_aio.ReadDeadNotifications:
+				util.Assert(command.ReadDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotifications(tx, command.ReadDeadNotifications)
+			case t_aio.CreateDeadNotification:
+				util.Assert(command.CreateDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.createDeadNotification(tx, command.CreateDeadNotification)
+			case t_aio.DeleteDeadNotification:
+				util.Assert(command.DeleteDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotification(tx, command.DeleteDeadNotification)
+			case t_aio.DeleteDeadNotifications:
+				util.Assert(command.DeleteDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotifications(tx, command.DeleteDeadNotifications)
+
+			// Notification attempt
+			case t_aio.ReadNotificationAttempts:
+				util.Assert(command.ReadNotificationAttempts != nil, "command must not be nil")
+				results[i][j], err = w.readNotificationAttempts(tx, command.ReadNotificationAttempts)
+			case t_aio.CreateNotificationAttempt:
+				util.Assert(command.CreateNotificationAttempt != nil, "command must not be nil")
+				results[i][j], err = w.createNotificationAttempt(tx, command.CreateNotificationAttempt)
+
+			default:
+				panic("invalid command")
+			}
+
+			if err != nil {
+				break
+			}
+		}
+
+		if err != nil {
+			if err := tx.rollback(savepoint); err != nil {
+				return nil, nil, err
+			}
+			results[i], errs[i] = nil, err
+		} else if transaction.Atomic && store.Conflict(results[i]) {
+			if err := tx.rollback(savepoint); err != nil {
+				return nil, nil, err
+			}
+			store.Discard(results[i])
+		}
+	}
+
+	return results, errs, nil
+}
+
+func (w *BoltStoreWorker) readPromise(tx *transaction, cmd *t_aio.ReadPromiseCommand) (*t_aio.Result, error) {
+	row, err := tx.promise(cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*promise.PromiseRecord
+	if row != nil {
+		row.Record.SortId = 0 // not selected by the sql stores
+		records = append(records, row.Record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadPromise,
+		ReadPromise: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) searchPromises(tx *transaction, cmd *t_aio.SearchPromisesCommand) (*t_aio.Result, error) {
+	search := store.NewSearch(cmd)
+	limit := func(n int) bool { return cmd.Limit >= 0 && n >= cmd.Limit }
+
+	var records []*promise.PromiseRecord
+	c := tx.tx.Bucket(PROMISES_BY_SORT_ID).Cursor()
+
+	if cmd.SortBy == promise.SortBySortId {
+		// the sort id index is already in sort order, seek to the
+		// cursor and stop at the limit
+		var k, v []byte
+		var next func() ([]byte, []byte)
+
+		switch cmd.SortOrder {
+		case promise.Descending:
+			next = c.Prev
+			if cmd.SortId != nil || cmd.SortValue != nil {
+				k, v = c.Seek(encodeInt(searchCursor(cmd)))
+			}
+			if k == nil {
+				k, v = c.Last()
+			}
+		case promise.Ascending:
+			next = c.Next
+			if cmd.SortId != nil || cmd.SortValue != nil {
+				k, v = c.Seek(encodeInt(searchCursor(cmd)))
+			} else {
+				k, v = c.First()
+			}
+		default:
+			panic("invalid sort order")
+		}
+
+		for ; k != nil && !limit(len(records)); k, v = next() {
+			row, err := tx.promise(string(v))
+			if err != nil {
+				return nil, err
+			}
+
+			if search.Match(row.Record, row.Tags) {
+				records = append(records, row.Record)
+			}
+		}
+	} else {
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			row, err := tx.promise(string(v))
+			if err != nil {
+				return nil, err
+			}
+
+			if search.Match(row.Record, row.Tags) {
+				records = append(records, row.Record)
+			}
+		}
+
+		sort.SliceStable(records, func(i, j int) bool {
+			return search.Less(records[i], records[j])
+		})
+
+		if limit(len(records)) {
+			records = records[:cmd.Limit]
+		}
+	}
+
+	var lastSortId int64
+	if len(records) > 0 {
+		lastSortId = records[len(records)-1].SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.SearchPromises,
+		SearchPromises: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+// searchCursor returns the sort id to seek to when searching by sort id,
+// records before the cursor are filtered out by the search.
+func searchCursor(cmd *t_aio.SearchPromisesCommand) int64 {
+	if cmd.SortValue != nil {
+		return *cmd.SortValue
+	}
+	return *cmd.SortId
+}
+
+func (w *BoltStoreWorker) createPromise(tx *transaction, cmd *t_aio.CreatePromiseCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Param.Headers != nil, "headers must not be nil")
+	util.Assert(cmd.Param.Data != nil, "data must not be nil")
+	util.Assert(cmd.Tags != nil, "tags must not be nil")
+
+	headers, err := json.Marshal(cmd.Param.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := json.Marshal(cmd.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if tx.get(PROMISES, []byte(cmd.Id)) == nil {
+		sortId, err := tx.nextSequence(PROMISES)
+		if err != nil {
+			return nil, err
+		}
+
+		createdOn := cmd.CreatedOn
+		row := &promiseRow{
+			Record: &promise.PromiseRecord{
+				Id:                      cmd.Id,
+				State:                   promise.Pending,
+				ParamHeaders:            headers,
+				ParamData:               cmd.Param.Data,
+				Timeout:                 cmd.Timeout,
+				IdempotencyKeyForCreate: cmd.IdempotencyKey,
+				Tags:                    tags,
+				CreatedOn:               &createdOn,
+				SortId:                  sortId,
+			},
+			Tags:      cmd.Tags,
+			Retention: store.Retention(cmd.Tags),
+		}
+
+		if err := tx.putJSON(PROMISES, []byte(cmd.Id), row); err != nil {
+			return nil, err
+		}
+		if err := tx.put(PROMISES_BY_SORT_ID, encodeInt(sortId), []byte(cmd.Id)); err != nil {
+			return nil, err
+		}
+		if err := tx.put(PROMISES_BY_TIMEOUT, join(encodeInt(cmd.Timeout), []byte(cmd.Id)), []byte{}); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreatePromise,
+		CreatePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) updatePromise(tx *transaction, cmd *t_aio.UpdatePromiseCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.State.In(promise.Resolved|promise.Rejected|promise.Canceled|promise.Timedout), "state must be canceled, resolved, rejected, or timedout")
+	util.Assert(cmd.Value.Headers != nil, "value headers must not be nil")
+	util.Assert(cmd.Value.Data != nil, "value data must not be nil")
+
+	headers, err := json.Marshal(cmd.Value.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	row, err := tx.promise(cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if row != nil && row.Record.State == promise.Pending {
+		completedOn := cmd.CompletedOn
+
+		row.Record.State = cmd.State
+		row.Record.ValueHeaders = headers
+		row.Record.ValueData = cmd.Value.Data
+		row.Record.IdempotencyKeyForComplete = cmd.IdempotencyKey
+		row.Record.CompletedOn = &completedOn
+
+		if err := tx.completePromise(row); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.UpdatePromise,
+		UpdatePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) timeoutPromises(tx *transaction, cmd *t_aio.TimeoutPromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	rows, err := tx.timedoutPromises(cmd.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		completedOn := row.Record.Timeout
+
+		row.Record.State = promise.Timedout
+		row.Record.CompletedOn = &completedOn
+
+		if err := tx.completePromise(row); err != nil {
+			return nil, err
+		}
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.TimeoutPromises,
+		TimeoutPromises: &t_aio.AlterPromisesResult{
+			RowsAffected: int64(len(rows)),
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deletePromises(tx *transaction, cmd *t_aio.DeletePromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	rows, err := tx.expiredPromises(cmd.Time, cmd.Retention, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if err := tx.deletePromise(row); err != nil {
+			return nil, err
+		}
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromises,
+		DeletePromises: &t_aio.AlterPromisesResult{
+			RowsAffected: int64(len(rows)),
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readExpiredPromises(tx *transaction, cmd *t_aio.ReadExpiredPromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	rows, err := tx.expiredPromises(cmd.Time, cmd.Retention, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for _, row := range rows {
+		records = append(records, row.Record)
+		lastSortId = row.Record.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadExpiredPromises,
+		ReadExpiredPromises: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readPromisesByIdempotencyKey(tx *transaction, cmd *t_aio.ReadPromisesByIdempotencyKeyCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	// promises are not indexed by idempotency key, seek to the cursor
+	// and scan the sort id index in reverse
+	cursor := int64(math.MaxInt64)
+	if cmd.SortId != nil {
+		cursor = *cmd.SortId
+	}
+
+	c := tx.tx.Bucket(PROMISES_BY_SORT_ID).Cursor()
+	k, v := c.Seek(encodeInt(cursor))
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for ; k != nil && len(records) < cmd.Limit; k, v = c.Prev() {
+		row, err := tx.promise(string(v))
+		if err != nil {
+			return nil, err
+		}
+
+		if row.Record.IdempotencyKeyForCreate.Match(&cmd.IdempotencyKey) || row.Record.IdempotencyKeyForComplete.Match(&cmd.IdempotencyKey) {
+			records = append(records, row.Record)
+			lastSortId = row.Record.SortId
+		}
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadPromisesByIdempotencyKey,
+		ReadPromisesByIdempotencyKey: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deletePromise(tx *transaction, cmd *t_aio.DeletePromiseCommand) (*t_aio.Result, error) {
+	row, err := tx.promise(cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if row != nil && row.Record.State != promise.Pending && !tx.undelivered([]byte(cmd.Id)) {
+		if err := tx.deletePromise(row); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromise,
+		DeletePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readTimeouts(tx *transaction, cmd *t_aio.ReadTimeoutsCommand) (*t_aio.Result, error) {
+	var records []*timeout.TimeoutRecord
+
+	c := tx.tx.Bucket(TIMEOUTS_BY_TIME).Cursor()
+	for k, _ := c.First(); k != nil && (cmd.N < 0 || len(records) < cmd.N); k, _ = c.Next() {
+		records = append(records, &timeout.TimeoutRecord{
+			Id:   string(k[9:]),
+			Time: decodeInt(k[:8]),
+		})
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadTimeouts,
+		ReadTimeouts: &t_aio.QueryTimeoutsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) createTimeout(tx *transaction, cmd *t_aio.CreateTimeoutCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	var rowsAffected int64
+
+	if tx.get(TIMEOUTS, []byte(cmd.Id)) == nil {
+		if err := tx.put(TIMEOUTS, []byte(cmd.Id), encodeInt(cmd.Time)); err != nil {
+			return nil, err
+		}
+		if err := tx.put(TIMEOUTS_BY_TIME, join(encodeInt(cmd.Time), []byte(cmd.Id)), []byte{}); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateTimeout,
+		CreateTimeout: &t_aio.AlterTimeoutsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deleteTimeout(tx *transaction, cmd *t_aio.DeleteTimeoutCommand) (*t_aio.Result, error) {
+	var rowsAffected int64
+
+	if time := tx.get(TIMEOUTS, []byte(cmd.Id)); time != nil {
+		if err := tx.delete(TIMEOUTS_BY_TIME, join(time, []byte(cmd.Id))); err != nil {
+			return nil, err
+		}
+		if err := tx.delete(TIMEOUTS, []byte(cmd.Id)); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteTimeout,
+		DeleteTimeout: &t_aio.AlterTimeoutsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readSubscription(tx *transaction, cmd *t_aio.ReadSubscriptionCommand) (*t_aio.Result, error) {
+	record, err := tx.subscription(cmd.PromiseId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*subscription.SubscriptionRecord
+	if record != nil {
+		record.SortId = 0 // not selected by the sql stores
+		records = append(records, record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadSubscription,
+		ReadSubscription: &t_aio.QuerySubscriptionsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readSubscriptions(tx *transaction, cmd *t_aio.ReadSubscriptionsCommand) (*t_aio.Result, error) {
+	prefix := join([]byte(cmd.PromiseId), nil)
+
+	// seek to the cursor and iterate the sort id index in reverse
+	cursor := int64(math.MaxInt64)
+	if cmd.SortId != nil {
+		cursor = *cmd.SortId
+	}
+
+	c := tx.tx.Bucket(SUBSCRIPTIONS_BY_SORT_ID).Cursor()
+	k, v := c.Seek(append(prefix, encodeInt(cursor)...))
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+
+	var records []*subscription.SubscriptionRecord
+	var lastSortId int64
+
+	for ; k != nil && bytes.HasPrefix(k, prefix) && (cmd.Limit < 0 || len(records) < cmd.Limit); k, v = c.Prev() {
+		record, err := tx.subscription(cmd.PromiseId, string(v))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadSubscriptions,
+		ReadSubscriptions: &t_aio.QuerySubscriptionsResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) createSubscription(tx *transaction, cmd *t_aio.CreateSubscriptionCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if tx.get(SUBSCRIPTIONS, join([]byte(cmd.PromiseId), []byte(cmd.Id))) == nil {
+		sortId, err := tx.nextSequence(SUBSCRIPTIONS)
+		if err != nil {
+			return nil, err
+		}
+
+		record := &subscription.SubscriptionRecord{
+			Id:          cmd.Id,
+			PromiseId:   cmd.PromiseId,
+			Url:         cmd.Url,
+			Method:      cmd.Method,
+			Headers:     headers,
+			Payload:     payload,
+			Secret:      cmd.Secret,
+			RetryPolicy: retryPolicy,
+			CreatedOn:   cmd.CreatedOn,
+			SortId:      sortId,
+		}
+
+		if err := tx.putJSON(SUBSCRIPTIONS, join([]byte(cmd.PromiseId), []byte(cmd.Id)), record); err != nil {
+			return nil, err
+		}
+		if err := tx.put(SUBSCRIPTIONS_BY_SORT_ID, join([]byte(cmd.PromiseId), encodeInt(sortId)), []byte(cmd.Id)); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateSubscription,
+		CreateSubscription: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deleteSubscription(tx *transaction, cmd *t_aio.DeleteSubscriptionCommand) (*t_aio.Result, error) {
+	record, err := tx.subscription(cmd.PromiseId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if record != nil {
+		if err := tx.deleteSubscription(record); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteSubscription,
+		DeleteSubscription: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deleteSubscriptions(tx *transaction, cmd *t_aio.DeleteSubscriptionsCommand) (*t_aio.Result, error) {
+	rowsAffected, err := tx.deleteSubscriptions(cmd.PromiseId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteSubscriptions,
+		DeleteSubscriptions: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) timeoutDeleteSubscriptions(tx *transaction, cmd *t_aio.TimeoutDeleteSubscriptionsCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	rows, err := tx.timedoutPromises(cmd.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	for _, row := range rows {
+		n, err := tx.deleteSubscriptions(row.Record.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected += n
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.TimeoutDeleteSubscriptions,
+		TimeoutDeleteSubscriptions: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readNotifications(tx *transaction, cmd *t_aio.ReadNotificationsCommand) (*t_aio.Result, error) {
+	var records []*notification.NotificationRecord
+
+	c := tx.tx.Bucket(NOTIFICATIONS_BY_TIME).Cursor()
+	for k, _ := c.First(); k != nil && (cmd.N < 0 || len(records) < cmd.N); k, _ = c.Next() {
+		record := &notification.NotificationRecord{}
+		if err := json.Unmarshal(tx.get(NOTIFICATIONS, k[9:]), record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadNotifications,
+		ReadNotifications: &t_aio.QueryNotificationsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) createNotifications(tx *transaction, cmd *t_aio.CreateNotificationsCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	rowsAffected, err := tx.insertNotifications(cmd.PromiseId, cmd.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotifications,
+		CreateNotifications: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) createNotification(tx *transaction, cmd *t_aio.CreateNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	record, err := tx.notification(cmd.PromiseId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if record == nil {
+		if err := tx.putNotification(&notification.NotificationRecord{
+			Id:          cmd.Id,
+			PromiseId:   cmd.PromiseId,
+			Url:         cmd.Url,
+			Method:      cmd.Method,
+			Headers:     headers,
+			Payload:     payload,
+			Secret:      cmd.Secret,
+			RetryPolicy: retryPolicy,
+			Time:        cmd.Time,
+			Attempt:     cmd.Attempt,
+		}); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotification,
+		CreateNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) updateNotification(tx *transaction, cmd *t_aio.UpdateNotificationCommand) (*t_aio.Result, error) {
+	record, err := tx.notification(cmd.PromiseId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if record != nil {
+		if err := tx.deleteNotification(record); err != nil {
+			return nil, err
+		}
+
+		record.Time = cmd.Time
+		record.Attempt = cmd.Attempt
+
+		if err := tx.putNotification(record); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.UpdateNotification,
+		UpdateNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deleteNotification(tx *transaction, cmd *t_aio.DeleteNotificationCommand) (*t_aio.Result, error) {
+	record, err := tx.notification(cmd.PromiseId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if record != nil {
+		if err := tx.deleteNotification(record); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteNotification,
+		DeleteNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) timeoutCreateNotifications(tx *transaction, cmd *t_aio.TimeoutCreateNotificationsCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	rows, err := tx.timedoutPromises(cmd.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	for _, row := range rows {
+		n, err := tx.insertNotifications(row.Record.Id, cmd.Time)
+		if err != nil {
+			return nil, err
+		}
+
+		rowsAffected += n
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.TimeoutCreateNotifications,
+		TimeoutCreateNotifications: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readDeadNotification(tx *transaction, cmd *t_aio.ReadDeadNotificationCommand) (*t_aio.Result, error) {
+	record, err := tx.deadNotification(cmd.PromiseId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []*notification.DeadNotificationRecord
+	if record != nil {
+		records = append(records, record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotification,
+		ReadDeadNotification: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) readDeadNotifications(tx *transaction, cmd *t_aio.ReadDeadNotificationsCommand) (*t_aio.Result, error) {
+	// seek to the cursor and iterate the sort id index in reverse
+	cursor := int64(math.MaxInt64)
+	if cmd.SortId != nil {
+		cursor = *cmd.SortId
+	}
+
+	c := tx.tx.Bucket(DEAD_NOTIFICATIONS_BY_SORT_ID).Cursor()
+	k, v := c.Seek(encodeInt(cursor))
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+
+	var records []*notification.DeadNotificationRecord
+	var lastSortId int64
+
+	for ; k != nil && (cmd.Limit < 0 || len(records) < cmd.Limit); k, v = c.Prev() {
+		record := &notification.DeadNotificationRecord{}
+		if err := json.Unmarshal(tx.get(DEAD_NOTIFICATIONS, v), record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotifications,
+		ReadDeadNotifications: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) createDeadNotification(tx *transaction, cmd *t_aio.CreateDeadNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	key := join([]byte(cmd.PromiseId), []byte(cmd.Id))
+
+	if tx.get(DEAD_NOTIFICATIONS, key) == nil {
+		sortId, err := tx.nextSequence(DEAD_NOTIFICATIONS)
+		if err != nil {
+			return nil, err
+		}
+
+		record := &notification.DeadNotificationRecord{
+			Id:          cmd.Id,
+			PromiseId:   cmd.PromiseId,
+			Url:         cmd.Url,
+			Method:      cmd.Method,
+			Headers:     headers,
+			Payload:     payload,
+			Secret:      cmd.Secret,
+			RetryPolicy: retryPolicy,
+			Attempts:    cmd.Attempts,
+			StatusCode:  cmd.StatusCode,
+			Error:       cmd.Error,
+			CreatedOn:   cmd.CreatedOn,
+			SortId:      sortId,
+		}
+
+		if err := tx.putJSON(DEAD_NOTIFICATIONS, key, record); err != nil {
+			return nil, err
+		}
+		if err := tx.put(DEAD_NOTIFICATIONS_BY_SORT_ID, encodeInt(sortId), key); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateDeadNotification,
+		CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deleteDeadNotification(tx *transaction, cmd *t_aio.DeleteDeadNotificationCommand) (*t_aio.Result, error) {
+	record, err := tx.deadNotification(cmd.PromiseId, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if record != nil {
+		if err := tx.deleteDeadNotification(record); err != nil {
+			return nil, err
+		}
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotification,
+		DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) deleteDeadNotifications(tx *transaction, cmd *t_aio.DeleteDeadNotificationsCommand) (*t_aio.Result, error) {
+	rowsAffected, err := tx.deleteDeadNotifications(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotifications,
+		DeleteDeadNotifications: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+// Keys
+
+// join joins ids, and encoded integers, with a zero byte separator.
+func (w *BoltStoreWorker) readNotificationAttempts(tx *transaction, cmd *t_aio.ReadNotificationAttemptsCommand) (*t_aio.Result, error) {
+	if cmd.Id == "" {
+		return w.readPromiseNotificationAttempts(tx, cmd)
+	}
+
+	prefix := join([]byte(cmd.PromiseId), []byte(cmd.Id), nil)
+
+	// seek to the cursor and iterate the attempts of the subscription in
+	// reverse
+	cursor := int64(math.MaxInt64)
+	if cmd.SortId != nil {
+		cursor = *cmd.SortId
+	}
+
+	c := tx.tx.Bucket(NOTIFICATION_ATTEMPTS).Cursor()
+	k, v := c.Seek(append(bytes.Clone(prefix), encodeInt(cursor)...))
+	if k == nil {
+		k, v = c.Last()
+	} else {
+		k, v = c.Prev()
+	}
+
+	var records []*notification.NotificationAttemptRecord
+	var lastSortId int64
+
+	for ; k != nil && bytes.HasPrefix(k, prefix) && (cmd.Limit < 0 || len(records) < cmd.Limit); k, v = c.Prev() {
+		record := &notification.NotificationAttemptRecord{}
+		if err := json.Unmarshal(v, record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadNotificationAttempts,
+		ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+// readPromiseNotificationAttempts reads the attempts of all notifications
+// of a promise, attempts are keyed by notification and then sort id so
+// all attempts of the promise are read and ordered by sort id.
+func (w *BoltStoreWorker) readPromiseNotificationAttempts(tx *transaction, cmd *t_aio.ReadNotificationAttemptsCommand) (*t_aio.Result, error) {
+	prefix := join([]byte(cmd.PromiseId), nil)
+
+	var records []*notification.NotificationAttemptRecord
+
+	c := tx.tx.Bucket(NOTIFICATION_ATTEMPTS).Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		record := &notification.NotificationAttemptRecord{}
+		if err := json.Unmarshal(v, record); err != nil {
+			return nil, err
+		}
+
+		if cmd.SortId == nil || record.SortId < *cmd.SortId {
+			records = append(records, record)
+		}
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		return records[i].SortId > records[j].SortId
+	})
+
+	if cmd.Limit >= 0 && len(records) > cmd.Limit {
+		records = records[:cmd.Limit]
+	}
+
+	var lastSortId int64
+	if len(records) > 0 {
+		lastSortId = records[len(records)-1].SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadNotificationAttempts,
+		ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *BoltStoreWorker) createNotificationAttempt(tx *transaction, cmd *t_aio.CreateNotificationAttemptCommand) (*t_aio.Result, error) {
+	sortId, err := tx.nextSequence(NOTIFICATION_ATTEMPTS)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &notification.NotificationAttemptRecord{
+		Id:         cmd.Id,
+		PromiseId:  cmd.PromiseId,
+		Attempt:    cmd.Attempt,
+		Url:        cmd.Url,
+		StatusCode: cmd.StatusCode,
+		Latency:    cmd.Latency,
+		Body:       cmd.Body,
+		Error:      cmd.Error,
+		Time:       cmd.Time,
+		SortId:     sortId,
+	}
+
+	key := join([]byte(cmd.PromiseId), []byte(cmd.Id), encodeInt(sortId))
+	if err := tx.putJSON(NOTIFICATION_ATTEMPTS, key, record); err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotificationAttempt,
+		CreateNotificationAttempt: &t_aio.AlterNotificationAttemptsResult{
+			RowsAffected: 1,
+		},
+	}, nil
+}
+
+func join(parts ...[]byte) []byte {
+	return bytes.Join(parts, []byte{0})
+}
+
+// encodeInt encodes an integer so that byte order matches numeric order,
+// including negative integers.
+func encodeInt(i int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(i)^(1<<63))
+	return b
+}
+
+func decodeInt(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b) ^ (1 << 63))
+}
@@ -0,0 +1,33 @@
+package bolt
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/test"
+)
+
+func TestBoltStore(t *testing.T) {
+	for _, tc := range test.TestCases {
+		store, err := New(&Config{
+			Path:        filepath.Join(t.TempDir(), "resonate.bolt"),
+			LockTimeout: 250 * time.Millisecond,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Start(); err != nil {
+			t.Fatal(err)
+		}
+
+		tc.Run(t, store)
+
+		if err := store.Stop(); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Reset(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,490 @@
+package bolt
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/resonatehq/resonate/pkg/notification"
+	"github.com/resonatehq/resonate/pkg/subscription"
+
+	"go.etcd.io/bbolt"
+)
+
+// transaction wraps a bolt transaction and records how to undo each
+// change, bolt does not support savepoints so changes are undone in
+// reverse order to roll back to a savepoint.
+type transaction struct {
+	tx   *bbolt.Tx
+	undo []func() error
+}
+
+func (tx *transaction) savepoint() int {
+	return len(tx.undo)
+}
+
+func (tx *transaction) rollback(savepoint int) error {
+	for i := len(tx.undo) - 1; i >= savepoint; i-- {
+		if err := tx.undo[i](); err != nil {
+			return err
+		}
+	}
+
+	tx.undo = tx.undo[:savepoint]
+	return nil
+}
+
+func (tx *transaction) get(bucket []byte, key []byte) []byte {
+	return tx.tx.Bucket(bucket).Get(key)
+}
+
+func (tx *transaction) put(bucket []byte, key []byte, value []byte) error {
+	b := tx.tx.Bucket(bucket)
+
+	// values returned by get are only valid until the next change
+	prev := bytes.Clone(b.Get(key))
+
+	if err := b.Put(key, value); err != nil {
+		return err
+	}
+
+	tx.undo = append(tx.undo, func() error {
+		if prev == nil {
+			return b.Delete(key)
+		}
+		return b.Put(key, prev)
+	})
+
+	return nil
+}
+
+// hasPrefix reports whether a bucket has a key that starts with the
+// given promise id.
+func (tx *transaction) hasPrefix(bucket []byte, promiseId []byte) bool {
+	prefix := join(promiseId, nil)
+
+	k, _ := tx.tx.Bucket(bucket).Cursor().Seek(prefix)
+	return k != nil && bytes.HasPrefix(k, prefix)
+}
+
+func (tx *transaction) putJSON(bucket []byte, key []byte, value any) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return tx.put(bucket, key, b)
+}
+
+func (tx *transaction) delete(bucket []byte, key []byte) error {
+	b := tx.tx.Bucket(bucket)
+	prev := bytes.Clone(b.Get(key))
+
+	if prev == nil {
+		return nil
+	}
+
+	if err := b.Delete(key); err != nil {
+		return err
+	}
+
+	tx.undo = append(tx.undo, func() error {
+		return b.Put(key, prev)
+	})
+
+	return nil
+}
+
+// nextSequence returns the next auto incremented id of a bucket.
+func (tx *transaction) nextSequence(bucket []byte) (int64, error) {
+	b := tx.tx.Bucket(bucket)
+	prev := b.Sequence()
+
+	next, err := b.NextSequence()
+	if err != nil {
+		return 0, err
+	}
+
+	tx.undo = append(tx.undo, func() error {
+		return b.SetSequence(prev)
+	})
+
+	return int64(next), nil
+}
+
+// Promises
+
+func (tx *transaction) promise(id string) (*promiseRow, error) {
+	v := tx.get(PROMISES, []byte(id))
+	if v == nil {
+		return nil, nil
+	}
+
+	row := &promiseRow{}
+	if err := json.Unmarshal(v, row); err != nil {
+		return nil, err
+	}
+
+	return row, nil
+}
+
+// timedoutPromises returns all pending promises with a timeout before
+// or equal to the given time, ordered by timeout and id.
+func (tx *transaction) timedoutPromises(time int64) ([]*promiseRow, error) {
+	var rows []*promiseRow
+
+	c := tx.tx.Bucket(PROMISES_BY_TIMEOUT).Cursor()
+	for k, _ := c.First(); k != nil && decodeInt(k[:8]) <= time; k, _ = c.Next() {
+		row, err := tx.promise(string(k[9:]))
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// completePromise puts a promise that transitioned to a completed
+// state and moves it from the timeout index to a retention index.
+func (tx *transaction) completePromise(row *promiseRow) error {
+	id := []byte(row.Record.Id)
+
+	if err := tx.putJSON(PROMISES, id, row); err != nil {
+		return err
+	}
+	if err := tx.delete(PROMISES_BY_TIMEOUT, join(encodeInt(row.Record.Timeout), id)); err != nil {
+		return err
+	}
+
+	bucket, key := retentionKey(row)
+	return tx.put(bucket, key, []byte{})
+}
+
+// expiredPromises returns up to limit completed promises whose
+// retention has elapsed at the given time, promises without a retention
+// are returned first. Promises with pending or dead notifications do not
+// expire until the notifications are delivered or deleted.
+func (tx *transaction) expiredPromises(time int64, retention *int64, limit int) ([]*promiseRow, error) {
+	var rows []*promiseRow
+
+	scan := func(bucket []byte, time int64) error {
+		c := tx.tx.Bucket(bucket).Cursor()
+		for k, _ := c.First(); k != nil && len(rows) < limit && decodeInt(k[:8]) <= time; k, _ = c.Next() {
+			if tx.undelivered(k[9:]) {
+				continue
+			}
+
+			row, err := tx.promise(string(k[9:]))
+			if err != nil {
+				return err
+			}
+
+			rows = append(rows, row)
+		}
+
+		return nil
+	}
+
+	if retention != nil {
+		if err := scan(PROMISES_BY_COMPLETED_ON, time-*retention); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := scan(PROMISES_BY_EXPIRY, time); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// undelivered reports whether a promise has pending or dead
+// notifications.
+func (tx *transaction) undelivered(promiseId []byte) bool {
+	return tx.hasPrefix(NOTIFICATIONS, promiseId) || tx.hasPrefix(DEAD_NOTIFICATIONS, promiseId)
+}
+
+// deletePromise deletes a completed promise along with its indexes,
+// subscriptions, notifications, dead notifications, and notification
+// attempts.
+func (tx *transaction) deletePromise(row *promiseRow) error {
+	id := []byte(row.Record.Id)
+
+	notifications, err := tx.notifications(row.Record.Id)
+	if err != nil {
+		return err
+	}
+
+	for _, n := range notifications {
+		if err := tx.deleteNotification(n); err != nil {
+			return err
+		}
+	}
+
+	if _, err := tx.deleteSubscriptions(row.Record.Id); err != nil {
+		return err
+	}
+
+	if _, err := tx.deleteDeadNotifications(id); err != nil {
+		return err
+	}
+
+	if err := tx.deleteNotificationAttempts(id); err != nil {
+		return err
+	}
+
+	bucket, key := retentionKey(row)
+	if err := tx.delete(bucket, key); err != nil {
+		return err
+	}
+	if err := tx.delete(PROMISES_BY_SORT_ID, encodeInt(row.Record.SortId)); err != nil {
+		return err
+	}
+
+	return tx.delete(PROMISES, id)
+}
+
+// retentionKey returns the retention index of a completed promise.
+func retentionKey(row *promiseRow) ([]byte, []byte) {
+	id := []byte(row.Record.Id)
+
+	if row.Retention != nil {
+		return PROMISES_BY_EXPIRY, join(encodeInt(*row.Record.CompletedOn+*row.Retention), id)
+	}
+
+	return PROMISES_BY_COMPLETED_ON, join(encodeInt(*row.Record.CompletedOn), id)
+}
+
+// Subscriptions
+
+func (tx *transaction) subscription(promiseId string, id string) (*subscription.SubscriptionRecord, error) {
+	v := tx.get(SUBSCRIPTIONS, join([]byte(promiseId), []byte(id)))
+	if v == nil {
+		return nil, nil
+	}
+
+	record := &subscription.SubscriptionRecord{}
+	if err := json.Unmarshal(v, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// subscriptions returns all subscriptions of a promise ordered by id.
+func (tx *transaction) subscriptions(promiseId string) ([]*subscription.SubscriptionRecord, error) {
+	prefix := join([]byte(promiseId), nil)
+
+	var records []*subscription.SubscriptionRecord
+
+	c := tx.tx.Bucket(SUBSCRIPTIONS).Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		record := &subscription.SubscriptionRecord{}
+		if err := json.Unmarshal(v, record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (tx *transaction) deleteSubscription(record *subscription.SubscriptionRecord) error {
+	if err := tx.delete(SUBSCRIPTIONS_BY_SORT_ID, join([]byte(record.PromiseId), encodeInt(record.SortId))); err != nil {
+		return err
+	}
+
+	return tx.delete(SUBSCRIPTIONS, join([]byte(record.PromiseId), []byte(record.Id)))
+}
+
+// deleteSubscriptions deletes all subscriptions of a promise and returns
+// the number of deleted subscriptions.
+func (tx *transaction) deleteSubscriptions(promiseId string) (int64, error) {
+	// collect first, a bolt cursor must not be used while the bucket
+	// is changed
+	records, err := tx.subscriptions(promiseId)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, record := range records {
+		if err := tx.deleteSubscription(record); err != nil {
+			return 0, err
+		}
+	}
+
+	return int64(len(records)), nil
+}
+
+// Notifications
+
+// notifications returns all notifications of a promise ordered by id.
+func (tx *transaction) notifications(promiseId string) ([]*notification.NotificationRecord, error) {
+	prefix := join([]byte(promiseId), nil)
+
+	var records []*notification.NotificationRecord
+
+	c := tx.tx.Bucket(NOTIFICATIONS).Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		record := &notification.NotificationRecord{}
+		if err := json.Unmarshal(v, record); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (tx *transaction) notification(promiseId string, id string) (*notification.NotificationRecord, error) {
+	v := tx.get(NOTIFICATIONS, join([]byte(promiseId), []byte(id)))
+	if v == nil {
+		return nil, nil
+	}
+
+	record := &notification.NotificationRecord{}
+	if err := json.Unmarshal(v, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// insertNotifications creates a notification for each subscription of a
+// promise, unless the notification already exists, and returns the
+// number of created notifications.
+func (tx *transaction) insertNotifications(promiseId string, time int64) (int64, error) {
+	subscriptions, err := tx.subscriptions(promiseId)
+	if err != nil {
+		return 0, err
+	}
+
+	var inserted int64
+
+	for _, s := range subscriptions {
+		if tx.get(NOTIFICATIONS, join([]byte(s.PromiseId), []byte(s.Id))) != nil {
+			continue
+		}
+
+		if err := tx.putNotification(&notification.NotificationRecord{
+			Id:          s.Id,
+			PromiseId:   s.PromiseId,
+			Url:         s.Url,
+			Method:      s.Method,
+			Headers:     s.Headers,
+			Payload:     s.Payload,
+			Secret:      s.Secret,
+			RetryPolicy: s.RetryPolicy,
+			Time:        time,
+			Attempt:     0,
+		}); err != nil {
+			return 0, err
+		}
+
+		inserted++
+	}
+
+	return inserted, nil
+}
+
+func (tx *transaction) putNotification(record *notification.NotificationRecord) error {
+	key := join([]byte(record.PromiseId), []byte(record.Id))
+
+	if err := tx.putJSON(NOTIFICATIONS, key, record); err != nil {
+		return err
+	}
+
+	return tx.put(NOTIFICATIONS_BY_TIME, join(encodeInt(record.Time), key), []byte{})
+}
+
+func (tx *transaction) deleteNotification(record *notification.NotificationRecord) error {
+	key := join([]byte(record.PromiseId), []byte(record.Id))
+
+	if err := tx.delete(NOTIFICATIONS_BY_TIME, join(encodeInt(record.Time), key)); err != nil {
+		return err
+	}
+
+	return tx.delete(NOTIFICATIONS, key)
+}
+
+// Dead notifications
+
+func (tx *transaction) deadNotification(promiseId string, id string) (*notification.DeadNotificationRecord, error) {
+	v := tx.get(DEAD_NOTIFICATIONS, join([]byte(promiseId), []byte(id)))
+	if v == nil {
+		return nil, nil
+	}
+
+	record := &notification.DeadNotificationRecord{}
+	if err := json.Unmarshal(v, record); err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (tx *transaction) deleteDeadNotification(record *notification.DeadNotificationRecord) error {
+	if err := tx.delete(DEAD_NOTIFICATIONS_BY_SORT_ID, encodeInt(record.SortId)); err != nil {
+		return err
+	}
+
+	return tx.delete(DEAD_NOTIFICATIONS, join([]byte(record.PromiseId), []byte(record.Id)))
+}
+
+// deleteDeadNotifications deletes the dead notifications of a promise,
+// or all dead notifications if the promise id is nil, and returns the
+// number of deleted dead notifications.
+func (tx *transaction) deleteDeadNotifications(promiseId []byte) (int64, error) {
+	var prefix []byte
+	if promiseId != nil {
+		prefix = join(promiseId, nil)
+	}
+
+	// collect first, a bolt cursor must not be used while the bucket
+	// is changed
+	var records []*notification.DeadNotificationRecord
+
+	c := tx.tx.Bucket(DEAD_NOTIFICATIONS).Cursor()
+	for k, v := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = c.Next() {
+		record := &notification.DeadNotificationRecord{}
+		if err := json.Unmarshal(v, record); err != nil {
+			return 0, err
+		}
+
+		records = append(records, record)
+	}
+
+	for _, record := range records {
+		if err := tx.deleteDeadNotification(record); err != nil {
+			return 0, err
+		}
+	}
+
+	return int64(len(records)), nil
+}
+
+// Notification attempts
+
+func (tx *transaction) deleteNotificationAttempts(promiseId []byte) error {
+	prefix := join(promiseId, nil)
+
+	// collect first, a bolt cursor must not be used while the bucket
+	// is changed
+	var keys [][]byte
+
+	c := tx.tx.Bucket(NOTIFICATION_ATTEMPTS).Cursor()
+	for k, _ := c.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = c.Next() {
+		keys = append(keys, bytes.Clone(k))
+	}
+
+	for _, key := range keys {
+		if err := tx.delete(NOTIFICATION_ATTEMPTS, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package memory
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+// index holds promise rows ordered by a key and then by sort id, the
+// key of a row must not change while the row is in the index.
+type index struct {
+	rows []*promiseRow
+	key  func(*promiseRow) int64
+}
+
+func newIndex(key func(*promiseRow) int64) *index {
+	return &index{key: key}
+}
+
+func (i *index) insert(row *promiseRow) {
+	i.rows = slices.Insert(i.rows, i.search(row), row)
+}
+
+func (i *index) remove(row *promiseRow) {
+	j := i.search(row)
+	util.Assert(j < len(i.rows) && i.rows[j] == row, "row must be in index")
+
+	i.rows = slices.Delete(i.rows, j, j+1)
+}
+
+// until returns a copy of all rows with a key before or equal to the
+// given key.
+func (i *index) until(key int64) []*promiseRow {
+	n := sort.Search(len(i.rows), func(j int) bool {
+		return i.key(i.rows[j]) > key
+	})
+
+	return slices.Clone(i.rows[:n])
+}
+
+func (i *index) search(row *promiseRow) int {
+	key := i.key(row)
+
+	return sort.Search(len(i.rows), func(j int) bool {
+		k := i.key(i.rows[j])
+		return k > key || k == key && i.rows[j].record.SortId >= row.record.SortId
+	})
+}
@@ -0,0 +1,1145 @@
+package memory
+
+import (
+	"bytes"
+	"encoding/json"
+	"maps"
+	"sort"
+	"sync"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/notification"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/subscription"
+	"github.com/resonatehq/resonate/pkg/timeout"
+)
+
+type MemoryStore struct {
+	mutex  sync.Mutex
+	tables *tables
+}
+
+type MemoryStoreWorker struct {
+	*MemoryStore
+}
+
+// tables holds all records, the semantics of each table, including
+// auto incremented sort ids, match the sqlite store.
+type tables struct {
+	promises      map[string]*promiseRow
+	promisesIndex []*promiseRow // ordered by sort id
+	promiseSortId int64
+
+	// pending promises ordered by timeout, completed promises without a
+	// retention tag ordered by completed on, and completed promises with
+	// a retention tag ordered by the time their retention elapses
+	promisesByTimeout     *index
+	promisesByCompletedOn *index
+	promisesByExpiry      *index
+
+	timeouts map[string]*timeout.TimeoutRecord
+
+	// keyed by promise id, ordered by sort id
+	subscriptions      map[string][]*subscription.SubscriptionRecord
+	subscriptionSortId int64
+
+	// keyed by promise id and then id
+	notifications map[string]map[string]*notification.NotificationRecord
+
+	deadNotifications      []*notification.DeadNotificationRecord // ordered by sort id
+	deadNotificationSortId int64
+
+	// keyed by promise id, ordered by sort id
+	notificationAttempts      map[string][]*notification.NotificationAttemptRecord
+	notificationAttemptSortId int64
+}
+
+type promiseRow struct {
+	record    *promise.PromiseRecord
+	tags      map[string]string
+	retention *int64
+}
+
+func New() (aio.Subsystem, error) {
+	return &MemoryStore{
+		tables: newTables(),
+	}, nil
+}
+
+func newTables() *tables {
+	return &tables{
+		promises:      map[string]*promiseRow{},
+		timeouts:      map[string]*timeout.TimeoutRecord{},
+		subscriptions: map[string][]*subscription.SubscriptionRecord{},
+		notifications: map[string]map[string]*notification.NotificationRecord{},
+
+		notificationAttempts: map[string][]*notification.NotificationAttemptRecord{},
+
+		promisesByTimeout: newIndex(func(row *promiseRow) int64 {
+			return row.record.Timeout
+		}),
+		promisesByCompletedOn: newIndex(func(row *promiseRow) int64 {
+			return *row.record.CompletedOn
+		}),
+		promisesByExpiry: newIndex(func(row *promiseRow) int64 {
+			return *row.record.CompletedOn + *row.retention
+		}),
+	}
+}
+
+func (s *MemoryStore) String() string {
+	return "store:memory"
+}
+
+func (s *MemoryStore) Start() error {
+	return nil
+}
+
+func (s *MemoryStore) Stop() error {
+	return nil
+}
+
+func (s *MemoryStore) Reset() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.tables = newTables()
+	return nil
+}
+
+func (s *MemoryStore) NewWorker(int) aio.Worker {
+	return &MemoryStoreWorker{s}
+}
+
+func (w *MemoryStoreWorker) Process(sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]) []*bus.CQE[t_aio.Submission, t_aio.Completion] {
+	return store.Process(w, sqes)
+}
+
+func (w *MemoryStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
+	util.Assert(len(transactions) > 0, "expected a transaction")
+
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	tx := &transaction{tables: w.tables}
+	committed := false
+
+	// all changes are undone if the batch fails, or a command panics
+	defer func() {
+		if !committed {
+			tx.rollback(0)
+		}
+	}()
+
+	results, errs, err := w.performCommands(tx, transactions)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	committed = true
+	return results, errs, nil
+}
+
+func (w *MemoryStoreWorker) performCommands(tx *transaction, transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
+	results := make([][]*t_aio.Result, len(transactions))
+	errs := make([]error, len(transactions))
+
+	for i, transaction := range transactions {
+		util.Assert(len(transaction.Commands) > 0, "expected a command")
+		results[i] = make([]*t_aio.Result, len(transaction.Commands))
+
+		// an error only rolls back the transaction that caused it
+		savepoint := tx.savepoint()
+
+		var err error
+
+		for j, command := range transaction.Commands {
+			switch command.Kind {
+			// Promise
+			case t_aio.ReadPromise:
+				util.Assert(command.ReadPromise != nil, "command must not be nil")
+				results[i][j], err = w.readPromise(tx, command.ReadPromise)
+			case t_aio.SearchPromises:
+				util.Assert(command.SearchPromises != nil, "command must not be nil")
+				results[i][j], err = w.searchPromises(tx, command.SearchPromises)
+			case t_aio.CreatePromise:
+				util.Assert(command.CreatePromise != nil, "command must not be nil")
+				results[i][j], err = w.createPromise(tx, command.CreatePromise)
+			case t_aio.UpdatePromise:
+				util.Assert(command.UpdatePromise != nil, "command must not be nil")
+				results[i][j], err = w.updatePromise(tx, command.UpdatePromise)
+			case t_aio.TimeoutPromises:
+				util.Assert(command.TimeoutPromises != nil, "command must not be nil")
+				results[i][j], err = w.timeoutPromises(tx, command.TimeoutPromises)
+			case t_aio.DeletePromises:
+				util.Assert(command.DeletePromises != nil, "command must not be nil")
+				results[i][j], err = w.deletePromises(tx, command.DeletePromises)
+			case t_aio.ReadExpiredPromises:
+				util.Assert(command.ReadExpiredPromises != nil, "command must not be nil")
+				results[i][j], err = w.readExpiredPromises(tx, command.ReadExpiredPromises)
+			case t_aio.ReadPromisesByIdempotencyKey:
+				util.Assert(command.ReadPromisesByIdempotencyKey != nil, "command must not be nil")
+				results[i][j], err = w.readPromisesByIdempotencyKey(tx, command.ReadPromisesByIdempotencyKey)
+			case t_aio.DeletePromise:
+				util.Assert(command.DeletePromise != nil, "command must not be nil")
+				results[i][j], err = w.deletePromise(tx, command.DeletePromise)
+
+			// Timeout
+			case t_aio.ReadTimeouts:
+				util.Assert(command.ReadTimeouts != nil, "command must not be nil")
+				results[i][j], err = w.readTimeouts(tx, command.ReadTimeouts)
+			case t_aio.CreateTimeout:
+				util.Assert(command.CreateTimeout != nil, "command must not be nil")
+				results[i][j], err = w.createTimeout(tx, command.CreateTimeout)
+			case t_aio.DeleteTimeout:
+				util.Assert(command.DeleteTimeout != nil, "command must not be nil")
+				results[i][j], err = w.deleteTimeout(tx, command.DeleteTimeout)
+
+			// Subscription
+			case t_aio.ReadSubscription:
+				util.Assert(command.ReadSubscription != nil, "command must not be nil")
+				results[i][j], err = w.readSubscription(tx, command.ReadSubscription)
+			case t_aio.ReadSubscriptions:
+				util.Assert(command.ReadSubscriptions != nil, "command must not be nil")
+				results[i][j], err = w.readSubscriptions(tx, command.ReadSubscriptions)
+			case t_aio.CreateSubscription:
+				util.Assert(command.CreateSubscription != nil, "command must not be nil")
+				results[i][j], err = w.createSubscription(tx, command.CreateSubscription)
+			case t_aio.DeleteSubscription:
+				util.Assert(command.DeleteSubscription != nil, "command must not be nil")
+				results[i][j], err = w.deleteSubscription(tx, command.DeleteSubscription)
+			case t_aio.DeleteSubscriptions:
+				util.Assert(command.DeleteSubscriptions != nil, "command must not be nil")
+				results[i][j], err = w.deleteSubscriptions(tx, command.DeleteSubscriptions)
+			case t_aio.TimeoutDeleteSubscriptions:
+				util.Assert(command.TimeoutDeleteSubscriptions != nil, "command must not be nil")
+				results[i][j], err = w.timeoutDeleteSubscriptions(tx, command.TimeoutDeleteSubscriptions)
+
+			// Notification
+			case t_aio.ReadNotifications:
+				util.Assert(command.ReadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.readNotifications(tx, command.ReadNotifications)
+			case t_aio.CreateNotifications:
+				util.Assert(command.CreateNotifications != nil, "command must not be nil")
+				results[i][j], err = w.createNotifications(tx, command.CreateNotifications)
+			case t_aio.CreateNotification:
+				util.Assert(command.CreateNotification != nil, "command must not be nil")
+				results[i][j], err = w.createNotification(tx, command.CreateNotification)
+			case t_aio.UpdateNotification:
+				util.Assert(command.UpdateNotification != nil, "command must not be nil")
+				results[i][j], err = w.updateNotification(tx, command.UpdateNotification)
+			case t_aio.DeleteNotification:
+				util.Assert(command.DeleteNotification != nil, "command must not be nil")
+				results[i][j], err = w.deleteNotification(tx, command.DeleteNotification)
+			case t_aio.TimeoutCreateNotifications:
+				util.Assert(command.TimeoutCreateNotifications != nil, "command must not be nil")
+				results[i][j], err = w.timeoutCreateNotifications(tx, command.TimeoutCreateNotifications)
+
+			// Dead notification
+			case t_aio.ReadDeadNotification:
+				util.Assert(command.ReadDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotification(tx, command.ReadDeadNotification)
+			case t_aio.ReadDeadNotifications:
+				util.Assert(command.ReadDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotifications(tx, command.ReadDeadNotifications)
+			case t_aio.CreateDeadNotification:
+				util.Assert(command.CreateDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.createDeadNotification(tx, command.CreateDeadNotification)
+			case t_aio.DeleteDeadNotification:
+				util.Assert(command.DeleteDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotification(tx, command.DeleteDeadNotification)
+			case t_aio.DeleteDeadNotifications:
+				util.Assert(command.DeleteDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotifications(tx, command.DeleteDeadNotifications)
+
+			// Notification attempt
+			case t_aio.ReadNotificationAttempts:
+				util.Assert(command.ReadNotificationAttempts != nil, "command must not be nil")
+				results[i][j], err = w.readNotificationAttempts(tx, command.ReadNotificationAttempts)
+			case t_aio.CreateNotificationAttempt:
+				util.Assert(command.CreateNotificationAttempt != nil, "command must not be nil")
+				results[i][j], err = w.createNotificationAttempt(tx, command.CreateNotificationAttempt)
+
+			default:
+				panic("invalid command")
+			}
+
+			if err != nil {
+				break
+			}
+		}
+
+		if err != nil {
+			tx.rollback(savepoint)
+			results[i], errs[i] = nil, err
+		} else if transaction.Atomic && store.Conflict(results[i]) {
+			tx.rollback(savepoint)
+			store.Discard(results[i])
+		}
+	}
+
+	return results, errs, nil
+}
+
+func (w *MemoryStoreWorker) readPromise(tx *transaction, cmd *t_aio.ReadPromiseCommand) (*t_aio.Result, error) {
+	var records []*promise.PromiseRecord
+
+	if row, ok := tx.promises[cmd.Id]; ok {
+		record := copyPromiseRecord(row.record)
+		record.SortId = 0 // not selected by the sqlite store
+
+		records = append(records, record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadPromise,
+		ReadPromise: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) searchPromises(tx *transaction, cmd *t_aio.SearchPromisesCommand) (*t_aio.Result, error) {
+	search := store.NewSearch(cmd)
+
+	var rows []*promiseRow
+	for _, row := range tx.promisesIndex {
+		if search.Match(row.record, row.tags) {
+			rows = append(rows, row)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		return search.Less(rows[i].record, rows[j].record)
+	})
+
+	if cmd.Limit >= 0 && len(rows) > cmd.Limit {
+		rows = rows[:cmd.Limit]
+	}
+
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for _, row := range rows {
+		records = append(records, copyPromiseRecord(row.record))
+		lastSortId = row.record.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.SearchPromises,
+		SearchPromises: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) createPromise(tx *transaction, cmd *t_aio.CreatePromiseCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Param.Headers != nil, "headers must not be nil")
+	util.Assert(cmd.Param.Data != nil, "data must not be nil")
+	util.Assert(cmd.Tags != nil, "tags must not be nil")
+
+	headers, err := json.Marshal(cmd.Param.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := json.Marshal(cmd.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if _, ok := tx.promises[cmd.Id]; !ok {
+		createdOn := cmd.CreatedOn
+
+		tx.insertPromise(&promiseRow{
+			record: &promise.PromiseRecord{
+				Id:                      cmd.Id,
+				State:                   promise.Pending,
+				ParamHeaders:            headers,
+				ParamData:               bytes.Clone(cmd.Param.Data),
+				Timeout:                 cmd.Timeout,
+				IdempotencyKeyForCreate: copyIdempotencyKey(cmd.IdempotencyKey),
+				Tags:                    tags,
+				CreatedOn:               &createdOn,
+			},
+			tags:      maps.Clone(cmd.Tags),
+			retention: store.Retention(cmd.Tags),
+		})
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreatePromise,
+		CreatePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) updatePromise(tx *transaction, cmd *t_aio.UpdatePromiseCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.State.In(promise.Resolved|promise.Rejected|promise.Canceled|promise.Timedout), "state must be canceled, resolved, rejected, or timedout")
+	util.Assert(cmd.Value.Headers != nil, "value headers must not be nil")
+	util.Assert(cmd.Value.Data != nil, "value data must not be nil")
+
+	headers, err := json.Marshal(cmd.Value.Headers)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if row, ok := tx.promises[cmd.Id]; ok && row.record.State == promise.Pending {
+		completedOn := cmd.CompletedOn
+
+		tx.updatePromise(row, func(r *promise.PromiseRecord) {
+			r.State = cmd.State
+			r.ValueHeaders = headers
+			r.ValueData = bytes.Clone(cmd.Value.Data)
+			r.IdempotencyKeyForComplete = copyIdempotencyKey(cmd.IdempotencyKey)
+			r.CompletedOn = &completedOn
+		})
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.UpdatePromise,
+		UpdatePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) timeoutPromises(tx *transaction, cmd *t_aio.TimeoutPromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	var rowsAffected int64
+
+	for _, row := range tx.timedoutPromises(cmd.Time) {
+		tx.updatePromise(row, func(r *promise.PromiseRecord) {
+			completedOn := r.Timeout
+
+			r.State = promise.Timedout
+			r.CompletedOn = &completedOn
+		})
+
+		rowsAffected++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.TimeoutPromises,
+		TimeoutPromises: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deletePromises(tx *transaction, cmd *t_aio.DeletePromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	rows := tx.expiredPromises(cmd.Time, cmd.Retention)
+	if len(rows) > cmd.Limit {
+		rows = rows[:cmd.Limit]
+	}
+
+	for _, row := range rows {
+		tx.deletePromise(row)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromises,
+		DeletePromises: &t_aio.AlterPromisesResult{
+			RowsAffected: int64(len(rows)),
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readExpiredPromises(tx *transaction, cmd *t_aio.ReadExpiredPromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	rows := tx.expiredPromises(cmd.Time, cmd.Retention)
+	if len(rows) > cmd.Limit {
+		rows = rows[:cmd.Limit]
+	}
+
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for _, row := range rows {
+		records = append(records, copyPromiseRecord(row.record))
+		lastSortId = row.record.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadExpiredPromises,
+		ReadExpiredPromises: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readPromisesByIdempotencyKey(tx *transaction, cmd *t_aio.ReadPromisesByIdempotencyKeyCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	// the index is ordered by sort id, iterate in reverse
+	for i := len(tx.promisesIndex) - 1; i >= 0 && len(records) < cmd.Limit; i-- {
+		record := tx.promisesIndex[i].record
+
+		if cmd.SortId != nil && record.SortId >= *cmd.SortId {
+			continue
+		}
+		if !record.IdempotencyKeyForCreate.Match(&cmd.IdempotencyKey) && !record.IdempotencyKeyForComplete.Match(&cmd.IdempotencyKey) {
+			continue
+		}
+
+		records = append(records, copyPromiseRecord(record))
+		lastSortId = record.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadPromisesByIdempotencyKey,
+		ReadPromisesByIdempotencyKey: &t_aio.QueryPromisesResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deletePromise(tx *transaction, cmd *t_aio.DeletePromiseCommand) (*t_aio.Result, error) {
+	var rowsAffected int64
+
+	if row, ok := tx.promises[cmd.Id]; ok && row.record.State != promise.Pending && !tx.undelivered(cmd.Id) {
+		tx.deletePromise(row)
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromise,
+		DeletePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readTimeouts(tx *transaction, cmd *t_aio.ReadTimeoutsCommand) (*t_aio.Result, error) {
+	timeouts := util.OrderedRange(tx.timeouts)
+
+	// ordered by id, sort stable by time
+	sort.SliceStable(timeouts, func(i, j int) bool {
+		return timeouts[i].Time < timeouts[j].Time
+	})
+
+	if cmd.N >= 0 && len(timeouts) > cmd.N {
+		timeouts = timeouts[:cmd.N]
+	}
+
+	var records []*timeout.TimeoutRecord
+	for _, t := range timeouts {
+		records = append(records, &timeout.TimeoutRecord{Id: t.Id, Time: t.Time})
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadTimeouts,
+		ReadTimeouts: &t_aio.QueryTimeoutsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) createTimeout(tx *transaction, cmd *t_aio.CreateTimeoutCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	var rowsAffected int64
+
+	if _, ok := tx.timeouts[cmd.Id]; !ok {
+		tx.insertTimeout(&timeout.TimeoutRecord{Id: cmd.Id, Time: cmd.Time})
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateTimeout,
+		CreateTimeout: &t_aio.AlterTimeoutsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deleteTimeout(tx *transaction, cmd *t_aio.DeleteTimeoutCommand) (*t_aio.Result, error) {
+	var rowsAffected int64
+
+	if _, ok := tx.timeouts[cmd.Id]; ok {
+		tx.deleteTimeout(cmd.Id)
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteTimeout,
+		DeleteTimeout: &t_aio.AlterTimeoutsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readSubscription(tx *transaction, cmd *t_aio.ReadSubscriptionCommand) (*t_aio.Result, error) {
+	var records []*subscription.SubscriptionRecord
+
+	if s := tx.subscription(cmd.Id, cmd.PromiseId); s != nil {
+		record := copySubscriptionRecord(s)
+		record.SortId = 0 // not selected by the sqlite store
+
+		records = append(records, record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadSubscription,
+		ReadSubscription: &t_aio.QuerySubscriptionsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readSubscriptions(tx *transaction, cmd *t_aio.ReadSubscriptionsCommand) (*t_aio.Result, error) {
+	var records []*subscription.SubscriptionRecord
+	var lastSortId int64
+
+	// subscriptions are ordered by sort id, iterate in reverse
+	subscriptions := tx.subscriptions[cmd.PromiseId]
+	for i := len(subscriptions) - 1; i >= 0 && (cmd.Limit < 0 || len(records) < cmd.Limit); i-- {
+		s := subscriptions[i]
+		if cmd.SortId != nil && s.SortId >= *cmd.SortId {
+			continue
+		}
+
+		records = append(records, copySubscriptionRecord(s))
+		lastSortId = s.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadSubscriptions,
+		ReadSubscriptions: &t_aio.QuerySubscriptionsResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) createSubscription(tx *transaction, cmd *t_aio.CreateSubscriptionCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if tx.subscription(cmd.Id, cmd.PromiseId) == nil {
+		tx.insertSubscription(&subscription.SubscriptionRecord{
+			Id:          cmd.Id,
+			PromiseId:   cmd.PromiseId,
+			Url:         cmd.Url,
+			Method:      cmd.Method,
+			Headers:     headers,
+			Payload:     payload,
+			Secret:      cmd.Secret,
+			RetryPolicy: retryPolicy,
+			CreatedOn:   cmd.CreatedOn,
+		})
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateSubscription,
+		CreateSubscription: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deleteSubscription(tx *transaction, cmd *t_aio.DeleteSubscriptionCommand) (*t_aio.Result, error) {
+	rowsAffected := tx.deleteSubscriptions(cmd.PromiseId, func(s *subscription.SubscriptionRecord) bool {
+		return s.Id == cmd.Id
+	})
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteSubscription,
+		DeleteSubscription: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deleteSubscriptions(tx *transaction, cmd *t_aio.DeleteSubscriptionsCommand) (*t_aio.Result, error) {
+	rowsAffected := tx.deleteSubscriptions(cmd.PromiseId, func(*subscription.SubscriptionRecord) bool {
+		return true
+	})
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteSubscriptions,
+		DeleteSubscriptions: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) timeoutDeleteSubscriptions(tx *transaction, cmd *t_aio.TimeoutDeleteSubscriptionsCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	var rowsAffected int64
+
+	for _, row := range tx.timedoutPromises(cmd.Time) {
+		rowsAffected += tx.deleteSubscriptions(row.record.Id, func(*subscription.SubscriptionRecord) bool {
+			return true
+		})
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.TimeoutDeleteSubscriptions,
+		TimeoutDeleteSubscriptions: &t_aio.AlterSubscriptionsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readNotifications(tx *transaction, cmd *t_aio.ReadNotificationsCommand) (*t_aio.Result, error) {
+	// ordered by promise id and then id, sort stable by time
+	var notifications []*notification.NotificationRecord
+	for _, n := range util.OrderedRange(tx.notifications) {
+		notifications = append(notifications, util.OrderedRange(n)...)
+	}
+
+	sort.SliceStable(notifications, func(i, j int) bool {
+		return notifications[i].Time < notifications[j].Time
+	})
+
+	if cmd.N >= 0 && len(notifications) > cmd.N {
+		notifications = notifications[:cmd.N]
+	}
+
+	var records []*notification.NotificationRecord
+	for _, n := range notifications {
+		records = append(records, copyNotificationRecord(n))
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadNotifications,
+		ReadNotifications: &t_aio.QueryNotificationsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) createNotifications(tx *transaction, cmd *t_aio.CreateNotificationsCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	rowsAffected := tx.insertNotifications(cmd.PromiseId, cmd.Time)
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotifications,
+		CreateNotifications: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) createNotification(tx *transaction, cmd *t_aio.CreateNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if _, ok := tx.notifications[cmd.PromiseId][cmd.Id]; !ok {
+		n := &notification.NotificationRecord{
+			Id:          cmd.Id,
+			PromiseId:   cmd.PromiseId,
+			Url:         cmd.Url,
+			Method:      cmd.Method,
+			Headers:     headers,
+			Payload:     payload,
+			Secret:      cmd.Secret,
+			RetryPolicy: retryPolicy,
+			Time:        cmd.Time,
+			Attempt:     cmd.Attempt,
+		}
+
+		tx.putNotification(n)
+		tx.undo = append(tx.undo, func() {
+			tx.removeNotification(n)
+		})
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotification,
+		CreateNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) updateNotification(tx *transaction, cmd *t_aio.UpdateNotificationCommand) (*t_aio.Result, error) {
+	var rowsAffected int64
+
+	if n, ok := tx.notifications[cmd.PromiseId][cmd.Id]; ok {
+		time, attempt := n.Time, n.Attempt
+		n.Time, n.Attempt = cmd.Time, cmd.Attempt
+
+		tx.undo = append(tx.undo, func() {
+			n.Time, n.Attempt = time, attempt
+		})
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.UpdateNotification,
+		UpdateNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deleteNotification(tx *transaction, cmd *t_aio.DeleteNotificationCommand) (*t_aio.Result, error) {
+	var rowsAffected int64
+
+	if n, ok := tx.notifications[cmd.PromiseId][cmd.Id]; ok {
+		tx.deleteNotification(n)
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteNotification,
+		DeleteNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) timeoutCreateNotifications(tx *transaction, cmd *t_aio.TimeoutCreateNotificationsCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+
+	var rowsAffected int64
+
+	for _, row := range tx.timedoutPromises(cmd.Time) {
+		rowsAffected += tx.insertNotifications(row.record.Id, cmd.Time)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.TimeoutCreateNotifications,
+		TimeoutCreateNotifications: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readDeadNotification(tx *transaction, cmd *t_aio.ReadDeadNotificationCommand) (*t_aio.Result, error) {
+	var records []*notification.DeadNotificationRecord
+
+	if n := tx.deadNotification(cmd.Id, cmd.PromiseId); n != nil {
+		records = append(records, copyDeadNotificationRecord(n))
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotification,
+		ReadDeadNotification: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: int64(len(records)),
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readDeadNotifications(tx *transaction, cmd *t_aio.ReadDeadNotificationsCommand) (*t_aio.Result, error) {
+	var records []*notification.DeadNotificationRecord
+	var lastSortId int64
+
+	// dead notifications are ordered by sort id, iterate in reverse
+	for i := len(tx.deadNotifications) - 1; i >= 0 && (cmd.Limit < 0 || len(records) < cmd.Limit); i-- {
+		n := tx.deadNotifications[i]
+		if cmd.SortId != nil && n.SortId >= *cmd.SortId {
+			continue
+		}
+
+		records = append(records, copyDeadNotificationRecord(n))
+		lastSortId = n.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotifications,
+		ReadDeadNotifications: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) createDeadNotification(tx *transaction, cmd *t_aio.CreateDeadNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	var rowsAffected int64
+
+	if tx.deadNotification(cmd.Id, cmd.PromiseId) == nil {
+		tx.insertDeadNotification(&notification.DeadNotificationRecord{
+			Id:          cmd.Id,
+			PromiseId:   cmd.PromiseId,
+			Url:         cmd.Url,
+			Method:      cmd.Method,
+			Headers:     headers,
+			Payload:     payload,
+			Secret:      cmd.Secret,
+			RetryPolicy: retryPolicy,
+			Attempts:    cmd.Attempts,
+			StatusCode:  copyInt(cmd.StatusCode),
+			Error:       copyString(cmd.Error),
+			CreatedOn:   cmd.CreatedOn,
+		})
+
+		rowsAffected = 1
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateDeadNotification,
+		CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deleteDeadNotification(tx *transaction, cmd *t_aio.DeleteDeadNotificationCommand) (*t_aio.Result, error) {
+	rowsAffected := tx.deleteDeadNotifications(func(n *notification.DeadNotificationRecord) bool {
+		return n.Id == cmd.Id && n.PromiseId == cmd.PromiseId
+	})
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotification,
+		DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) deleteDeadNotifications(tx *transaction, cmd *t_aio.DeleteDeadNotificationsCommand) (*t_aio.Result, error) {
+	rowsAffected := tx.deleteDeadNotifications(func(*notification.DeadNotificationRecord) bool {
+		return true
+	})
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotifications,
+		DeleteDeadNotifications: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) readNotificationAttempts(tx *transaction, cmd *t_aio.ReadNotificationAttemptsCommand) (*t_aio.Result, error) {
+	var records []*notification.NotificationAttemptRecord
+	var lastSortId int64
+
+	// attempts are ordered by sort id, iterate in reverse
+	attempts := tx.notificationAttempts[cmd.PromiseId]
+	for i := len(attempts) - 1; i >= 0 && (cmd.Limit < 0 || len(records) < cmd.Limit); i-- {
+		a := attempts[i]
+		if (cmd.Id != "" && a.Id != cmd.Id) || (cmd.SortId != nil && a.SortId >= *cmd.SortId) {
+			continue
+		}
+
+		records = append(records, copyNotificationAttemptRecord(a))
+		lastSortId = a.SortId
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadNotificationAttempts,
+		ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+			RowsReturned: int64(len(records)),
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *MemoryStoreWorker) createNotificationAttempt(tx *transaction, cmd *t_aio.CreateNotificationAttemptCommand) (*t_aio.Result, error) {
+	tx.insertNotificationAttempt(&notification.NotificationAttemptRecord{
+		Id:         cmd.Id,
+		PromiseId:  cmd.PromiseId,
+		Attempt:    cmd.Attempt,
+		Url:        cmd.Url,
+		StatusCode: copyInt(cmd.StatusCode),
+		Latency:    cmd.Latency,
+		Body:       bytes.Clone(cmd.Body),
+		Error:      copyString(cmd.Error),
+		Time:       cmd.Time,
+	})
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotificationAttempt,
+		CreateNotificationAttempt: &t_aio.AlterNotificationAttemptsResult{
+			RowsAffected: 1,
+		},
+	}, nil
+}
+
+func copyPromiseRecord(r *promise.PromiseRecord) *promise.PromiseRecord {
+	return &promise.PromiseRecord{
+		Id:                        r.Id,
+		State:                     r.State,
+		ParamHeaders:              bytes.Clone(r.ParamHeaders),
+		ParamData:                 bytes.Clone(r.ParamData),
+		ValueHeaders:              bytes.Clone(r.ValueHeaders),
+		ValueData:                 bytes.Clone(r.ValueData),
+		Timeout:                   r.Timeout,
+		IdempotencyKeyForCreate:   copyIdempotencyKey(r.IdempotencyKeyForCreate),
+		IdempotencyKeyForComplete: copyIdempotencyKey(r.IdempotencyKeyForComplete),
+		CreatedOn:                 copyInt64(r.CreatedOn),
+		CompletedOn:               copyInt64(r.CompletedOn),
+		Tags:                      bytes.Clone(r.Tags),
+		SortId:                    r.SortId,
+	}
+}
+
+func copySubscriptionRecord(r *subscription.SubscriptionRecord) *subscription.SubscriptionRecord {
+	return &subscription.SubscriptionRecord{
+		Id:          r.Id,
+		PromiseId:   r.PromiseId,
+		Url:         r.Url,
+		Method:      r.Method,
+		Headers:     bytes.Clone(r.Headers),
+		Payload:     bytes.Clone(r.Payload),
+		Secret:      r.Secret,
+		RetryPolicy: bytes.Clone(r.RetryPolicy),
+		CreatedOn:   r.CreatedOn,
+		SortId:      r.SortId,
+	}
+}
+
+func copyNotificationRecord(r *notification.NotificationRecord) *notification.NotificationRecord {
+	return &notification.NotificationRecord{
+		Id:          r.Id,
+		PromiseId:   r.PromiseId,
+		Url:         r.Url,
+		Method:      r.Method,
+		Headers:     bytes.Clone(r.Headers),
+		Payload:     bytes.Clone(r.Payload),
+		Secret:      r.Secret,
+		RetryPolicy: bytes.Clone(r.RetryPolicy),
+		Time:        r.Time,
+		Attempt:     r.Attempt,
+	}
+}
+
+func copyDeadNotificationRecord(r *notification.DeadNotificationRecord) *notification.DeadNotificationRecord {
+	return &notification.DeadNotificationRecord{
+		Id:          r.Id,
+		PromiseId:   r.PromiseId,
+		Url:         r.Url,
+		Method:      r.Method,
+		Headers:     bytes.Clone(r.Headers),
+		Payload:     bytes.Clone(r.Payload),
+		Secret:      r.Secret,
+		RetryPolicy: bytes.Clone(r.RetryPolicy),
+		Attempts:    r.Attempts,
+		StatusCode:  copyInt(r.StatusCode),
+		Error:       copyString(r.Error),
+		CreatedOn:   r.CreatedOn,
+		SortId:      r.SortId,
+	}
+}
+
+func copyNotificationAttemptRecord(r *notification.NotificationAttemptRecord) *notification.NotificationAttemptRecord {
+	return &notification.NotificationAttemptRecord{
+		Id:         r.Id,
+		PromiseId:  r.PromiseId,
+		Attempt:    r.Attempt,
+		Url:        r.Url,
+		StatusCode: copyInt(r.StatusCode),
+		Latency:    r.Latency,
+		Body:       bytes.Clone(r.Body),
+		Error:      copyString(r.Error),
+		Time:       r.Time,
+		SortId:     r.SortId,
+	}
+}
+
+func copyIdempotencyKey(i *promise.IdempotencyKey) *promise.IdempotencyKey {
+	if i == nil {
+		return nil
+	}
+
+	c := *i
+	return &c
+}
+
+func copyInt64(i *int64) *int64 {
+	if i == nil {
+		return nil
+	}
+
+	c := *i
+	return &c
+}
+
+func copyInt(i *int) *int {
+	if i == nil {
+		return nil
+	}
+
+	c := *i
+	return &c
+}
+
+func copyString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+
+	c := *s
+	return &c
+}
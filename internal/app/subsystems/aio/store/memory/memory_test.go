@@ -0,0 +1,82 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/test"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore(t *testing.T) {
+	for _, tc := range test.TestCases {
+		store, err := New()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Start(); err != nil {
+			t.Fatal(err)
+		}
+
+		tc.Run(t, store)
+
+		if err := store.Stop(); err != nil {
+			t.Fatal(err)
+		}
+		if err := store.Reset(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestIndexes(t *testing.T) {
+	tx := &transaction{tables: newTables()}
+
+	ids := func(rows []*promiseRow) []string {
+		var ids []string
+		for _, row := range rows {
+			ids = append(ids, row.record.Id)
+		}
+		return ids
+	}
+
+	retention := int64(10)
+	for _, row := range []*promiseRow{
+		{record: &promise.PromiseRecord{Id: "a", State: promise.Pending, Timeout: 3}},
+		{record: &promise.PromiseRecord{Id: "b", State: promise.Pending, Timeout: 1}},
+		{record: &promise.PromiseRecord{Id: "c", State: promise.Pending, Timeout: 2}, retention: &retention},
+		{record: &promise.PromiseRecord{Id: "d", State: promise.Pending, Timeout: 1}},
+	} {
+		tx.insertPromise(row)
+	}
+
+	assert.Equal(t, []string{"b", "d", "c"}, ids(tx.timedoutPromises(2)))
+
+	savepoint := tx.savepoint()
+
+	// complete all promises that have timed out
+	for _, row := range tx.timedoutPromises(2) {
+		tx.updatePromise(row, func(r *promise.PromiseRecord) {
+			r.State = promise.Timedout
+			r.CompletedOn = &r.Timeout
+		})
+	}
+
+	assert.Equal(t, []string{"a"}, ids(tx.timedoutPromises(3)))
+	assert.Equal(t, []string{"b", "d"}, ids(tx.expiredPromises(11, int64ToPointer(10))))
+	assert.Equal(t, []string{"b", "d", "c"}, ids(tx.expiredPromises(12, int64ToPointer(10))))
+	assert.Equal(t, []string{"c"}, ids(tx.expiredPromises(12, nil)))
+
+	tx.deletePromise(tx.promises["d"])
+	assert.Equal(t, []string{"b", "c"}, ids(tx.expiredPromises(12, int64ToPointer(10))))
+
+	// rollback restores the indexes
+	tx.rollback(savepoint)
+
+	assert.Equal(t, []string{"b", "d", "c", "a"}, ids(tx.timedoutPromises(3)))
+	assert.Empty(t, tx.expiredPromises(100, int64ToPointer(0)))
+}
+
+func int64ToPointer(i int64) *int64 {
+	return &i
+}
@@ -0,0 +1,402 @@
+package memory
+
+import (
+	"bytes"
+	"slices"
+	"sort"
+
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/notification"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/subscription"
+	"github.com/resonatehq/resonate/pkg/timeout"
+)
+
+// transaction applies changes directly to the tables and records how to
+// undo each change, changes are undone in reverse order on rollback.
+type transaction struct {
+	*tables
+	undo []func()
+}
+
+func (tx *transaction) savepoint() int {
+	return len(tx.undo)
+}
+
+func (tx *transaction) rollback(savepoint int) {
+	for i := len(tx.undo) - 1; i >= savepoint; i-- {
+		tx.undo[i]()
+	}
+
+	tx.undo = tx.undo[:savepoint]
+}
+
+// Promises
+
+func (tx *transaction) insertPromise(row *promiseRow) {
+	tx.promiseSortId++
+	row.record.SortId = tx.promiseSortId
+
+	tx.promises[row.record.Id] = row
+	tx.promisesIndex = append(tx.promisesIndex, row)
+	tx.indexPromise(row)
+
+	tx.undo = append(tx.undo, func() {
+		tx.unindexPromise(row)
+		delete(tx.promises, row.record.Id)
+		tx.promisesIndex = tx.promisesIndex[:len(tx.promisesIndex)-1]
+		tx.promiseSortId--
+	})
+}
+
+func (tx *transaction) updatePromise(row *promiseRow, f func(*promise.PromiseRecord)) {
+	record := *row.record
+
+	tx.unindexPromise(row)
+	f(row.record)
+	tx.indexPromise(row)
+
+	tx.undo = append(tx.undo, func() {
+		tx.unindexPromise(row)
+		*row.record = record
+		tx.indexPromise(row)
+	})
+}
+
+// indexPromise adds a promise to the timeout index if pending and to
+// the completed on or expiry index if completed.
+func (tx *transaction) indexPromise(row *promiseRow) {
+	if row.record.State == promise.Pending {
+		tx.promisesByTimeout.insert(row)
+	}
+
+	if row.record.CompletedOn != nil {
+		if row.retention != nil {
+			tx.promisesByExpiry.insert(row)
+		} else {
+			tx.promisesByCompletedOn.insert(row)
+		}
+	}
+}
+
+func (tx *transaction) unindexPromise(row *promiseRow) {
+	if row.record.State == promise.Pending {
+		tx.promisesByTimeout.remove(row)
+	}
+
+	if row.record.CompletedOn != nil {
+		if row.retention != nil {
+			tx.promisesByExpiry.remove(row)
+		} else {
+			tx.promisesByCompletedOn.remove(row)
+		}
+	}
+}
+
+// timedoutPromises returns all pending promises with a timeout before
+// or equal to the given time, ordered by timeout and sort id.
+func (tx *transaction) timedoutPromises(time int64) []*promiseRow {
+	return tx.promisesByTimeout.until(time)
+}
+
+// expiredPromises returns all completed promises whose retention has
+// elapsed at the given time, ordered by completed on and sort id.
+// Promises with pending or dead notifications do not expire until the
+// notifications are delivered or deleted.
+func (tx *transaction) expiredPromises(time int64, retention *int64) []*promiseRow {
+	rows := tx.promisesByExpiry.until(time)
+	if retention != nil {
+		rows = append(rows, tx.promisesByCompletedOn.until(time-*retention)...)
+	}
+
+	dead := map[string]bool{}
+	for _, n := range tx.deadNotifications {
+		dead[n.PromiseId] = true
+	}
+
+	rows = slices.DeleteFunc(rows, func(row *promiseRow) bool {
+		_, ok := tx.notifications[row.record.Id]
+		return ok || dead[row.record.Id]
+	})
+
+	sort.Slice(rows, func(i, j int) bool {
+		if *rows[i].record.CompletedOn != *rows[j].record.CompletedOn {
+			return *rows[i].record.CompletedOn < *rows[j].record.CompletedOn
+		}
+
+		return rows[i].record.SortId < rows[j].record.SortId
+	})
+
+	return rows
+}
+
+// undelivered reports whether a promise has pending or dead
+// notifications.
+func (tx *transaction) undelivered(promiseId string) bool {
+	if _, ok := tx.notifications[promiseId]; ok {
+		return true
+	}
+
+	return slices.ContainsFunc(tx.deadNotifications, func(n *notification.DeadNotificationRecord) bool {
+		return n.PromiseId == promiseId
+	})
+}
+
+// deletePromise deletes a promise along with its subscriptions,
+// notifications, dead notifications, and notification attempts.
+func (tx *transaction) deletePromise(row *promiseRow) {
+	for _, n := range util.OrderedRange(tx.notifications[row.record.Id]) {
+		tx.deleteNotification(n)
+	}
+
+	tx.deleteDeadNotifications(func(n *notification.DeadNotificationRecord) bool {
+		return n.PromiseId == row.record.Id
+	})
+
+	tx.deleteNotificationAttempts(row.record.Id)
+
+	tx.deleteSubscriptions(row.record.Id, func(*subscription.SubscriptionRecord) bool { return true })
+
+	// copy on delete, the previous index is restored on undo
+	index := tx.promisesIndex
+	tx.promisesIndex = slices.DeleteFunc(slices.Clone(index), func(r *promiseRow) bool {
+		return r == row
+	})
+	delete(tx.promises, row.record.Id)
+	tx.unindexPromise(row)
+
+	tx.undo = append(tx.undo, func() {
+		tx.indexPromise(row)
+		tx.promises[row.record.Id] = row
+		tx.promisesIndex = index
+	})
+}
+
+// Timeouts
+
+func (tx *transaction) insertTimeout(t *timeout.TimeoutRecord) {
+	tx.timeouts[t.Id] = t
+
+	tx.undo = append(tx.undo, func() {
+		delete(tx.timeouts, t.Id)
+	})
+}
+
+func (tx *transaction) deleteTimeout(id string) {
+	t := tx.timeouts[id]
+	delete(tx.timeouts, id)
+
+	tx.undo = append(tx.undo, func() {
+		tx.timeouts[id] = t
+	})
+}
+
+// Subscriptions
+
+func (tx *transaction) subscription(id string, promiseId string) *subscription.SubscriptionRecord {
+	for _, s := range tx.subscriptions[promiseId] {
+		if s.Id == id {
+			return s
+		}
+	}
+
+	return nil
+}
+
+func (tx *transaction) insertSubscription(s *subscription.SubscriptionRecord) {
+	tx.subscriptionSortId++
+	s.SortId = tx.subscriptionSortId
+
+	// copy on append, the previous slice is restored on undo
+	subscriptions := tx.subscriptions[s.PromiseId]
+	tx.setSubscriptions(s.PromiseId, append(subscriptions[:len(subscriptions):len(subscriptions)], s))
+
+	tx.undo = append(tx.undo, func() {
+		tx.setSubscriptions(s.PromiseId, subscriptions)
+		tx.subscriptionSortId--
+	})
+}
+
+// deleteSubscriptions deletes all subscriptions of a promise that match
+// and returns the number of deleted subscriptions.
+func (tx *transaction) deleteSubscriptions(promiseId string, match func(*subscription.SubscriptionRecord) bool) int64 {
+	subscriptions := tx.subscriptions[promiseId]
+
+	var remaining []*subscription.SubscriptionRecord
+	for _, s := range subscriptions {
+		if !match(s) {
+			remaining = append(remaining, s)
+		}
+	}
+
+	deleted := int64(len(subscriptions) - len(remaining))
+	if deleted == 0 {
+		return 0
+	}
+
+	tx.setSubscriptions(promiseId, remaining)
+
+	tx.undo = append(tx.undo, func() {
+		tx.setSubscriptions(promiseId, subscriptions)
+	})
+
+	return deleted
+}
+
+func (tx *transaction) setSubscriptions(promiseId string, subscriptions []*subscription.SubscriptionRecord) {
+	if len(subscriptions) == 0 {
+		delete(tx.subscriptions, promiseId)
+	} else {
+		tx.subscriptions[promiseId] = subscriptions
+	}
+}
+
+// Notifications
+
+// insertNotifications creates a notification for each subscription of a
+// promise, unless the notification already exists, and returns the
+// number of created notifications.
+func (tx *transaction) insertNotifications(promiseId string, time int64) int64 {
+	var inserted int64
+
+	for _, s := range tx.subscriptions[promiseId] {
+		if _, ok := tx.notifications[promiseId][s.Id]; ok {
+			continue
+		}
+
+		n := &notification.NotificationRecord{
+			Id:          s.Id,
+			PromiseId:   s.PromiseId,
+			Url:         s.Url,
+			Method:      s.Method,
+			Headers:     bytes.Clone(s.Headers),
+			Payload:     bytes.Clone(s.Payload),
+			Secret:      s.Secret,
+			RetryPolicy: bytes.Clone(s.RetryPolicy),
+			Time:        time,
+			Attempt:     0,
+		}
+
+		tx.putNotification(n)
+		tx.undo = append(tx.undo, func() {
+			tx.removeNotification(n)
+		})
+
+		inserted++
+	}
+
+	return inserted
+}
+
+func (tx *transaction) deleteNotification(n *notification.NotificationRecord) {
+	tx.removeNotification(n)
+
+	tx.undo = append(tx.undo, func() {
+		tx.putNotification(n)
+	})
+}
+
+func (tx *transaction) putNotification(n *notification.NotificationRecord) {
+	if _, ok := tx.notifications[n.PromiseId]; !ok {
+		tx.notifications[n.PromiseId] = map[string]*notification.NotificationRecord{}
+	}
+
+	tx.notifications[n.PromiseId][n.Id] = n
+}
+
+func (tx *transaction) removeNotification(n *notification.NotificationRecord) {
+	delete(tx.notifications[n.PromiseId], n.Id)
+
+	if len(tx.notifications[n.PromiseId]) == 0 {
+		delete(tx.notifications, n.PromiseId)
+	}
+}
+
+// Dead notifications
+
+func (tx *transaction) deadNotification(id string, promiseId string) *notification.DeadNotificationRecord {
+	for _, n := range tx.deadNotifications {
+		if n.Id == id && n.PromiseId == promiseId {
+			return n
+		}
+	}
+
+	return nil
+}
+
+func (tx *transaction) insertDeadNotification(n *notification.DeadNotificationRecord) {
+	tx.deadNotificationSortId++
+	n.SortId = tx.deadNotificationSortId
+
+	// copy on append, the previous slice is restored on undo
+	deadNotifications := tx.deadNotifications
+	tx.deadNotifications = append(deadNotifications[:len(deadNotifications):len(deadNotifications)], n)
+
+	tx.undo = append(tx.undo, func() {
+		tx.deadNotifications = deadNotifications
+		tx.deadNotificationSortId--
+	})
+}
+
+// deleteDeadNotifications deletes all dead notifications that match and
+// returns the number of deleted dead notifications.
+func (tx *transaction) deleteDeadNotifications(match func(*notification.DeadNotificationRecord) bool) int64 {
+	deadNotifications := tx.deadNotifications
+
+	var remaining []*notification.DeadNotificationRecord
+	for _, n := range deadNotifications {
+		if !match(n) {
+			remaining = append(remaining, n)
+		}
+	}
+
+	deleted := int64(len(deadNotifications) - len(remaining))
+	if deleted == 0 {
+		return 0
+	}
+
+	tx.deadNotifications = remaining
+
+	tx.undo = append(tx.undo, func() {
+		tx.deadNotifications = deadNotifications
+	})
+
+	return deleted
+}
+
+// Notification attempts
+
+func (tx *transaction) insertNotificationAttempt(a *notification.NotificationAttemptRecord) {
+	tx.notificationAttemptSortId++
+	a.SortId = tx.notificationAttemptSortId
+
+	// copy on append, the previous slice is restored on undo
+	attempts := tx.notificationAttempts[a.PromiseId]
+	tx.notificationAttempts[a.PromiseId] = append(attempts[:len(attempts):len(attempts)], a)
+
+	tx.undo = append(tx.undo, func() {
+		tx.setNotificationAttempts(a.PromiseId, attempts)
+		tx.notificationAttemptSortId--
+	})
+}
+
+func (tx *transaction) deleteNotificationAttempts(promiseId string) {
+	attempts, ok := tx.notificationAttempts[promiseId]
+	if !ok {
+		return
+	}
+
+	delete(tx.notificationAttempts, promiseId)
+
+	tx.undo = append(tx.undo, func() {
+		tx.notificationAttempts[promiseId] = attempts
+	})
+}
+
+func (tx *transaction) setNotificationAttempts(promiseId string, attempts []*notification.NotificationAttemptRecord) {
+	if len(attempts) == 0 {
+		delete(tx.notificationAttempts, promiseId)
+	} else {
+		tx.notificationAttempts[promiseId] = attempts
+	}
+}
@@ -0,0 +1,211 @@
+package migrations
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/util"
+)
+
+// Migration is a versioned schema change, migrations are applied in
+// ascending order of version and reverted in descending order.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+func (m *Migration) String() string {
+	return fmt.Sprintf("%d_%s", m.Version, m.Name)
+}
+
+// Dialect contains the statements, specific to a database, used to
+// track applied migrations in the schema_migrations table.
+type Dialect struct {
+	// executed at the beginning of each migration transaction, must
+	// create the schema_migrations table if it does not exist and
+	// acquire a lock that is held until the transaction completes
+	Setup []string
+
+	// selects the version and applied on time of all applied migrations
+	Select string
+
+	// inserts a version, name, and applied on time
+	Insert string
+
+	// deletes a version
+	Delete string
+}
+
+type Status struct {
+	Migration *Migration
+	AppliedOn *int64
+}
+
+func (s *Status) Applied() bool {
+	return s.AppliedOn != nil
+}
+
+type Migrator struct {
+	db         *sql.DB
+	dialect    *Dialect
+	migrations []*Migration
+}
+
+func New(db *sql.DB, dialect *Dialect, migrations []*Migration) *Migrator {
+	// sort a copy, migrations must be in ascending order of version
+	sorted := make([]*Migration, len(migrations))
+	copy(sorted, migrations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Version < sorted[j].Version })
+
+	for i := 1; i < len(sorted); i++ {
+		util.Assert(sorted[i-1].Version != sorted[i].Version, "migration versions must be unique")
+	}
+
+	return &Migrator{
+		db:         db,
+		dialect:    dialect,
+		migrations: sorted,
+	}
+}
+
+// Up applies all pending migrations in a single transaction and returns
+// the migrations that were applied.
+func (m *Migrator) Up() ([]*Migration, error) {
+	var applied []*Migration
+
+	err := m.transaction(func(tx *sql.Tx, versions map[int64]int64) error {
+		for _, migration := range m.migrations {
+			if _, ok := versions[migration.Version]; ok {
+				continue
+			}
+
+			if _, err := tx.Exec(migration.Up); err != nil {
+				return fmt.Errorf("failed to apply migration %s: %w", migration, err)
+			}
+			if _, err := tx.Exec(m.dialect.Insert, migration.Version, migration.Name, time.Now().UnixMilli()); err != nil {
+				return err
+			}
+
+			applied = append(applied, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
+
+// Down reverts the n most recently applied migrations in a single
+// transaction and returns the migrations that were reverted.
+func (m *Migrator) Down(n int) ([]*Migration, error) {
+	var reverted []*Migration
+
+	err := m.transaction(func(tx *sql.Tx, versions map[int64]int64) error {
+		for i := len(m.migrations) - 1; i >= 0 && len(reverted) < n; i-- {
+			migration := m.migrations[i]
+			if _, ok := versions[migration.Version]; !ok {
+				continue
+			}
+
+			if _, err := tx.Exec(migration.Down); err != nil {
+				return fmt.Errorf("failed to revert migration %s: %w", migration, err)
+			}
+			if _, err := tx.Exec(m.dialect.Delete, migration.Version); err != nil {
+				return err
+			}
+
+			reverted = append(reverted, migration)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reverted, nil
+}
+
+// Status returns the status of all known migrations in ascending order
+// of version.
+func (m *Migrator) Status() ([]*Status, error) {
+	var statuses []*Status
+
+	err := m.transaction(func(tx *sql.Tx, versions map[int64]int64) error {
+		for _, migration := range m.migrations {
+			status := &Status{Migration: migration}
+			if appliedOn, ok := versions[migration.Version]; ok {
+				status.AppliedOn = &appliedOn
+			}
+
+			statuses = append(statuses, status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+func (m *Migrator) transaction(f func(*sql.Tx, map[int64]int64) error) error {
+	tx, err := m.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if err := m.execute(tx, f); err != nil {
+		if err := tx.Rollback(); err != nil {
+			return err
+		}
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (m *Migrator) execute(tx *sql.Tx, f func(*sql.Tx, map[int64]int64) error) error {
+	for _, stmt := range m.dialect.Setup {
+		if _, err := tx.Exec(stmt); err != nil {
+			return err
+		}
+	}
+
+	versions, err := m.versions(tx)
+	if err != nil {
+		return err
+	}
+
+	return f(tx, versions)
+}
+
+// versions returns the applied on time of all applied migrations keyed
+// by version, rows must be closed before the transaction is used again
+func (m *Migrator) versions(tx *sql.Tx) (map[int64]int64, error) {
+	rows, err := tx.Query(m.dialect.Select)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	versions := map[int64]int64{}
+	for rows.Next() {
+		var version, appliedOn int64
+		if err := rows.Scan(&version, &appliedOn); err != nil {
+			return nil, err
+		}
+
+		versions[version] = appliedOn
+	}
+
+	return versions, rows.Err()
+}
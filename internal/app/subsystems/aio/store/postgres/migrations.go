@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/migrations"
+)
+
+// Migrations must never be modified once released, changes to the
+// schema must be added as a new migration with the next version.
+var Migrations = []*migrations.Migration{
+	{
+		Version: 1,
+		Name:    "init",
+		Up: `
+		CREATE TABLE IF NOT EXISTS promises (
+			id                           TEXT,
+			sort_id                      SERIAL,
+			state                        INTEGER DEFAULT 1,
+			param_headers                BYTEA,
+			param_data                   BYTEA,
+			value_headers                BYTEA,
+			value_data                   BYTEA,
+			timeout                      BIGINT,
+			idempotency_key_for_create   TEXT,
+			idempotency_key_for_complete TEXT,
+			tags                         BYTEA,
+			created_on                   BIGINT,
+			completed_on                 BIGINT,
+			PRIMARY KEY(id)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_promises_sort_id ON promises(sort_id);
+
+		CREATE TABLE IF NOT EXISTS timeouts (
+			id   TEXT,
+			time BIGINT,
+			PRIMARY KEY(id)
+		);
+
+		CREATE TABLE IF NOT EXISTS subscriptions (
+			id           TEXT,
+			sort_id      SERIAL,
+			promise_id   TEXT,
+			url          TEXT,
+			retry_policy BYTEA,
+			created_on   BIGINT,
+			PRIMARY KEY(id, promise_id)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_subscriptions_sort_id ON subscriptions(sort_id);
+
+		CREATE TABLE IF NOT EXISTS notifications (
+			id           TEXT,
+			promise_id   TEXT,
+			url          TEXT,
+			retry_policy BYTEA,
+			time         BIGINT,
+			attempt      INTEGER,
+			PRIMARY KEY(id, promise_id)
+		);`,
+		Down: `
+		DROP TABLE notifications;
+		DROP TABLE subscriptions;
+		DROP TABLE timeouts;
+		DROP TABLE promises;`,
+	},
+	{
+		Version: 2,
+		Name:    "promise_retention",
+		Up: `
+		ALTER TABLE promises ADD COLUMN IF NOT EXISTS retention BIGINT;
+		CREATE INDEX IF NOT EXISTS idx_promises_completed_on ON promises(completed_on);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_completed_on;
+		ALTER TABLE promises DROP COLUMN IF EXISTS retention;`,
+	},
+	{
+		Version: 3,
+		Name:    "promise_idempotency_keys",
+		Up: `
+		CREATE INDEX IF NOT EXISTS idx_promises_idempotency_key_for_create ON promises(idempotency_key_for_create);
+		CREATE INDEX IF NOT EXISTS idx_promises_idempotency_key_for_complete ON promises(idempotency_key_for_complete);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_idempotency_key_for_complete;
+		DROP INDEX IF EXISTS idx_promises_idempotency_key_for_create;`,
+	},
+	{
+		Version: 4,
+		Name:    "subscription_delivery",
+		Up: `
+		ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS method TEXT NOT NULL DEFAULT '';
+		ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS headers BYTEA;
+		ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS payload BYTEA;
+		ALTER TABLE notifications ADD COLUMN IF NOT EXISTS method TEXT NOT NULL DEFAULT '';
+		ALTER TABLE notifications ADD COLUMN IF NOT EXISTS headers BYTEA;
+		ALTER TABLE notifications ADD COLUMN IF NOT EXISTS payload BYTEA;`,
+		Down: `
+		ALTER TABLE notifications DROP COLUMN IF EXISTS payload;
+		ALTER TABLE notifications DROP COLUMN IF EXISTS headers;
+		ALTER TABLE notifications DROP COLUMN IF EXISTS method;
+		ALTER TABLE subscriptions DROP COLUMN IF EXISTS payload;
+		ALTER TABLE subscriptions DROP COLUMN IF EXISTS headers;
+		ALTER TABLE subscriptions DROP COLUMN IF EXISTS method;`,
+	},
+	{
+		Version: 5,
+		Name:    "subscription_secret",
+		Up: `
+		ALTER TABLE subscriptions ADD COLUMN IF NOT EXISTS secret TEXT NOT NULL DEFAULT '';
+		ALTER TABLE notifications ADD COLUMN IF NOT EXISTS secret TEXT NOT NULL DEFAULT '';`,
+		Down: `
+		ALTER TABLE notifications DROP COLUMN IF EXISTS secret;
+		ALTER TABLE subscriptions DROP COLUMN IF EXISTS secret;`,
+	},
+	{
+		Version: 6,
+		Name:    "dead_notifications",
+		Up: `
+		CREATE TABLE IF NOT EXISTS dead_notifications (
+			id           TEXT,
+			sort_id      SERIAL,
+			promise_id   TEXT,
+			url          TEXT,
+			method       TEXT NOT NULL DEFAULT '',
+			headers      BYTEA,
+			payload      BYTEA,
+			secret       TEXT NOT NULL DEFAULT '',
+			retry_policy BYTEA,
+			attempts     INTEGER,
+			status_code  INTEGER,
+			error        TEXT,
+			created_on   BIGINT,
+			PRIMARY KEY(id, promise_id)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_dead_notifications_sort_id ON dead_notifications(sort_id);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_dead_notifications_sort_id;
+		DROP TABLE IF EXISTS dead_notifications;`,
+	},
+	{
+		Version: 7,
+		Name:    "notification_attempts",
+		Up: `
+		CREATE TABLE IF NOT EXISTS notification_attempts (
+			id          TEXT,
+			promise_id  TEXT,
+			sort_id     SERIAL,
+			attempt     INTEGER,
+			url         TEXT,
+			status_code INTEGER,
+			latency     BIGINT,
+			body        BYTEA,
+			error       TEXT,
+			time        BIGINT,
+			PRIMARY KEY(sort_id)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_notification_attempts_id ON notification_attempts(promise_id, id, sort_id);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_notification_attempts_id;
+		DROP TABLE IF EXISTS notification_attempts;`,
+	},
+	{
+		Version: 8,
+		Name:    "promise_tags",
+		Up: `
+		ALTER TABLE promises ADD COLUMN IF NOT EXISTS tags_jsonb JSONB;
+		UPDATE promises SET tags_jsonb = convert_from(tags, 'UTF8')::jsonb WHERE tags_jsonb IS NULL AND tags IS NOT NULL;
+		CREATE INDEX IF NOT EXISTS idx_promises_tags ON promises USING GIN(tags_jsonb);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_tags;
+		ALTER TABLE promises DROP COLUMN IF EXISTS tags_jsonb;`,
+	},
+	{
+		Version: 9,
+		Name:    "promise_expires_on",
+		Up: `
+		ALTER TABLE promises ADD COLUMN IF NOT EXISTS expires_on BIGINT;
+
+		UPDATE promises SET expires_on = completed_on + retention WHERE completed_on IS NOT NULL AND retention IS NOT NULL;
+
+		CREATE INDEX IF NOT EXISTS idx_promises_expires_on ON promises(expires_on);
+		CREATE INDEX IF NOT EXISTS idx_promises_completed_on_without_retention ON promises(completed_on, sort_id) WHERE retention IS NULL;`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_completed_on_without_retention;
+		DROP INDEX IF EXISTS idx_promises_expires_on;
+		ALTER TABLE promises DROP COLUMN IF EXISTS expires_on;`,
+	},
+	{
+		Version: 10,
+		Name:    "notifications_promise_id",
+		Up: `
+		CREATE INDEX IF NOT EXISTS idx_notifications_promise_id ON notifications(promise_id);
+		CREATE INDEX IF NOT EXISTS idx_dead_notifications_promise_id ON dead_notifications(promise_id);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_dead_notifications_promise_id;
+		DROP INDEX IF EXISTS idx_notifications_promise_id;`,
+	},
+	{
+		Version: 11,
+		Name:    "promise_search_sort",
+		Up: `
+		UPDATE promises SET created_on = 0 WHERE created_on IS NULL;
+		ALTER TABLE promises ALTER COLUMN created_on SET NOT NULL;
+
+		CREATE INDEX IF NOT EXISTS idx_promises_created_on ON promises(created_on, sort_id);
+		CREATE INDEX IF NOT EXISTS idx_promises_timeout ON promises(timeout, sort_id);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_timeout;
+		DROP INDEX IF EXISTS idx_promises_created_on;
+		ALTER TABLE promises ALTER COLUMN created_on DROP NOT NULL;`,
+	},
+}
+
+var dialect = &migrations.Dialect{
+	Setup: []string{
+		// an advisory lock serializes migrations across all servers, it
+		// is released when the transaction completes
+		`SELECT pg_advisory_xact_lock(hashtext('schema_migrations'))`,
+		`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    BIGINT,
+			name       TEXT,
+			applied_on BIGINT,
+			PRIMARY KEY(version)
+		)`,
+	},
+	Select: `SELECT version, applied_on FROM schema_migrations ORDER BY version`,
+	Insert: `INSERT INTO schema_migrations (version, name, applied_on) VALUES ($1, $2, $3)`,
+	Delete: `DELETE FROM schema_migrations WHERE version = $1`,
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/migrations"
 	"github.com/resonatehq/resonate/internal/kernel/bus"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 
@@ -20,63 +21,12 @@ import (
 	"github.com/resonatehq/resonate/pkg/subscription"
 	"github.com/resonatehq/resonate/pkg/timeout"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
 const (
-	CREATE_TABLE_STATEMENT = `
-	CREATE TABLE IF NOT EXISTS promises (
-		id                           TEXT,
-		sort_id                      SERIAL,
-		state                        INTEGER DEFAULT 1,
-		param_headers                BYTEA,
-		param_data                   BYTEA,
-		value_headers                BYTEA,
-		value_data                   BYTEA,
-		timeout                      BIGINT,
-		idempotency_key_for_create   TEXT,
-		idempotency_key_for_complete TEXT,
-		tags                         BYTEA,
-		created_on                   BIGINT,
-		completed_on                 BIGINT,
-		PRIMARY KEY(id)
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_promises_sort_id ON promises(sort_id);
-
-	CREATE TABLE IF NOT EXISTS timeouts (
-		id   TEXT,
-		time BIGINT,
-		PRIMARY KEY(id)
-	);
-
-	CREATE TABLE IF NOT EXISTS subscriptions (
-		id           TEXT,
-		sort_id      SERIAL,
-		promise_id   TEXT,
-		url          TEXT,
-		retry_policy BYTEA,
-		created_on   BIGINT,
-		PRIMARY KEY(id, promise_id)
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_subscriptions_sort_id ON subscriptions(sort_id);
-
-	CREATE TABLE IF NOT EXISTS notifications (
-		id           TEXT,
-		promise_id   TEXT,
-		url          TEXT,
-		retry_policy BYTEA,
-		time         BIGINT,
-		attempt      INTEGER,
-		PRIMARY KEY(id, promise_id)
-	);`
-
-	DROP_TABLE_STATEMENT = `
-	DROP TABLE notifications;
-	DROP TABLE subscriptions;
-	DROP TABLE timeouts;
-	DROP TABLE promises;`
+	DROP_MIGRATIONS_TABLE_STATEMENT = `
+	DROP TABLE IF EXISTS schema_migrations`
 
 	PROMISE_SELECT_STATEMENT = `
 	SELECT
@@ -92,26 +42,32 @@ const (
 	FROM
 		promises
 	WHERE
-		($1::int IS NULL OR sort_id < $1) AND
-		state & $2 != 0 AND
-		id LIKE $3
+		($1::bigint IS NULL OR %[1]s %[2]s $1 OR (%[1]s = $1 AND sort_id %[2]s $2)) AND
+		state & $3 != 0 AND
+		id LIKE $4 AND
+		($5::bigint IS NULL OR created_on >= $5) AND
+		($6::bigint IS NULL OR created_on < $6) AND
+		($7::bigint IS NULL OR completed_on >= $7) AND
+		($8::bigint IS NULL OR completed_on < $8) AND
+		($9::bigint IS NULL OR timeout < $9) AND
+		($10::jsonb = '{}'::jsonb OR tags_jsonb @> $10::jsonb)
 	ORDER BY
-		sort_id DESC
+		%[1]s %[3]s, sort_id %[3]s
 	LIMIT
-		$4`
+		$11`
 
 	PROMISE_INSERT_STATEMENT = `
 	INSERT INTO promises
-	    (id, state, param_headers, param_data, timeout, idempotency_key_for_create, tags, created_on)
+	    (id, state, param_headers, param_data, timeout, idempotency_key_for_create, tags, tags_jsonb, created_on, retention)
 	VALUES
-	    ($1, $2, $3, $4, $5, $6, $7, $8)
+	    ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 	ON CONFLICT(id) DO NOTHING`
 
 	PROMISE_UPDATE_STATMENT = `
 	UPDATE
 		promises
     SET
-		state = $1, value_headers = $2, value_data = $3, idempotency_key_for_complete = $4, completed_on = $5
+		state = $1, value_headers = $2, value_data = $3, idempotency_key_for_complete = $4, completed_on = $5, expires_on = $5 + retention
     WHERE
 		id = $6 AND state = 1`
 
@@ -119,10 +75,77 @@ const (
 	UPDATE
 		promises
 	SET
-		state = 8, completed_on = timeout
+		state = 8, completed_on = timeout, expires_on = timeout + retention
 	WHERE
 		state = 1 AND timeout <= $1`
 
+	// promises with a retention expire on expires_on, all other promises
+	// expire once completed before the given time, each branch is served
+	// by an index and limited before the union. promises with pending or
+	// dead notifications do not expire until the notifications are
+	// delivered or deleted
+	PROMISE_SELECT_EXPIRED_RECORDS_STATEMENT = `
+	(
+		SELECT
+			id, state, param_headers, param_data, value_headers, value_data, timeout, idempotency_key_for_create, idempotency_key_for_complete, tags, created_on, completed_on, sort_id
+		FROM
+			promises
+		WHERE
+			expires_on <= $1 AND
+			NOT EXISTS (SELECT 1 FROM notifications WHERE promise_id = promises.id) AND
+			NOT EXISTS (SELECT 1 FROM dead_notifications WHERE promise_id = promises.id)
+		ORDER BY
+			completed_on, sort_id
+		LIMIT
+			$3
+	)
+	UNION ALL
+	(
+		SELECT
+			id, state, param_headers, param_data, value_headers, value_data, timeout, idempotency_key_for_create, idempotency_key_for_complete, tags, created_on, completed_on, sort_id
+		FROM
+			promises
+		WHERE
+			retention IS NULL AND completed_on <= $2 AND
+			NOT EXISTS (SELECT 1 FROM notifications WHERE promise_id = promises.id) AND
+			NOT EXISTS (SELECT 1 FROM dead_notifications WHERE promise_id = promises.id)
+		ORDER BY
+			completed_on, sort_id
+		LIMIT
+			$3
+	)
+	ORDER BY
+		completed_on, sort_id
+	LIMIT
+		$3`
+
+	PROMISE_SELECT_EXPIRED_STATEMENT = `
+	SELECT id FROM (` + PROMISE_SELECT_EXPIRED_RECORDS_STATEMENT + `) AS expired`
+
+	PROMISE_SELECT_BY_IDEMPOTENCY_KEY_STATEMENT = `
+	SELECT
+		id, state, param_headers, param_data, value_headers, value_data, timeout, idempotency_key_for_create, idempotency_key_for_complete, tags, created_on, completed_on, sort_id
+	FROM
+		promises
+	WHERE
+		($1::int IS NULL OR sort_id < $1) AND
+		(idempotency_key_for_create = $2 OR idempotency_key_for_complete = $2)
+	ORDER BY
+		sort_id DESC
+	LIMIT
+		$3`
+
+	PROMISE_DELETE_COMPLETED_STATEMENT = `
+	DELETE FROM
+		promises
+	WHERE
+		id = $1 AND state != 1 AND
+		NOT EXISTS (SELECT 1 FROM notifications WHERE promise_id = promises.id) AND
+		NOT EXISTS (SELECT 1 FROM dead_notifications WHERE promise_id = promises.id)`
+
+	PROMISE_DELETE_EXPIRED_STATEMENT = `
+	DELETE FROM promises WHERE id = ANY($1)`
+
 	TIMEOUT_SELECT_STATEMENT = `
 	SELECT
         id, time
@@ -144,7 +167,7 @@ const (
 
 	SUBSCRIPTION_SELECT_STATEMENT = `
 	SELECT
-		id, promise_id, url, retry_policy, created_on
+		id, promise_id, url, method, headers, payload, secret, retry_policy, created_on
 	FROM
 		subscriptions
 	WHERE
@@ -152,7 +175,7 @@ const (
 
 	SUBSCRIPTION_SELECT_ALL_STATEMENT = `
 	SELECT
-		id, promise_id, url, retry_policy, created_on, sort_id
+		id, promise_id, url, method, headers, payload, secret, retry_policy, created_on, sort_id
 	FROM
 		subscriptions
 	WHERE
@@ -165,9 +188,9 @@ const (
 
 	SUBSCRIPTION_INSERT_STATEMENT = `
 	INSERT INTO subscriptions
-        (id, promise_id, url, retry_policy, created_on)
+        (id, promise_id, url, method, headers, payload, secret, retry_policy, created_on)
     VALUES
-        ($1, $2, $3, $4, $5)
+        ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	ON CONFLICT(id, promise_id) DO NOTHING`
 
 	SUBSCRIPTION_DELETE_STATEMENT = `
@@ -176,6 +199,9 @@ const (
 	SUBSCRIPTION_DELETE_ALL_STATEMENT = `
 	DELETE FROM subscriptions WHERE promise_id = $1`
 
+	SUBSCRIPTION_DELETE_EXPIRED_STATEMENT = `
+	DELETE FROM subscriptions WHERE promise_id = ANY($1)`
+
 	SUBSCRIPTION_DELETE_ALL_TIMEOUT_STATEMENT = `
 	DELETE FROM
 		subscriptions
@@ -184,7 +210,7 @@ const (
 
 	NOTIFICATION_SELECT_STATEMENT = `
 	SELECT
-        id, promise_id, url, retry_policy, time, attempt
+        id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt
     FROM
         notifications
     ORDER BY
@@ -193,20 +219,27 @@ const (
 
 	NOTIFICATION_INSERT_STATEMENT = `
 	INSERT INTO notifications
-		(id, promise_id, url, retry_policy, time, attempt)
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt)
 	SELECT
-		id, promise_id, url, retry_policy, $1, 0
+		id, promise_id, url, method, headers, payload, secret, retry_policy, $1, 0
 	FROM
 		subscriptions
 	WHERE
 		promise_id = $2
 	ON CONFLICT(id, promise_id) DO NOTHING`
 
+	NOTIFICATION_INSERT_RECORD_STATEMENT = `
+	INSERT INTO notifications
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt)
+	VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	ON CONFLICT(id, promise_id) DO NOTHING`
+
 	NOTIFICATION_INSERT_TIMEOUT_STATEMENT = `
 	INSERT INTO notifications
-		(id, promise_id, url, retry_policy, time, attempt)
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt)
 	SELECT
-		id, promise_id, url, retry_policy, $1, 0
+		id, promise_id, url, method, headers, payload, secret, retry_policy, $1, 0
 	FROM
 		subscriptions
 	WHERE
@@ -220,6 +253,63 @@ const (
 
 	NOTIFICATION_DELETE_STATEMENT = `
 	DELETE FROM notifications WHERE id = $1 AND promise_id = $2`
+
+	DEAD_NOTIFICATION_SELECT_STATEMENT = `
+	SELECT
+		id, promise_id, url, method, headers, payload, secret, retry_policy, attempts, status_code, error, created_on, sort_id
+	FROM
+		dead_notifications
+	WHERE
+		id = $1 AND promise_id = $2`
+
+	DEAD_NOTIFICATION_SELECT_ALL_STATEMENT = `
+	SELECT
+		id, promise_id, url, method, headers, payload, secret, retry_policy, attempts, status_code, error, created_on, sort_id
+	FROM
+		dead_notifications
+	WHERE
+		($1::int IS NULL OR sort_id < $1)
+	ORDER BY
+		sort_id DESC
+	LIMIT
+		$2`
+
+	DEAD_NOTIFICATION_INSERT_STATEMENT = `
+	INSERT INTO dead_notifications
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, attempts, status_code, error, created_on)
+	VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	ON CONFLICT(id, promise_id) DO NOTHING`
+
+	DEAD_NOTIFICATION_DELETE_STATEMENT = `
+	DELETE FROM dead_notifications WHERE id = $1 AND promise_id = $2`
+
+	DEAD_NOTIFICATION_PURGE_STATEMENT = `
+	DELETE FROM dead_notifications`
+
+	NOTIFICATION_ATTEMPT_SELECT_ALL_STATEMENT = `
+	SELECT
+		id, promise_id, attempt, url, status_code, latency, body, error, time, sort_id
+	FROM
+		notification_attempts
+	WHERE
+		($1 = '' OR id = $1) AND promise_id = $2 AND ($3::int IS NULL OR sort_id < $3)
+	ORDER BY
+		sort_id DESC
+	LIMIT
+		$4`
+
+	NOTIFICATION_ATTEMPT_INSERT_STATEMENT = `
+	INSERT INTO notification_attempts
+		(id, promise_id, attempt, url, status_code, latency, body, error, time)
+	VALUES
+		($1, $2, $3, $4, $5, $6, $7, $8, $9)`
+
+	NOTIFICATION_ATTEMPT_DELETE_ALL_STATEMENT = `
+	DELETE FROM notification_attempts WHERE promise_id = $1`
+
+	NOTIFICATION_ATTEMPT_DELETE_EXPIRED_STATEMENT = `
+	DELETE FROM notification_attempts WHERE promise_id = ANY($1)`
 )
 
 type Config struct {
@@ -232,8 +322,9 @@ type Config struct {
 }
 
 type PostgresStore struct {
-	config *Config
-	db     *sql.DB
+	config   *Config
+	db       *sql.DB
+	migrator *migrations.Migrator
 }
 
 type PostgresStoreWorker struct {
@@ -260,8 +351,9 @@ func New(config *Config, workers int) (aio.Subsystem, error) {
 	db.SetConnMaxIdleTime(0)
 
 	return &PostgresStore{
-		config: config,
-		db:     db,
+		config:   config,
+		db:       db,
+		migrator: migrations.New(db, dialect, Migrations),
 	}, nil
 }
 
@@ -270,7 +362,7 @@ func (s *PostgresStore) String() string {
 }
 
 func (s *PostgresStore) Start() error {
-	if _, err := s.db.Exec(CREATE_TABLE_STATEMENT); err != nil {
+	if _, err := s.migrator.Up(); err != nil {
 		return err
 	}
 
@@ -281,8 +373,16 @@ func (s *PostgresStore) Stop() error {
 	return s.db.Close()
 }
 
+func (s *PostgresStore) Migrator() *migrations.Migrator {
+	return s.migrator
+}
+
 func (s *PostgresStore) Reset() error {
-	if _, err := s.db.Exec(DROP_TABLE_STATEMENT); err != nil {
+	if _, err := s.migrator.Down(len(Migrations)); err != nil {
+		return err
+	}
+
+	if _, err := s.db.Exec(DROP_MIGRATIONS_TABLE_STATEMENT); err != nil {
 		return err
 	}
 
@@ -300,7 +400,7 @@ func (w *PostgresStoreWorker) Process(sqes []*bus.SQE[t_aio.Submission, t_aio.Co
 	return store.Process(w, sqes)
 }
 
-func (w *PostgresStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t_aio.Result, error) {
+func (w *PostgresStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
 	util.Assert(len(transactions) > 0, "expected a transaction")
 
 	ctx, cancel := context.WithTimeout(context.Background(), w.config.TxTimeout)
@@ -308,112 +408,119 @@ func (w *PostgresStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t
 
 	tx, err := w.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	results, err := w.performCommands(tx, transactions)
+	results, errs, err := w.performCommands(tx, transactions)
 	if err != nil {
 		if err := tx.Rollback(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return results, nil
+	return results, errs, nil
 }
 
-func (w *PostgresStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.Transaction) ([][]*t_aio.Result, error) {
+func (w *PostgresStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
 	promiseInsertStmt, err := tx.Prepare(PROMISE_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer promiseInsertStmt.Close()
 
 	promiseUpdateStmt, err := tx.Prepare(PROMISE_UPDATE_STATMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer promiseUpdateStmt.Close()
 
 	promiseUpdateTimeoutStmt, err := tx.Prepare(PROMISE_UPDATE_TIMEOUT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer promiseUpdateTimeoutStmt.Close()
 
 	timeoutInsertStmt, err := tx.Prepare(TIMEOUT_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer timeoutInsertStmt.Close()
 
 	timeoutDeleteStmt, err := tx.Prepare(TIMEOUT_DELETE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer timeoutDeleteStmt.Close()
 
 	subscriptionInsertStmt, err := tx.Prepare(SUBSCRIPTION_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionInsertStmt.Close()
 
 	subscriptionDeleteStmt, err := tx.Prepare(SUBSCRIPTION_DELETE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionDeleteStmt.Close()
 
 	subscriptionDeleteAllStmt, err := tx.Prepare(SUBSCRIPTION_DELETE_ALL_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionDeleteAllStmt.Close()
 
 	subscriptionDeleteAllTimeoutStmt, err := tx.Prepare(SUBSCRIPTION_DELETE_ALL_TIMEOUT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionDeleteAllTimeoutStmt.Close()
 
 	notificationInsertStmt, err := tx.Prepare(NOTIFICATION_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationInsertStmt.Close()
 
 	notificationInsertTimeoutStmt, err := tx.Prepare(NOTIFICATION_INSERT_TIMEOUT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationInsertTimeoutStmt.Close()
 
 	notificationUpdateStmt, err := tx.Prepare(NOTIFICATION_UPDATE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationUpdateStmt.Close()
 
 	notificationDeleteStmt, err := tx.Prepare(NOTIFICATION_DELETE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationDeleteStmt.Close()
 
 	results := make([][]*t_aio.Result, len(transactions))
+	errs := make([]error, len(transactions))
 
 	for i, transaction := range transactions {
 		util.Assert(len(transaction.Commands) > 0, "expected a command")
 		results[i] = make([]*t_aio.Result, len(transaction.Commands))
 
-		for j, command := range transaction.Commands {
-			var err error
+		// each transaction runs in a savepoint so that an error only
+		// rolls back the transaction that caused it
+		if _, err := tx.Exec("SAVEPOINT tx"); err != nil {
+			return nil, nil, err
+		}
+
+		var err error
 
+		for j, command := range transaction.Commands {
 			switch command.Kind {
 			// Promise
 			case t_aio.ReadPromise:
@@ -431,6 +538,18 @@ func (w *PostgresStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.
 			case t_aio.TimeoutPromises:
 				util.Assert(command.TimeoutPromises != nil, "command must not be nil")
 				results[i][j], err = w.timeoutPromises(tx, promiseUpdateTimeoutStmt, command.TimeoutPromises)
+			case t_aio.DeletePromises:
+				util.Assert(command.DeletePromises != nil, "command must not be nil")
+				results[i][j], err = w.deletePromises(tx, command.DeletePromises)
+			case t_aio.ReadExpiredPromises:
+				util.Assert(command.ReadExpiredPromises != nil, "command must not be nil")
+				results[i][j], err = w.readExpiredPromises(tx, command.ReadExpiredPromises)
+			case t_aio.ReadPromisesByIdempotencyKey:
+				util.Assert(command.ReadPromisesByIdempotencyKey != nil, "command must not be nil")
+				results[i][j], err = w.readPromisesByIdempotencyKey(tx, command.ReadPromisesByIdempotencyKey)
+			case t_aio.DeletePromise:
+				util.Assert(command.DeletePromise != nil, "command must not be nil")
+				results[i][j], err = w.deletePromise(tx, command.DeletePromise)
 
 			// Timeout
 			case t_aio.ReadTimeouts:
@@ -470,6 +589,9 @@ func (w *PostgresStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.
 			case t_aio.CreateNotifications:
 				util.Assert(command.CreateNotifications != nil, "command must not be nil")
 				results[i][j], err = w.createNotifications(tx, notificationInsertStmt, command.CreateNotifications)
+			case t_aio.CreateNotification:
+				util.Assert(command.CreateNotification != nil, "command must not be nil")
+				results[i][j], err = w.createNotification(tx, command.CreateNotification)
 			case t_aio.UpdateNotification:
 				util.Assert(command.UpdateNotification != nil, "command must not be nil")
 				results[i][j], err = w.updateNotification(tx, notificationUpdateStmt, command.UpdateNotification)
@@ -480,17 +602,58 @@ func (w *PostgresStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.
 				util.Assert(command.TimeoutCreateNotifications != nil, "command must not be nil")
 				results[i][j], err = w.timeoutCreateNotifications(tx, notificationInsertTimeoutStmt, command.TimeoutCreateNotifications)
 
+			// Dead notification
+			case t_aio.ReadDeadNotification:
+				util.Assert(command.ReadDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotification(tx, command.ReadDeadNotification)
+			case t_aio.ReadDeadNotifications:
+				util.Assert(command.ReadDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotifications(tx, command.ReadDeadNotifications)
+			case t_aio.CreateDeadNotification:
+				util.Assert(command.CreateDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.createDeadNotification(tx, command.CreateDeadNotification)
+			case t_aio.DeleteDeadNotification:
+				util.Assert(command.DeleteDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotification(tx, command.DeleteDeadNotification)
+			case t_aio.DeleteDeadNotifications:
+				util.Assert(command.DeleteDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotifications(tx, command.DeleteDeadNotifications)
+
+			// Notification attempt
+			case t_aio.ReadNotificationAttempts:
+				util.Assert(command.ReadNotificationAttempts != nil, "command must not be nil")
+				results[i][j], err = w.readNotificationAttempts(tx, command.ReadNotificationAttempts)
+			case t_aio.CreateNotificationAttempt:
+				util.Assert(command.CreateNotificationAttempt != nil, "command must not be nil")
+				results[i][j], err = w.createNotificationAttempt(tx, command.CreateNotificationAttempt)
+
 			default:
 				panic("invalid command")
 			}
 
 			if err != nil {
-				return nil, err
+				break
 			}
 		}
+
+		if err != nil {
+			if _, err := tx.Exec("ROLLBACK TO SAVEPOINT tx"); err != nil {
+				return nil, nil, err
+			}
+			results[i], errs[i] = nil, err
+		} else if transaction.Atomic && store.Conflict(results[i]) {
+			if _, err := tx.Exec("ROLLBACK TO SAVEPOINT tx"); err != nil {
+				return nil, nil, err
+			}
+			store.Discard(results[i])
+		}
+
+		if _, err := tx.Exec("RELEASE SAVEPOINT tx"); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	return results, nil
+	return results, errs, nil
 }
 
 func (w *PostgresStoreWorker) readPromise(tx *sql.Tx, cmd *t_aio.ReadPromiseCommand) (*t_aio.Result, error) {
@@ -547,8 +710,34 @@ func (w *PostgresStoreWorker) searchPromises(tx *sql.Tx, cmd *t_aio.SearchPromis
 		mask = mask | int(state)
 	}
 
+	// convert tags to json object
+	tags := []byte("{}")
+	if len(cmd.Tags) > 0 {
+		var err error
+		tags, err = json.Marshal(cmd.Tags)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// convert sort to column and direction
+	column, cmp, order, sortValue := store.SearchSort(cmd)
+
 	// select
-	rows, err := tx.Query(PROMISE_SEARCH_STATEMENT, cmd.SortId, mask, query, cmd.Limit)
+	rows, err := tx.Query(
+		fmt.Sprintf(PROMISE_SEARCH_STATEMENT, column, cmp, order),
+		sortValue,
+		cmd.SortId,
+		mask,
+		query,
+		cmd.CreatedAfter,
+		cmd.CreatedBefore,
+		cmd.CompletedAfter,
+		cmd.CompletedBefore,
+		cmd.TimeoutBefore,
+		string(tags),
+		cmd.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -608,8 +797,10 @@ func (w *PostgresStoreWorker) createPromise(tx *sql.Tx, stmt *sql.Stmt, cmd *t_a
 		return nil, err
 	}
 
-	// insert
-	res, err := stmt.Exec(cmd.Id, promise.Pending, headers, cmd.Param.Data, cmd.Timeout, cmd.IdempotencyKey, tags, cmd.CreatedOn)
+	// insert, tags is the source of truth and tags_jsonb only indexes
+	// the same bytes for search, the tags of a promise are never updated
+	// so both columns are only written here
+	res, err := stmt.Exec(cmd.Id, promise.Pending, headers, cmd.Param.Data, cmd.Timeout, cmd.IdempotencyKey, tags, string(tags), cmd.CreatedOn, store.Retention(cmd.Tags))
 	if err != nil {
 		return nil, err
 	}
@@ -678,6 +869,212 @@ func (w *PostgresStoreWorker) timeoutPromises(tx *sql.Tx, stmt *sql.Stmt, cmd *t
 	}, nil
 }
 
+func (w *PostgresStoreWorker) deletePromises(tx *sql.Tx, cmd *t_aio.DeletePromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	// promises without a retention are kept when there is no default
+	var completedBefore *int64
+	if cmd.Retention != nil {
+		t := cmd.Time - *cmd.Retention
+		completedBefore = &t
+	}
+
+	// select the expired promises once, the related rows and then the
+	// promises are deleted by id
+	rows, err := tx.Query(PROMISE_SELECT_EXPIRED_STATEMENT, cmd.Time, completedBefore, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return &t_aio.Result{
+			Kind: t_aio.DeletePromises,
+			DeletePromises: &t_aio.AlterPromisesResult{
+				RowsAffected: 0,
+			},
+		}, nil
+	}
+
+	for _, stmt := range []string{
+		NOTIFICATION_ATTEMPT_DELETE_EXPIRED_STATEMENT,
+		SUBSCRIPTION_DELETE_EXPIRED_STATEMENT,
+	} {
+		if _, err := tx.Exec(stmt, pq.Array(ids)); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := tx.Exec(PROMISE_DELETE_EXPIRED_STATEMENT, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromises,
+		DeletePromises: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) readExpiredPromises(tx *sql.Tx, cmd *t_aio.ReadExpiredPromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	// promises without a retention are kept when there is no default
+	var completedBefore *int64
+	if cmd.Retention != nil {
+		t := cmd.Time - *cmd.Retention
+		completedBefore = &t
+	}
+
+	// select
+	rows, err := tx.Query(PROMISE_SELECT_EXPIRED_RECORDS_STATEMENT, cmd.Time, completedBefore, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &promise.PromiseRecord{}
+		if err := rows.Scan(
+			&record.Id,
+			&record.State,
+			&record.ParamHeaders,
+			&record.ParamData,
+			&record.ValueHeaders,
+			&record.ValueData,
+			&record.Timeout,
+			&record.IdempotencyKeyForCreate,
+			&record.IdempotencyKeyForComplete,
+			&record.Tags,
+			&record.CreatedOn,
+			&record.CompletedOn,
+			&record.SortId,
+		); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadExpiredPromises,
+		ReadExpiredPromises: &t_aio.QueryPromisesResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) readPromisesByIdempotencyKey(tx *sql.Tx, cmd *t_aio.ReadPromisesByIdempotencyKeyCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	// select
+	rows, err := tx.Query(PROMISE_SELECT_BY_IDEMPOTENCY_KEY_STATEMENT, cmd.SortId, cmd.IdempotencyKey, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &promise.PromiseRecord{}
+		if err := rows.Scan(
+			&record.Id,
+			&record.State,
+			&record.ParamHeaders,
+			&record.ParamData,
+			&record.ValueHeaders,
+			&record.ValueData,
+			&record.Timeout,
+			&record.IdempotencyKeyForCreate,
+			&record.IdempotencyKeyForComplete,
+			&record.Tags,
+			&record.CreatedOn,
+			&record.CompletedOn,
+			&record.SortId,
+		); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadPromisesByIdempotencyKey,
+		ReadPromisesByIdempotencyKey: &t_aio.QueryPromisesResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) deletePromise(tx *sql.Tx, cmd *t_aio.DeletePromiseCommand) (*t_aio.Result, error) {
+	// delete
+	res, err := tx.Exec(PROMISE_DELETE_COMPLETED_STATEMENT, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	// delete related rows, only if the promise was deleted
+	if rowsAffected == 1 {
+		for _, stmt := range []string{
+			NOTIFICATION_ATTEMPT_DELETE_ALL_STATEMENT,
+			SUBSCRIPTION_DELETE_ALL_STATEMENT,
+		} {
+			if _, err := tx.Exec(stmt, cmd.Id); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromise,
+		DeletePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
 func (w *PostgresStoreWorker) readTimeouts(tx *sql.Tx, cmd *t_aio.ReadTimeoutsCommand) (*t_aio.Result, error) {
 	// select
 	rows, err := tx.Query(TIMEOUT_SELECT_STATEMENT, cmd.N)
@@ -756,7 +1153,7 @@ func (w *PostgresStoreWorker) readSubscription(tx *sql.Tx, cmd *t_aio.ReadSubscr
 	record := &subscription.SubscriptionRecord{}
 	rowsReturned := int64(1)
 
-	if err := row.Scan(&record.Id, &record.PromiseId, &record.Url, &record.RetryPolicy, &record.CreatedOn); err != nil {
+	if err := row.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.CreatedOn); err != nil {
 		if err == sql.ErrNoRows {
 			rowsReturned = 0
 		} else {
@@ -792,7 +1189,7 @@ func (w *PostgresStoreWorker) readSubscriptions(tx *sql.Tx, cmd *t_aio.ReadSubsc
 
 	for rows.Next() {
 		record := &subscription.SubscriptionRecord{}
-		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.RetryPolicy, &record.CreatedOn, &record.SortId); err != nil {
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.CreatedOn, &record.SortId); err != nil {
 			return nil, err
 		}
 
@@ -814,13 +1211,18 @@ func (w *PostgresStoreWorker) readSubscriptions(tx *sql.Tx, cmd *t_aio.ReadSubsc
 func (w *PostgresStoreWorker) createSubscription(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio.CreateSubscriptionCommand) (*t_aio.Result, error) {
 	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
 
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
 	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
 	if err != nil {
 		return nil, err
 	}
 
 	// insert
-	res, err := stmt.Exec(cmd.Id, cmd.PromiseId, cmd.Url, retryPolicy, cmd.CreatedOn)
+	res, err := stmt.Exec(cmd.Id, cmd.PromiseId, cmd.Url, cmd.Method, headers, payload, cmd.Secret, retryPolicy, cmd.CreatedOn)
 	if err != nil {
 		return nil, err
 	}
@@ -913,7 +1315,7 @@ func (w *PostgresStoreWorker) readNotifications(tx *sql.Tx, cmd *t_aio.ReadNotif
 
 	for rows.Next() {
 		record := &notification.NotificationRecord{}
-		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.RetryPolicy, &record.Time, &record.Attempt); err != nil {
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.Time, &record.Attempt); err != nil {
 			return nil, err
 		}
 
@@ -952,6 +1354,38 @@ func (w *PostgresStoreWorker) createNotifications(tx *sql.Tx, stmt *sql.Stmt, cm
 	}, nil
 }
 
+func (w *PostgresStoreWorker) createNotification(tx *sql.Tx, cmd *t_aio.CreateNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// insert
+	res, err := tx.Exec(NOTIFICATION_INSERT_RECORD_STATEMENT, cmd.Id, cmd.PromiseId, cmd.Url, cmd.Method, headers, payload, cmd.Secret, retryPolicy, cmd.Time, cmd.Attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotification,
+		CreateNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
 func (w *PostgresStoreWorker) updateNotification(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio.UpdateNotificationCommand) (*t_aio.Result, error) {
 	// update
 	res, err := stmt.Exec(cmd.Time, cmd.Attempt, cmd.Id, cmd.PromiseId)
@@ -1013,3 +1447,189 @@ func (w *PostgresStoreWorker) timeoutCreateNotifications(tx *sql.Tx, stmt *sql.S
 		},
 	}, nil
 }
+
+func (w *PostgresStoreWorker) readDeadNotification(tx *sql.Tx, cmd *t_aio.ReadDeadNotificationCommand) (*t_aio.Result, error) {
+	// select
+	row := tx.QueryRow(DEAD_NOTIFICATION_SELECT_STATEMENT, cmd.Id, cmd.PromiseId)
+	record := &notification.DeadNotificationRecord{}
+	rowsReturned := int64(1)
+
+	if err := row.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.Attempts, &record.StatusCode, &record.Error, &record.CreatedOn, &record.SortId); err != nil {
+		if err == sql.ErrNoRows {
+			rowsReturned = 0
+		} else {
+			return nil, err
+		}
+	}
+
+	var records []*notification.DeadNotificationRecord
+	if rowsReturned == 1 {
+		records = append(records, record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotification,
+		ReadDeadNotification: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: rowsReturned,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) readDeadNotifications(tx *sql.Tx, cmd *t_aio.ReadDeadNotificationsCommand) (*t_aio.Result, error) {
+	// select
+	rows, err := tx.Query(DEAD_NOTIFICATION_SELECT_ALL_STATEMENT, cmd.SortId, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*notification.DeadNotificationRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &notification.DeadNotificationRecord{}
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.Attempts, &record.StatusCode, &record.Error, &record.CreatedOn, &record.SortId); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotifications,
+		ReadDeadNotifications: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) createDeadNotification(tx *sql.Tx, cmd *t_aio.CreateDeadNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// insert
+	res, err := tx.Exec(DEAD_NOTIFICATION_INSERT_STATEMENT, cmd.Id, cmd.PromiseId, cmd.Url, cmd.Method, headers, payload, cmd.Secret, retryPolicy, cmd.Attempts, cmd.StatusCode, cmd.Error, cmd.CreatedOn)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateDeadNotification,
+		CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) deleteDeadNotification(tx *sql.Tx, cmd *t_aio.DeleteDeadNotificationCommand) (*t_aio.Result, error) {
+	// delete
+	res, err := tx.Exec(DEAD_NOTIFICATION_DELETE_STATEMENT, cmd.Id, cmd.PromiseId)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotification,
+		DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) deleteDeadNotifications(tx *sql.Tx, cmd *t_aio.DeleteDeadNotificationsCommand) (*t_aio.Result, error) {
+	// delete
+	res, err := tx.Exec(DEAD_NOTIFICATION_PURGE_STATEMENT)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotifications,
+		DeleteDeadNotifications: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) readNotificationAttempts(tx *sql.Tx, cmd *t_aio.ReadNotificationAttemptsCommand) (*t_aio.Result, error) {
+	// select
+	rows, err := tx.Query(NOTIFICATION_ATTEMPT_SELECT_ALL_STATEMENT, cmd.Id, cmd.PromiseId, cmd.SortId, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*notification.NotificationAttemptRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &notification.NotificationAttemptRecord{}
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Attempt, &record.Url, &record.StatusCode, &record.Latency, &record.Body, &record.Error, &record.Time, &record.SortId); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadNotificationAttempts,
+		ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *PostgresStoreWorker) createNotificationAttempt(tx *sql.Tx, cmd *t_aio.CreateNotificationAttemptCommand) (*t_aio.Result, error) {
+	// insert
+	res, err := tx.Exec(NOTIFICATION_ATTEMPT_INSERT_STATEMENT, cmd.Id, cmd.PromiseId, cmd.Attempt, cmd.Url, cmd.StatusCode, cmd.Latency, cmd.Body, cmd.Error, cmd.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotificationAttempt,
+		CreateNotificationAttempt: &t_aio.AlterNotificationAttemptsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
@@ -0,0 +1,15 @@
+package store
+
+import "github.com/resonatehq/resonate/pkg/promise"
+
+// Retention returns the retention in milliseconds set by the retention
+// tag, or nil if the tag is not set. Tags are validated by the api, a
+// tag that cannot be parsed, for example from an import, is ignored.
+func Retention(tags map[string]string) *int64 {
+	retention, err := promise.Retention(tags)
+	if err != nil {
+		return nil
+	}
+
+	return retention
+}
@@ -0,0 +1,176 @@
+package store
+
+import (
+	"strings"
+
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/promise"
+)
+
+// Search evaluates a search promises command in process, with the same
+// semantics as the sql stores, for stores that do not have a query
+// language.
+type Search struct {
+	cmd       *t_aio.SearchPromisesCommand
+	query     string
+	mask      promise.State
+	sortValue *int64
+}
+
+func NewSearch(cmd *t_aio.SearchPromisesCommand) *Search {
+	util.Assert(cmd.Q != "", "query cannot be empty")
+	util.Assert(cmd.States != nil, "states cannot be empty")
+
+	// convert list of state to bit mask
+	var mask promise.State
+	for _, state := range cmd.States {
+		mask = mask | state
+	}
+
+	return &Search{
+		cmd:       cmd,
+		query:     strings.ReplaceAll(cmd.Q, "*", "%"),
+		mask:      mask,
+		sortValue: sortValue(cmd),
+	}
+}
+
+// SearchSort returns the column, comparison operator, and direction
+// that the sql stores use to order the results of a search promises
+// command, and the value of the column to resume from.
+func SearchSort(cmd *t_aio.SearchPromisesCommand) (string, string, string, *int64) {
+	var column string
+	switch cmd.SortBy {
+	case promise.SortBySortId:
+		column = "sort_id"
+	case promise.SortByCreatedOn:
+		column = "created_on"
+	case promise.SortByTimeout:
+		column = "timeout"
+	default:
+		panic("invalid sort by")
+	}
+
+	var cmp, order string
+	switch cmd.SortOrder {
+	case promise.Descending:
+		cmp, order = "<", "DESC"
+	case promise.Ascending:
+		cmp, order = ">", "ASC"
+	default:
+		panic("invalid sort order")
+	}
+
+	return column, cmp, order, sortValue(cmd)
+}
+
+func sortValue(cmd *t_aio.SearchPromisesCommand) *int64 {
+	// cursors created before sort values were introduced only carry
+	// the sort id
+	if cmd.SortBy == promise.SortBySortId && cmd.SortValue == nil {
+		return cmd.SortId
+	}
+
+	return cmd.SortValue
+}
+
+// Match returns true if the record, with the given tags, satisfies all
+// filters of the command and comes after the cursor.
+func (s *Search) Match(r *promise.PromiseRecord, tags map[string]string) bool {
+	cmd := s.cmd
+
+	if !r.State.In(s.mask) || !like(s.query, r.Id) {
+		return false
+	}
+	if cmd.CreatedAfter != nil && (r.CreatedOn == nil || *r.CreatedOn < *cmd.CreatedAfter) {
+		return false
+	}
+	if cmd.CreatedBefore != nil && (r.CreatedOn == nil || *r.CreatedOn >= *cmd.CreatedBefore) {
+		return false
+	}
+	if cmd.CompletedAfter != nil && (r.CompletedOn == nil || *r.CompletedOn < *cmd.CompletedAfter) {
+		return false
+	}
+	if cmd.CompletedBefore != nil && (r.CompletedOn == nil || *r.CompletedOn >= *cmd.CompletedBefore) {
+		return false
+	}
+	if cmd.TimeoutBefore != nil && r.Timeout >= *cmd.TimeoutBefore {
+		return false
+	}
+
+	for _, tag := range util.OrderedRangeKV(cmd.Tags) {
+		if value, ok := tags[tag.Key]; !ok || value != tag.Value {
+			return false
+		}
+	}
+
+	if s.sortValue != nil {
+		value := cmd.SortBy.Value(r)
+		if !s.after(value, *s.sortValue) && !(value == *s.sortValue && cmd.SortId != nil && s.after(r.SortId, *cmd.SortId)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Less returns true if record a is ordered before record b.
+func (s *Search) Less(a *promise.PromiseRecord, b *promise.PromiseRecord) bool {
+	va, vb := s.cmd.SortBy.Value(a), s.cmd.SortBy.Value(b)
+	if va != vb {
+		return s.after(vb, va)
+	}
+
+	return s.after(b.SortId, a.SortId)
+}
+
+// after returns true if value a comes after value b in the sort order.
+func (s *Search) after(a int64, b int64) bool {
+	switch s.cmd.SortOrder {
+	case promise.Descending:
+		return a < b
+	case promise.Ascending:
+		return a > b
+	default:
+		panic("invalid sort order")
+	}
+}
+
+// like reports whether s matches a sql like pattern, as in sqlite the
+// match is case insensitive for ascii characters.
+func like(pattern string, s string) bool {
+	p, r := []rune(pattern), []rune(s)
+	pi, ri := 0, 0
+	star, next := -1, 0
+
+	for ri < len(r) {
+		switch {
+		case pi < len(p) && p[pi] == '%':
+			star, next = pi, ri
+			pi++
+		case pi < len(p) && (p[pi] == '_' || fold(p[pi]) == fold(r[ri])):
+			pi++
+			ri++
+		case star != -1:
+			// backtrack, the last % matches one more character
+			next++
+			pi, ri = star+1, next
+		default:
+			return false
+		}
+	}
+
+	for pi < len(p) && p[pi] == '%' {
+		pi++
+	}
+
+	return pi == len(p)
+}
+
+func fold(r rune) rune {
+	if 'A' <= r && r <= 'Z' {
+		return r + 'a' - 'A'
+	}
+	return r
+}
@@ -0,0 +1,236 @@
+package sqlite
+
+import (
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/migrations"
+)
+
+// Migrations must never be modified once released, changes to the
+// schema must be added as a new migration with the next version.
+var Migrations = []*migrations.Migration{
+	{
+		Version: 1,
+		Name:    "init",
+		Up: `
+		CREATE TABLE IF NOT EXISTS promises (
+			id                           TEXT UNIQUE,
+			sort_id                      INTEGER PRIMARY KEY AUTOINCREMENT,
+			state                        INTEGER DEFAULT 1,
+			param_headers                BLOB,
+			param_data                   BLOB,
+			value_headers                BLOB,
+			value_data                   BLOB,
+			timeout                      INTEGER,
+			idempotency_key_for_create   TEXT,
+			idempotency_key_for_complete TEXT,
+			tags                         BLOB,
+			created_on                   INTEGER,
+			completed_on                 INTEGER
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_promises_id ON promises(id);
+
+		CREATE TABLE IF NOT EXISTS timeouts (
+			id   TEXT,
+			time INTEGER,
+			PRIMARY KEY(id)
+		);
+
+		CREATE TABLE IF NOT EXISTS subscriptions (
+			id           TEXT,
+			promise_id   TEXT,
+			sort_id      INTEGER PRIMARY KEY AUTOINCREMENT,
+			url          TEXT,
+			retry_policy BLOB,
+			created_on   INTEGER,
+			UNIQUE(id, promise_id)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_subscriptions_id ON subscriptions(id);
+
+		CREATE TABLE IF NOT EXISTS notifications (
+			id           TEXT,
+			promise_id   TEXT,
+			url          TEXT,
+			retry_policy BLOB,
+			time         INTEGER,
+			attempt      INTEGER,
+			PRIMARY KEY(id, promise_id)
+		);`,
+		Down: `
+		DROP TABLE notifications;
+		DROP TABLE subscriptions;
+		DROP TABLE timeouts;
+		DROP TABLE promises;`,
+	},
+	{
+		Version: 2,
+		Name:    "promise_retention",
+		Up: `
+		ALTER TABLE promises ADD COLUMN retention INTEGER;
+		CREATE INDEX IF NOT EXISTS idx_promises_completed_on ON promises(completed_on);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_completed_on;
+		ALTER TABLE promises DROP COLUMN retention;`,
+	},
+	{
+		Version: 3,
+		Name:    "promise_idempotency_keys",
+		Up: `
+		CREATE INDEX IF NOT EXISTS idx_promises_idempotency_key_for_create ON promises(idempotency_key_for_create);
+		CREATE INDEX IF NOT EXISTS idx_promises_idempotency_key_for_complete ON promises(idempotency_key_for_complete);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_idempotency_key_for_complete;
+		DROP INDEX IF EXISTS idx_promises_idempotency_key_for_create;`,
+	},
+	{
+		Version: 4,
+		Name:    "subscription_delivery",
+		Up: `
+		ALTER TABLE subscriptions ADD COLUMN method TEXT NOT NULL DEFAULT '';
+		ALTER TABLE subscriptions ADD COLUMN headers BLOB;
+		ALTER TABLE subscriptions ADD COLUMN payload BLOB;
+		ALTER TABLE notifications ADD COLUMN method TEXT NOT NULL DEFAULT '';
+		ALTER TABLE notifications ADD COLUMN headers BLOB;
+		ALTER TABLE notifications ADD COLUMN payload BLOB;`,
+		Down: `
+		ALTER TABLE notifications DROP COLUMN payload;
+		ALTER TABLE notifications DROP COLUMN headers;
+		ALTER TABLE notifications DROP COLUMN method;
+		ALTER TABLE subscriptions DROP COLUMN payload;
+		ALTER TABLE subscriptions DROP COLUMN headers;
+		ALTER TABLE subscriptions DROP COLUMN method;`,
+	},
+	{
+		Version: 5,
+		Name:    "subscription_secret",
+		Up: `
+		ALTER TABLE subscriptions ADD COLUMN secret TEXT NOT NULL DEFAULT '';
+		ALTER TABLE notifications ADD COLUMN secret TEXT NOT NULL DEFAULT '';`,
+		Down: `
+		ALTER TABLE notifications DROP COLUMN secret;
+		ALTER TABLE subscriptions DROP COLUMN secret;`,
+	},
+	{
+		Version: 6,
+		Name:    "dead_notifications",
+		Up: `
+		CREATE TABLE IF NOT EXISTS dead_notifications (
+			id           TEXT,
+			promise_id   TEXT,
+			sort_id      INTEGER PRIMARY KEY AUTOINCREMENT,
+			url          TEXT,
+			method       TEXT NOT NULL DEFAULT '',
+			headers      BLOB,
+			payload      BLOB,
+			secret       TEXT NOT NULL DEFAULT '',
+			retry_policy BLOB,
+			attempts     INTEGER,
+			status_code  INTEGER,
+			error        TEXT,
+			created_on   INTEGER,
+			UNIQUE(id, promise_id)
+		);`,
+		Down: `
+		DROP TABLE IF EXISTS dead_notifications;`,
+	},
+	{
+		Version: 7,
+		Name:    "notification_attempts",
+		Up: `
+		CREATE TABLE IF NOT EXISTS notification_attempts (
+			id          TEXT,
+			promise_id  TEXT,
+			sort_id     INTEGER PRIMARY KEY AUTOINCREMENT,
+			attempt     INTEGER,
+			url         TEXT,
+			status_code INTEGER,
+			latency     INTEGER,
+			body        BLOB,
+			error       TEXT,
+			time        INTEGER
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_notification_attempts_id ON notification_attempts(promise_id, id, sort_id);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_notification_attempts_id;
+		DROP TABLE IF EXISTS notification_attempts;`,
+	},
+	{
+		Version: 8,
+		Name:    "promise_tags",
+		Up: `
+		CREATE TABLE IF NOT EXISTS promise_tags (
+			promise_id TEXT,
+			key        TEXT,
+			value      TEXT,
+			PRIMARY KEY(promise_id, key)
+		);
+
+		CREATE INDEX IF NOT EXISTS idx_promise_tags_key_value ON promise_tags(key, value);
+
+		INSERT OR IGNORE INTO promise_tags
+			(promise_id, key, value)
+		SELECT
+			promises.id, t.key, t.value
+		FROM
+			promises, json_each(CAST(promises.tags AS TEXT)) AS t
+		WHERE
+			json_type(CAST(promises.tags AS TEXT)) = 'object';`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promise_tags_key_value;
+		DROP TABLE IF EXISTS promise_tags;`,
+	},
+	{
+		Version: 9,
+		Name:    "promise_expires_on",
+		Up: `
+		ALTER TABLE promises ADD COLUMN expires_on INTEGER;
+
+		UPDATE promises SET expires_on = completed_on + retention WHERE completed_on IS NOT NULL AND retention IS NOT NULL;
+
+		CREATE INDEX IF NOT EXISTS idx_promises_expires_on ON promises(expires_on);
+		CREATE INDEX IF NOT EXISTS idx_promises_completed_on_without_retention ON promises(completed_on, sort_id) WHERE retention IS NULL;`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_completed_on_without_retention;
+		DROP INDEX IF EXISTS idx_promises_expires_on;
+		ALTER TABLE promises DROP COLUMN expires_on;`,
+	},
+	{
+		Version: 10,
+		Name:    "notifications_promise_id",
+		Up: `
+		CREATE INDEX IF NOT EXISTS idx_notifications_promise_id ON notifications(promise_id);
+		CREATE INDEX IF NOT EXISTS idx_dead_notifications_promise_id ON dead_notifications(promise_id);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_dead_notifications_promise_id;
+		DROP INDEX IF EXISTS idx_notifications_promise_id;`,
+	},
+	{
+		Version: 11,
+		Name:    "promise_search_sort",
+		Up: `
+		UPDATE promises SET created_on = 0 WHERE created_on IS NULL;
+
+		CREATE INDEX IF NOT EXISTS idx_promises_created_on ON promises(created_on, sort_id);
+		CREATE INDEX IF NOT EXISTS idx_promises_timeout ON promises(timeout, sort_id);`,
+		Down: `
+		DROP INDEX IF EXISTS idx_promises_timeout;
+		DROP INDEX IF EXISTS idx_promises_created_on;`,
+	},
+}
+
+var dialect = &migrations.Dialect{
+	Setup: []string{
+		`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    INTEGER PRIMARY KEY,
+			name       TEXT,
+			applied_on INTEGER
+		)`,
+		// a write acquires the database lock, which is held until the
+		// transaction completes
+		`DELETE FROM schema_migrations WHERE version IS NULL`,
+	},
+	Select: `SELECT version, applied_on FROM schema_migrations ORDER BY version`,
+	Insert: `INSERT INTO schema_migrations (version, name, applied_on) VALUES (?, ?, ?)`,
+	Delete: `DELETE FROM schema_migrations WHERE version = ?`,
+}
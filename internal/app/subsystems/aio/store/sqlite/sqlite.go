@@ -4,12 +4,14 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
 	"os"
 	"strings"
 	"time"
 
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/migrations"
 	"github.com/resonatehq/resonate/internal/kernel/bus"
 	"github.com/resonatehq/resonate/internal/kernel/t_aio"
 
@@ -23,53 +25,6 @@ import (
 )
 
 const (
-	CREATE_TABLE_STATEMENT = `
-	CREATE TABLE IF NOT EXISTS promises (
-		id                           TEXT UNIQUE,
-		sort_id                      INTEGER PRIMARY KEY AUTOINCREMENT,
-		state                        INTEGER DEFAULT 1,
-		param_headers                BLOB,
-		param_data                   BLOB,
-		value_headers                BLOB,
-		value_data                   BLOB,
-		timeout                      INTEGER,
-		idempotency_key_for_create   TEXT,
-		idempotency_key_for_complete TEXT,
-		tags                         BLOB,
-		created_on                   INTEGER,
-		completed_on                 INTEGER
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_promises_id ON promises(id);
-
-	CREATE TABLE IF NOT EXISTS timeouts (
-		id   TEXT,
-		time INTEGER,
-		PRIMARY KEY(id)
-	);
-
-	CREATE TABLE IF NOT EXISTS subscriptions (
-		id           TEXT,
-		promise_id   TEXT,
-		sort_id      INTEGER PRIMARY KEY AUTOINCREMENT,
-		url          TEXT,
-		retry_policy BLOB,
-		created_on   INTEGER,
-		UNIQUE(id, promise_id)
-	);
-
-	CREATE INDEX IF NOT EXISTS idx_subscriptions_id ON subscriptions(id);
-
-	CREATE TABLE IF NOT EXISTS notifications (
-		id           TEXT,
-		promise_id   TEXT,
-		url          TEXT,
-		retry_policy BLOB,
-		time         INTEGER,
-		attempt      INTEGER,
-		PRIMARY KEY(id, promise_id)
-	);`
-
 	PROMISE_SELECT_STATEMENT = `
 	SELECT
 		id, state, param_headers, param_data, value_headers, value_data, timeout, idempotency_key_for_create, idempotency_key_for_complete, tags, created_on, completed_on
@@ -84,26 +39,54 @@ const (
 	FROM
 		promises
 	WHERE
-		(? IS NULL OR sort_id < ?) AND
+		(? IS NULL OR %[1]s %[2]s ? OR (%[1]s = ? AND sort_id %[2]s ?)) AND
 		state & ? != 0 AND
-		id LIKE ?
+		id LIKE ? AND
+		(? IS NULL OR created_on >= ?) AND
+		(? IS NULL OR created_on < ?) AND
+		(? IS NULL OR completed_on >= ?) AND
+		(? IS NULL OR completed_on < ?) AND
+		(? IS NULL OR timeout < ?) AND
+		(? = 0 OR id IN (
+			SELECT
+				promise_id
+			FROM
+				promise_tags, json_each(?) AS t
+			WHERE
+				promise_tags.key = t.key AND promise_tags.value = t.value
+			GROUP BY
+				promise_id
+			HAVING
+				COUNT(*) = ?
+		))
 	ORDER BY
-		sort_id DESC
+		%[1]s %[3]s, sort_id %[3]s
 	LIMIT
 		?`
 
 	PROMISE_INSERT_STATEMENT = `
 	INSERT INTO promises
-		(id, state, param_headers, param_data, timeout, idempotency_key_for_create, tags, created_on)
+		(id, state, param_headers, param_data, timeout, idempotency_key_for_create, tags, created_on, retention)
 	VALUES
-		(?, ?, ?, ?, ?, ?, ?, ?)
+		(?, ?, ?, ?, ?, ?, ?, ?, ?)
 	ON CONFLICT(id) DO NOTHING`
 
+	PROMISE_TAGS_INSERT_STATEMENT = `
+	INSERT INTO promise_tags
+		(promise_id, key, value)
+	SELECT
+		?, key, value
+	FROM
+		json_each(?)
+	WHERE
+		true
+	ON CONFLICT(promise_id, key) DO NOTHING`
+
 	PROMISE_UPDATE_STATMENT = `
 	UPDATE
 		promises
 	SET
-		state = ?, value_headers = ?, value_data = ?, idempotency_key_for_complete = ?, completed_on = ?
+		state = ?, value_headers = ?, value_data = ?, idempotency_key_for_complete = ?, completed_on = ?, expires_on = ? + retention
 	WHERE
 		id = ? AND state = 1`
 
@@ -111,10 +94,83 @@ const (
 	UPDATE
 		promises
 	SET
-		state = 8, completed_on = timeout
+		state = 8, completed_on = timeout, expires_on = timeout + retention
 	WHERE
 		state = 1 AND timeout <= ?`
 
+	// promises with a retention expire on expires_on, all other promises
+	// expire once completed before the given time, each branch is served
+	// by an index and limited before the union. promises with pending or
+	// dead notifications do not expire until the notifications are
+	// delivered or deleted
+	PROMISE_SELECT_EXPIRED_RECORDS_STATEMENT = `
+	SELECT * FROM (
+		SELECT
+			id, state, param_headers, param_data, value_headers, value_data, timeout, idempotency_key_for_create, idempotency_key_for_complete, tags, created_on, completed_on, sort_id
+		FROM
+			promises INDEXED BY idx_promises_expires_on
+		WHERE
+			expires_on <= ? AND
+			NOT EXISTS (SELECT 1 FROM notifications WHERE promise_id = promises.id) AND
+			NOT EXISTS (SELECT 1 FROM dead_notifications WHERE promise_id = promises.id)
+		ORDER BY
+			completed_on, sort_id
+		LIMIT
+			?
+	)
+	UNION ALL
+	SELECT * FROM (
+		SELECT
+			id, state, param_headers, param_data, value_headers, value_data, timeout, idempotency_key_for_create, idempotency_key_for_complete, tags, created_on, completed_on, sort_id
+		FROM
+			promises
+		WHERE
+			retention IS NULL AND completed_on <= ? AND
+			NOT EXISTS (SELECT 1 FROM notifications WHERE promise_id = promises.id) AND
+			NOT EXISTS (SELECT 1 FROM dead_notifications WHERE promise_id = promises.id)
+		ORDER BY
+			completed_on, sort_id
+		LIMIT
+			?
+	)
+	ORDER BY
+		completed_on, sort_id
+	LIMIT
+		?`
+
+	PROMISE_SELECT_EXPIRED_STATEMENT = `
+	SELECT id FROM (` + PROMISE_SELECT_EXPIRED_RECORDS_STATEMENT + `)`
+
+	PROMISE_SELECT_BY_IDEMPOTENCY_KEY_STATEMENT = `
+	SELECT
+		id, state, param_headers, param_data, value_headers, value_data, timeout, idempotency_key_for_create, idempotency_key_for_complete, tags, created_on, completed_on, sort_id
+	FROM
+		promises
+	WHERE
+		(? IS NULL OR sort_id < ?) AND
+		(idempotency_key_for_create = ? OR idempotency_key_for_complete = ?)
+	ORDER BY
+		sort_id DESC
+	LIMIT
+		?`
+
+	PROMISE_DELETE_COMPLETED_STATEMENT = `
+	DELETE FROM
+		promises
+	WHERE
+		id = ? AND state != 1 AND
+		NOT EXISTS (SELECT 1 FROM notifications WHERE promise_id = promises.id) AND
+		NOT EXISTS (SELECT 1 FROM dead_notifications WHERE promise_id = promises.id)`
+
+	PROMISE_DELETE_EXPIRED_STATEMENT = `
+	DELETE FROM promises WHERE id IN (SELECT value FROM json_each(?))`
+
+	PROMISE_TAGS_DELETE_STATEMENT = `
+	DELETE FROM promise_tags WHERE promise_id = ?`
+
+	PROMISE_TAGS_DELETE_EXPIRED_STATEMENT = `
+	DELETE FROM promise_tags WHERE promise_id IN (SELECT value FROM json_each(?))`
+
 	TIMEOUT_SELECT_STATEMENT = `
 	SELECT
 		id, time
@@ -136,7 +192,7 @@ const (
 
 	SUBSCRIPTION_SELECT_STATEMENT = `
 	SELECT
-		id, promise_id, url, retry_policy, created_on
+		id, promise_id, url, method, headers, payload, secret, retry_policy, created_on
 	FROM
 		subscriptions
 	WHERE
@@ -144,7 +200,7 @@ const (
 
 	SUBSCRIPTION_SELECT_ALL_STATEMENT = `
 	SELECT
-		id, promise_id, url, retry_policy, created_on, sort_id
+		id, promise_id, url, method, headers, payload, secret, retry_policy, created_on, sort_id
 	FROM
 		subscriptions
 	WHERE
@@ -157,9 +213,9 @@ const (
 
 	SUBSCRIPTION_INSERT_STATEMENT = `
 	INSERT INTO subscriptions
-		(id, promise_id, url, retry_policy, created_on)
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, created_on)
 	VALUES
-		(?, ?, ?, ?, ?)
+		(?, ?, ?, ?, ?, ?, ?, ?, ?)
 	ON CONFLICT(id, promise_id) DO NOTHING`
 
 	SUBSCRIPTION_DELETE_STATEMENT = `
@@ -168,6 +224,9 @@ const (
 	SUBSCRIPTION_DELETE_ALL_STATEMENT = `
 	DELETE FROM subscriptions WHERE promise_id = ?`
 
+	SUBSCRIPTION_DELETE_EXPIRED_STATEMENT = `
+	DELETE FROM subscriptions WHERE promise_id IN (SELECT value FROM json_each(?))`
+
 	SUBSCRIPTION_DELETE_ALL_TIMEOUT_STATEMENT = `
 	DELETE FROM
 		subscriptions
@@ -176,7 +235,7 @@ const (
 
 	NOTIFICATION_SELECT_STATEMENT = `
 	SELECT
-		id, promise_id, url, retry_policy, time, attempt
+		id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt
 	FROM
 		notifications
 	ORDER BY
@@ -185,20 +244,27 @@ const (
 
 	NOTIFICATION_INSERT_STATEMENT = `
 	INSERT INTO notifications
-		(id, promise_id, url, retry_policy, time, attempt)
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt)
 	SELECT
-		id, promise_id, url, retry_policy, ?, 0
+		id, promise_id, url, method, headers, payload, secret, retry_policy, ?, 0
 	FROM
 		subscriptions
 	WHERE
 		promise_id = ?
 	ON CONFLICT(id, promise_id) DO NOTHING`
 
+	NOTIFICATION_INSERT_RECORD_STATEMENT = `
+	INSERT INTO notifications
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt)
+	VALUES
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(id, promise_id) DO NOTHING`
+
 	NOTIFICATION_INSERT_TIMEOUT_STATEMENT = `
 	INSERT INTO notifications
-		(id, promise_id, url, retry_policy, time, attempt)
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, time, attempt)
 	SELECT
-		id, promise_id, url, retry_policy, ?, 0
+		id, promise_id, url, method, headers, payload, secret, retry_policy, ?, 0
 	FROM
 		subscriptions
 	WHERE
@@ -215,6 +281,63 @@ const (
 
 	NOTIFICATION_DELETE_STATEMENT = `
 	DELETE FROM notifications WHERE id = ? AND promise_id = ?`
+
+	DEAD_NOTIFICATION_SELECT_STATEMENT = `
+	SELECT
+		id, promise_id, url, method, headers, payload, secret, retry_policy, attempts, status_code, error, created_on, sort_id
+	FROM
+		dead_notifications
+	WHERE
+		id = ? AND promise_id = ?`
+
+	DEAD_NOTIFICATION_SELECT_ALL_STATEMENT = `
+	SELECT
+		id, promise_id, url, method, headers, payload, secret, retry_policy, attempts, status_code, error, created_on, sort_id
+	FROM
+		dead_notifications
+	WHERE
+		(? IS NULL OR sort_id < ?)
+	ORDER BY
+		sort_id DESC
+	LIMIT
+		?`
+
+	DEAD_NOTIFICATION_INSERT_STATEMENT = `
+	INSERT INTO dead_notifications
+		(id, promise_id, url, method, headers, payload, secret, retry_policy, attempts, status_code, error, created_on)
+	VALUES
+		(?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	ON CONFLICT(id, promise_id) DO NOTHING`
+
+	DEAD_NOTIFICATION_DELETE_STATEMENT = `
+	DELETE FROM dead_notifications WHERE id = ? AND promise_id = ?`
+
+	DEAD_NOTIFICATION_PURGE_STATEMENT = `
+	DELETE FROM dead_notifications`
+
+	NOTIFICATION_ATTEMPT_SELECT_ALL_STATEMENT = `
+	SELECT
+		id, promise_id, attempt, url, status_code, latency, body, error, time, sort_id
+	FROM
+		notification_attempts
+	WHERE
+		(? = '' OR id = ?) AND promise_id = ? AND (? IS NULL OR sort_id < ?)
+	ORDER BY
+		sort_id DESC
+	LIMIT
+		?`
+
+	NOTIFICATION_ATTEMPT_INSERT_STATEMENT = `
+	INSERT INTO notification_attempts
+		(id, promise_id, attempt, url, status_code, latency, body, error, time)
+	VALUES
+		(?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	NOTIFICATION_ATTEMPT_DELETE_ALL_STATEMENT = `
+	DELETE FROM notification_attempts WHERE promise_id = ?`
+
+	NOTIFICATION_ATTEMPT_DELETE_EXPIRED_STATEMENT = `
+	DELETE FROM notification_attempts WHERE promise_id IN (SELECT value FROM json_each(?))`
 )
 
 type Config struct {
@@ -223,8 +346,9 @@ type Config struct {
 }
 
 type SqliteStore struct {
-	config *Config
-	db     *sql.DB
+	config   *Config
+	db       *sql.DB
+	migrator *migrations.Migrator
 }
 
 type SqliteStoreWorker struct {
@@ -238,8 +362,9 @@ func New(config *Config) (aio.Subsystem, error) {
 	}
 
 	return &SqliteStore{
-		config: config,
-		db:     db,
+		config:   config,
+		db:       db,
+		migrator: migrations.New(db, dialect, Migrations),
 	}, nil
 }
 
@@ -248,7 +373,7 @@ func (s *SqliteStore) String() string {
 }
 
 func (s *SqliteStore) Start() error {
-	if _, err := s.db.Exec(CREATE_TABLE_STATEMENT); err != nil {
+	if _, err := s.migrator.Up(); err != nil {
 		return err
 	}
 
@@ -259,6 +384,10 @@ func (s *SqliteStore) Stop() error {
 	return s.db.Close()
 }
 
+func (s *SqliteStore) Migrator() *migrations.Migrator {
+	return s.migrator
+}
+
 func (s *SqliteStore) Reset() error {
 	if _, err := os.Stat(s.config.Path); err != nil {
 		return nil
@@ -275,7 +404,7 @@ func (w *SqliteStoreWorker) Process(sqes []*bus.SQE[t_aio.Submission, t_aio.Comp
 	return store.Process(w, sqes)
 }
 
-func (w *SqliteStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t_aio.Result, error) {
+func (w *SqliteStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
 	util.Assert(len(transactions) > 0, "expected a transaction")
 
 	ctx, cancel := context.WithTimeout(context.Background(), w.config.TxTimeout)
@@ -283,112 +412,125 @@ func (w *SqliteStoreWorker) Execute(transactions []*t_aio.Transaction) ([][]*t_a
 
 	tx, err := w.db.BeginTx(ctx, nil)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	results, err := w.performCommands(tx, transactions)
+	results, errs, err := w.performCommands(tx, transactions)
 	if err != nil {
 		if err := tx.Rollback(); err != nil {
-			return nil, err
+			return nil, nil, err
 		}
-		return nil, err
+		return nil, nil, err
 	}
 
 	if err := tx.Commit(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return results, nil
+	return results, errs, nil
 }
 
-func (w *SqliteStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.Transaction) ([][]*t_aio.Result, error) {
+func (w *SqliteStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.Transaction) ([][]*t_aio.Result, []error, error) {
 	promiseInsertStmt, err := tx.Prepare(PROMISE_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer promiseInsertStmt.Close()
 
+	promiseTagsInsertStmt, err := tx.Prepare(PROMISE_TAGS_INSERT_STATEMENT)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer promiseTagsInsertStmt.Close()
+
 	promiseUpdateStmt, err := tx.Prepare(PROMISE_UPDATE_STATMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer promiseUpdateStmt.Close()
 
 	promiseUpdateTimeoutStmt, err := tx.Prepare(PROMISE_UPDATE_TIMEOUT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer promiseUpdateTimeoutStmt.Close()
 
 	timeoutInsertStmt, err := tx.Prepare(TIMEOUT_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer timeoutInsertStmt.Close()
 
 	timeoutDeleteStmt, err := tx.Prepare(TIMEOUT_DELETE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer timeoutDeleteStmt.Close()
 
 	subscriptionInsertStmt, err := tx.Prepare(SUBSCRIPTION_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionInsertStmt.Close()
 
 	subscriptionDeleteStmt, err := tx.Prepare(SUBSCRIPTION_DELETE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionDeleteStmt.Close()
 
 	subscriptionDeleteAllStmt, err := tx.Prepare(SUBSCRIPTION_DELETE_ALL_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionDeleteAllStmt.Close()
 
 	subscriptionDeleteAllTimeoutStmt, err := tx.Prepare(SUBSCRIPTION_DELETE_ALL_TIMEOUT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer subscriptionDeleteAllTimeoutStmt.Close()
 
 	notificationInsertStmt, err := tx.Prepare(NOTIFICATION_INSERT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationInsertStmt.Close()
 
 	notificationInsertTimeoutStmt, err := tx.Prepare(NOTIFICATION_INSERT_TIMEOUT_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationInsertTimeoutStmt.Close()
 
 	notificationUpdateStmt, err := tx.Prepare(NOTIFICATION_UPDATE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationUpdateStmt.Close()
 
 	notificationDeleteStmt, err := tx.Prepare(NOTIFICATION_DELETE_STATEMENT)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer notificationDeleteStmt.Close()
 
 	results := make([][]*t_aio.Result, len(transactions))
+	errs := make([]error, len(transactions))
 
 	for i, transaction := range transactions {
 		util.Assert(len(transaction.Commands) > 0, "expected a command")
 		results[i] = make([]*t_aio.Result, len(transaction.Commands))
 
-		for j, command := range transaction.Commands {
-			var err error
+		// each transaction runs in a savepoint so that an error only
+		// rolls back the transaction that caused it
+		if _, err := tx.Exec("SAVEPOINT tx"); err != nil {
+			return nil, nil, err
+		}
+
+		var err error
 
+		for j, command := range transaction.Commands {
 			switch command.Kind {
 			// Promise
 			case t_aio.ReadPromise:
@@ -399,13 +541,25 @@ func (w *SqliteStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.Tr
 				results[i][j], err = w.searchPromises(tx, command.SearchPromises)
 			case t_aio.CreatePromise:
 				util.Assert(command.CreatePromise != nil, "command must not be nil")
-				results[i][j], err = w.createPromise(tx, promiseInsertStmt, command.CreatePromise)
+				results[i][j], err = w.createPromise(tx, promiseInsertStmt, promiseTagsInsertStmt, command.CreatePromise)
 			case t_aio.UpdatePromise:
 				util.Assert(command.UpdatePromise != nil, "command must not be nil")
 				results[i][j], err = w.updatePromise(tx, promiseUpdateStmt, command.UpdatePromise)
 			case t_aio.TimeoutPromises:
 				util.Assert(command.TimeoutPromises != nil, "command must not be nil")
 				results[i][j], err = w.timeoutPromises(tx, promiseUpdateTimeoutStmt, command.TimeoutPromises)
+			case t_aio.DeletePromises:
+				util.Assert(command.DeletePromises != nil, "command must not be nil")
+				results[i][j], err = w.deletePromises(tx, command.DeletePromises)
+			case t_aio.ReadExpiredPromises:
+				util.Assert(command.ReadExpiredPromises != nil, "command must not be nil")
+				results[i][j], err = w.readExpiredPromises(tx, command.ReadExpiredPromises)
+			case t_aio.ReadPromisesByIdempotencyKey:
+				util.Assert(command.ReadPromisesByIdempotencyKey != nil, "command must not be nil")
+				results[i][j], err = w.readPromisesByIdempotencyKey(tx, command.ReadPromisesByIdempotencyKey)
+			case t_aio.DeletePromise:
+				util.Assert(command.DeletePromise != nil, "command must not be nil")
+				results[i][j], err = w.deletePromise(tx, command.DeletePromise)
 
 			// Timeout
 			case t_aio.ReadTimeouts:
@@ -445,6 +599,9 @@ func (w *SqliteStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.Tr
 			case t_aio.CreateNotifications:
 				util.Assert(command.CreateNotifications != nil, "command must not be nil")
 				results[i][j], err = w.createNotifications(tx, notificationInsertStmt, command.CreateNotifications)
+			case t_aio.CreateNotification:
+				util.Assert(command.CreateNotification != nil, "command must not be nil")
+				results[i][j], err = w.createNotification(tx, command.CreateNotification)
 			case t_aio.UpdateNotification:
 				util.Assert(command.UpdateNotification != nil, "command must not be nil")
 				results[i][j], err = w.updateNotification(tx, notificationUpdateStmt, command.UpdateNotification)
@@ -455,17 +612,58 @@ func (w *SqliteStoreWorker) performCommands(tx *sql.Tx, transactions []*t_aio.Tr
 				util.Assert(command.TimeoutCreateNotifications != nil, "command must not be nil")
 				results[i][j], err = w.timeoutCreateNotifications(tx, notificationInsertTimeoutStmt, command.TimeoutCreateNotifications)
 
+			// Dead notification
+			case t_aio.ReadDeadNotification:
+				util.Assert(command.ReadDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotification(tx, command.ReadDeadNotification)
+			case t_aio.ReadDeadNotifications:
+				util.Assert(command.ReadDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.readDeadNotifications(tx, command.ReadDeadNotifications)
+			case t_aio.CreateDeadNotification:
+				util.Assert(command.CreateDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.createDeadNotification(tx, command.CreateDeadNotification)
+			case t_aio.DeleteDeadNotification:
+				util.Assert(command.DeleteDeadNotification != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotification(tx, command.DeleteDeadNotification)
+			case t_aio.DeleteDeadNotifications:
+				util.Assert(command.DeleteDeadNotifications != nil, "command must not be nil")
+				results[i][j], err = w.deleteDeadNotifications(tx, command.DeleteDeadNotifications)
+
+			// Notification attempt
+			case t_aio.ReadNotificationAttempts:
+				util.Assert(command.ReadNotificationAttempts != nil, "command must not be nil")
+				results[i][j], err = w.readNotificationAttempts(tx, command.ReadNotificationAttempts)
+			case t_aio.CreateNotificationAttempt:
+				util.Assert(command.CreateNotificationAttempt != nil, "command must not be nil")
+				results[i][j], err = w.createNotificationAttempt(tx, command.CreateNotificationAttempt)
+
 			default:
 				panic("invalid command")
 			}
 
 			if err != nil {
-				return nil, err
+				break
 			}
 		}
+
+		if err != nil {
+			if _, err := tx.Exec("ROLLBACK TO SAVEPOINT tx"); err != nil {
+				return nil, nil, err
+			}
+			results[i], errs[i] = nil, err
+		} else if transaction.Atomic && store.Conflict(results[i]) {
+			if _, err := tx.Exec("ROLLBACK TO SAVEPOINT tx"); err != nil {
+				return nil, nil, err
+			}
+			store.Discard(results[i])
+		}
+
+		if _, err := tx.Exec("RELEASE SAVEPOINT tx"); err != nil {
+			return nil, nil, err
+		}
 	}
 
-	return results, nil
+	return results, errs, nil
 }
 
 func (w *SqliteStoreWorker) readPromise(tx *sql.Tx, cmd *t_aio.ReadPromiseCommand) (*t_aio.Result, error) {
@@ -522,8 +720,33 @@ func (w *SqliteStoreWorker) searchPromises(tx *sql.Tx, cmd *t_aio.SearchPromises
 		mask = mask | int(state)
 	}
 
+	// convert tags to json object
+	tags := []byte("{}")
+	if len(cmd.Tags) > 0 {
+		var err error
+		tags, err = json.Marshal(cmd.Tags)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// convert sort to column and direction
+	column, cmp, order, sortValue := store.SearchSort(cmd)
+
 	// select
-	rows, err := tx.Query(PROMISE_SEARCH_STATEMENT, cmd.SortId, cmd.SortId, mask, query, cmd.Limit)
+	rows, err := tx.Query(
+		fmt.Sprintf(PROMISE_SEARCH_STATEMENT, column, cmp, order),
+		sortValue, sortValue, sortValue, cmd.SortId,
+		mask,
+		query,
+		cmd.CreatedAfter, cmd.CreatedAfter,
+		cmd.CreatedBefore, cmd.CreatedBefore,
+		cmd.CompletedAfter, cmd.CompletedAfter,
+		cmd.CompletedBefore, cmd.CompletedBefore,
+		cmd.TimeoutBefore, cmd.TimeoutBefore,
+		len(cmd.Tags), string(tags), len(cmd.Tags),
+		cmd.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -568,7 +791,7 @@ func (w *SqliteStoreWorker) searchPromises(tx *sql.Tx, cmd *t_aio.SearchPromises
 	}, nil
 }
 
-func (w *SqliteStoreWorker) createPromise(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio.CreatePromiseCommand) (*t_aio.Result, error) {
+func (w *SqliteStoreWorker) createPromise(tx *sql.Tx, stmt *sql.Stmt, tagsStmt *sql.Stmt, cmd *t_aio.CreatePromiseCommand) (*t_aio.Result, error) {
 	util.Assert(cmd.Param.Headers != nil, "headers must not be nil")
 	util.Assert(cmd.Param.Data != nil, "data must not be nil")
 	util.Assert(cmd.Tags != nil, "tags must not be nil")
@@ -584,7 +807,7 @@ func (w *SqliteStoreWorker) createPromise(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio
 	}
 
 	// insert
-	res, err := stmt.Exec(cmd.Id, promise.Pending, headers, cmd.Param.Data, cmd.Timeout, cmd.IdempotencyKey, tags, cmd.CreatedOn)
+	res, err := stmt.Exec(cmd.Id, promise.Pending, headers, cmd.Param.Data, cmd.Timeout, cmd.IdempotencyKey, tags, cmd.CreatedOn, store.Retention(cmd.Tags))
 	if err != nil {
 		return nil, err
 	}
@@ -594,6 +817,15 @@ func (w *SqliteStoreWorker) createPromise(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio
 		return nil, err
 	}
 
+	// index tags, only if the promise was created, the tags column is
+	// the source of truth and promise_tags is only used for search, the
+	// tags of a promise are never updated so both are only written here
+	if rowsAffected == 1 && len(cmd.Tags) > 0 {
+		if _, err := tagsStmt.Exec(cmd.Id, string(tags)); err != nil {
+			return nil, err
+		}
+	}
+
 	return &t_aio.Result{
 		Kind: t_aio.CreatePromise,
 		CreatePromise: &t_aio.AlterPromisesResult{
@@ -613,7 +845,7 @@ func (w *SqliteStoreWorker) updatePromise(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio
 	}
 
 	// update
-	res, err := stmt.Exec(cmd.State, headers, cmd.Value.Data, cmd.IdempotencyKey, cmd.CompletedOn, cmd.Id)
+	res, err := stmt.Exec(cmd.State, headers, cmd.Value.Data, cmd.IdempotencyKey, cmd.CompletedOn, cmd.CompletedOn, cmd.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -653,6 +885,219 @@ func (w *SqliteStoreWorker) timeoutPromises(tx *sql.Tx, stmt *sql.Stmt, cmd *t_a
 	}, nil
 }
 
+func (w *SqliteStoreWorker) deletePromises(tx *sql.Tx, cmd *t_aio.DeletePromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	// promises without a retention are kept when there is no default
+	var completedBefore *int64
+	if cmd.Retention != nil {
+		t := cmd.Time - *cmd.Retention
+		completedBefore = &t
+	}
+
+	// select the expired promises once, the related rows and then the
+	// promises are deleted by id
+	rows, err := tx.Query(PROMISE_SELECT_EXPIRED_STATEMENT, cmd.Time, cmd.Limit, completedBefore, cmd.Limit, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	ids := []string{}
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(ids) == 0 {
+		return &t_aio.Result{
+			Kind: t_aio.DeletePromises,
+			DeletePromises: &t_aio.AlterPromisesResult{
+				RowsAffected: 0,
+			},
+		}, nil
+	}
+
+	idsJson, err := json.Marshal(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stmt := range []string{
+		NOTIFICATION_ATTEMPT_DELETE_EXPIRED_STATEMENT,
+		SUBSCRIPTION_DELETE_EXPIRED_STATEMENT,
+		PROMISE_TAGS_DELETE_EXPIRED_STATEMENT,
+	} {
+		if _, err := tx.Exec(stmt, idsJson); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := tx.Exec(PROMISE_DELETE_EXPIRED_STATEMENT, idsJson)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromises,
+		DeletePromises: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) readExpiredPromises(tx *sql.Tx, cmd *t_aio.ReadExpiredPromisesCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Time >= 0, "time must be non-negative")
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	// promises without a retention are kept when there is no default
+	var completedBefore *int64
+	if cmd.Retention != nil {
+		t := cmd.Time - *cmd.Retention
+		completedBefore = &t
+	}
+
+	// select
+	rows, err := tx.Query(PROMISE_SELECT_EXPIRED_RECORDS_STATEMENT, cmd.Time, cmd.Limit, completedBefore, cmd.Limit, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &promise.PromiseRecord{}
+		if err := rows.Scan(
+			&record.Id,
+			&record.State,
+			&record.ParamHeaders,
+			&record.ParamData,
+			&record.ValueHeaders,
+			&record.ValueData,
+			&record.Timeout,
+			&record.IdempotencyKeyForCreate,
+			&record.IdempotencyKeyForComplete,
+			&record.Tags,
+			&record.CreatedOn,
+			&record.CompletedOn,
+			&record.SortId,
+		); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadExpiredPromises,
+		ReadExpiredPromises: &t_aio.QueryPromisesResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) readPromisesByIdempotencyKey(tx *sql.Tx, cmd *t_aio.ReadPromisesByIdempotencyKeyCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.Limit > 0, "limit must be greater than zero")
+
+	// select
+	rows, err := tx.Query(PROMISE_SELECT_BY_IDEMPOTENCY_KEY_STATEMENT, cmd.SortId, cmd.SortId, cmd.IdempotencyKey, cmd.IdempotencyKey, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*promise.PromiseRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &promise.PromiseRecord{}
+		if err := rows.Scan(
+			&record.Id,
+			&record.State,
+			&record.ParamHeaders,
+			&record.ParamData,
+			&record.ValueHeaders,
+			&record.ValueData,
+			&record.Timeout,
+			&record.IdempotencyKeyForCreate,
+			&record.IdempotencyKeyForComplete,
+			&record.Tags,
+			&record.CreatedOn,
+			&record.CompletedOn,
+			&record.SortId,
+		); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadPromisesByIdempotencyKey,
+		ReadPromisesByIdempotencyKey: &t_aio.QueryPromisesResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) deletePromise(tx *sql.Tx, cmd *t_aio.DeletePromiseCommand) (*t_aio.Result, error) {
+	// delete
+	res, err := tx.Exec(PROMISE_DELETE_COMPLETED_STATEMENT, cmd.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	// delete related rows, only if the promise was deleted
+	if rowsAffected == 1 {
+		for _, stmt := range []string{
+			NOTIFICATION_ATTEMPT_DELETE_ALL_STATEMENT,
+			SUBSCRIPTION_DELETE_ALL_STATEMENT,
+			PROMISE_TAGS_DELETE_STATEMENT,
+		} {
+			if _, err := tx.Exec(stmt, cmd.Id); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeletePromise,
+		DeletePromise: &t_aio.AlterPromisesResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
 func (w *SqliteStoreWorker) readTimeouts(tx *sql.Tx, cmd *t_aio.ReadTimeoutsCommand) (*t_aio.Result, error) {
 	// select
 	rows, err := tx.Query(TIMEOUT_SELECT_STATEMENT, cmd.N)
@@ -731,7 +1176,7 @@ func (w *SqliteStoreWorker) readSubscription(tx *sql.Tx, cmd *t_aio.ReadSubscrip
 	record := &subscription.SubscriptionRecord{}
 	rowsReturned := int64(1)
 
-	if err := row.Scan(&record.Id, &record.PromiseId, &record.Url, &record.RetryPolicy, &record.CreatedOn); err != nil {
+	if err := row.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.CreatedOn); err != nil {
 		if err == sql.ErrNoRows {
 			rowsReturned = 0
 		} else {
@@ -767,7 +1212,7 @@ func (w *SqliteStoreWorker) readSubscriptions(tx *sql.Tx, cmd *t_aio.ReadSubscri
 
 	for rows.Next() {
 		record := &subscription.SubscriptionRecord{}
-		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.RetryPolicy, &record.CreatedOn, &record.SortId); err != nil {
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.CreatedOn, &record.SortId); err != nil {
 			return nil, err
 		}
 
@@ -789,13 +1234,18 @@ func (w *SqliteStoreWorker) readSubscriptions(tx *sql.Tx, cmd *t_aio.ReadSubscri
 func (w *SqliteStoreWorker) createSubscription(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio.CreateSubscriptionCommand) (*t_aio.Result, error) {
 	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
 
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
 	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
 	if err != nil {
 		return nil, err
 	}
 
 	// insert
-	res, err := stmt.Exec(cmd.Id, cmd.PromiseId, cmd.Url, retryPolicy, cmd.CreatedOn)
+	res, err := stmt.Exec(cmd.Id, cmd.PromiseId, cmd.Url, cmd.Method, headers, payload, cmd.Secret, retryPolicy, cmd.CreatedOn)
 	if err != nil {
 		return nil, err
 	}
@@ -888,7 +1338,7 @@ func (w *SqliteStoreWorker) readNotifications(tx *sql.Tx, cmd *t_aio.ReadNotific
 
 	for rows.Next() {
 		record := &notification.NotificationRecord{}
-		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.RetryPolicy, &record.Time, &record.Attempt); err != nil {
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.Time, &record.Attempt); err != nil {
 			return nil, err
 		}
 
@@ -927,6 +1377,38 @@ func (w *SqliteStoreWorker) createNotifications(tx *sql.Tx, stmt *sql.Stmt, cmd
 	}, nil
 }
 
+func (w *SqliteStoreWorker) createNotification(tx *sql.Tx, cmd *t_aio.CreateNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// insert
+	res, err := tx.Exec(NOTIFICATION_INSERT_RECORD_STATEMENT, cmd.Id, cmd.PromiseId, cmd.Url, cmd.Method, headers, payload, cmd.Secret, retryPolicy, cmd.Time, cmd.Attempt)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotification,
+		CreateNotification: &t_aio.AlterNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
 func (w *SqliteStoreWorker) updateNotification(tx *sql.Tx, stmt *sql.Stmt, cmd *t_aio.UpdateNotificationCommand) (*t_aio.Result, error) {
 	// update
 	res, err := stmt.Exec(cmd.Time, cmd.Attempt, cmd.Id, cmd.PromiseId)
@@ -988,3 +1470,189 @@ func (w *SqliteStoreWorker) timeoutCreateNotifications(tx *sql.Tx, stmt *sql.Stm
 		},
 	}, nil
 }
+
+func (w *SqliteStoreWorker) readDeadNotification(tx *sql.Tx, cmd *t_aio.ReadDeadNotificationCommand) (*t_aio.Result, error) {
+	// select
+	row := tx.QueryRow(DEAD_NOTIFICATION_SELECT_STATEMENT, cmd.Id, cmd.PromiseId)
+	record := &notification.DeadNotificationRecord{}
+	rowsReturned := int64(1)
+
+	if err := row.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.Attempts, &record.StatusCode, &record.Error, &record.CreatedOn, &record.SortId); err != nil {
+		if err == sql.ErrNoRows {
+			rowsReturned = 0
+		} else {
+			return nil, err
+		}
+	}
+
+	var records []*notification.DeadNotificationRecord
+	if rowsReturned == 1 {
+		records = append(records, record)
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotification,
+		ReadDeadNotification: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: rowsReturned,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) readDeadNotifications(tx *sql.Tx, cmd *t_aio.ReadDeadNotificationsCommand) (*t_aio.Result, error) {
+	// select
+	rows, err := tx.Query(DEAD_NOTIFICATION_SELECT_ALL_STATEMENT, cmd.SortId, cmd.SortId, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*notification.DeadNotificationRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &notification.DeadNotificationRecord{}
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Url, &record.Method, &record.Headers, &record.Payload, &record.Secret, &record.RetryPolicy, &record.Attempts, &record.StatusCode, &record.Error, &record.CreatedOn, &record.SortId); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadDeadNotifications,
+		ReadDeadNotifications: &t_aio.QueryDeadNotificationsResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) createDeadNotification(tx *sql.Tx, cmd *t_aio.CreateDeadNotificationCommand) (*t_aio.Result, error) {
+	util.Assert(cmd.RetryPolicy != nil, "retry policy must not be nil")
+
+	headers, payload, err := subscription.MarshalDelivery(cmd.Headers, cmd.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy, err := json.Marshal(cmd.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	// insert
+	res, err := tx.Exec(DEAD_NOTIFICATION_INSERT_STATEMENT, cmd.Id, cmd.PromiseId, cmd.Url, cmd.Method, headers, payload, cmd.Secret, retryPolicy, cmd.Attempts, cmd.StatusCode, cmd.Error, cmd.CreatedOn)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateDeadNotification,
+		CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) deleteDeadNotification(tx *sql.Tx, cmd *t_aio.DeleteDeadNotificationCommand) (*t_aio.Result, error) {
+	// delete
+	res, err := tx.Exec(DEAD_NOTIFICATION_DELETE_STATEMENT, cmd.Id, cmd.PromiseId)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotification,
+		DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) deleteDeadNotifications(tx *sql.Tx, cmd *t_aio.DeleteDeadNotificationsCommand) (*t_aio.Result, error) {
+	// delete
+	res, err := tx.Exec(DEAD_NOTIFICATION_PURGE_STATEMENT)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.DeleteDeadNotifications,
+		DeleteDeadNotifications: &t_aio.AlterDeadNotificationsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) readNotificationAttempts(tx *sql.Tx, cmd *t_aio.ReadNotificationAttemptsCommand) (*t_aio.Result, error) {
+	// select
+	rows, err := tx.Query(NOTIFICATION_ATTEMPT_SELECT_ALL_STATEMENT, cmd.Id, cmd.Id, cmd.PromiseId, cmd.SortId, cmd.SortId, cmd.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rowsReturned := int64(0)
+	var records []*notification.NotificationAttemptRecord
+	var lastSortId int64
+
+	for rows.Next() {
+		record := &notification.NotificationAttemptRecord{}
+		if err := rows.Scan(&record.Id, &record.PromiseId, &record.Attempt, &record.Url, &record.StatusCode, &record.Latency, &record.Body, &record.Error, &record.Time, &record.SortId); err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+		lastSortId = record.SortId
+		rowsReturned++
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.ReadNotificationAttempts,
+		ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+			RowsReturned: rowsReturned,
+			LastSortId:   lastSortId,
+			Records:      records,
+		},
+	}, nil
+}
+
+func (w *SqliteStoreWorker) createNotificationAttempt(tx *sql.Tx, cmd *t_aio.CreateNotificationAttemptCommand) (*t_aio.Result, error) {
+	// insert
+	res, err := tx.Exec(NOTIFICATION_ATTEMPT_INSERT_STATEMENT, cmd.Id, cmd.PromiseId, cmd.Attempt, cmd.Url, cmd.StatusCode, cmd.Latency, cmd.Body, cmd.Error, cmd.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	return &t_aio.Result{
+		Kind: t_aio.CreateNotificationAttempt,
+		CreateNotificationAttempt: &t_aio.AlterNotificationAttemptsResult{
+			RowsAffected: rowsAffected,
+		},
+	}, nil
+}
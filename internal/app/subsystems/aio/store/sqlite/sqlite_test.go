@@ -1,10 +1,16 @@
 package sqlite
 
 import (
+	"database/sql"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/test"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSqliteStore(t *testing.T) {
@@ -30,3 +36,217 @@ func TestSqliteStore(t *testing.T) {
 		}
 	}
 }
+
+func TestSqliteMigrations(t *testing.T) {
+	store, err := New(&Config{
+		Path:      ":memory:",
+		TxTimeout: 250 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := store.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	migrator := store.(*SqliteStore).Migrator()
+
+	// all migrations are applied on start
+	if applied, err := migrator.Up(); err != nil || len(applied) != 0 {
+		t.Fatalf("expected no pending migrations, got %v (%v)", applied, err)
+	}
+
+	reverted, err := migrator.Down(len(Migrations))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reverted) != len(Migrations) {
+		t.Fatalf("expected %d reverted migrations, got %d", len(Migrations), len(reverted))
+	}
+
+	statuses, err := migrator.Status()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, status := range statuses {
+		if status.Applied() {
+			t.Fatalf("expected migration %s to be pending", status.Migration)
+		}
+	}
+
+	applied, err := migrator.Up()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) != len(Migrations) {
+		t.Fatalf("expected %d applied migrations, got %d", len(Migrations), len(applied))
+	}
+}
+
+func TestSqliteMigrationsFromBaseline(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resonate.db")
+
+	// a database created before migrations were tracked only has the
+	// initial schema
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(Migrations[0].Up); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Exec(
+		"INSERT INTO promises (id, state, param_headers, param_data, timeout, tags, created_on) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		"foo", promise.Pending, []byte("{}"), []byte{}, 1, []byte(`{"a":"a","b":"b"}`), 1,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := New(&Config{
+		Path:      path,
+		TxTimeout: 250 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := store.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	// the tags of existing promises are indexed
+	cqes := store.NewWorker(0).Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{
+		{
+			Submission: &t_aio.Submission{
+				Kind: t_aio.Store,
+				Store: &t_aio.StoreSubmission{
+					Transaction: &t_aio.Transaction{
+						Commands: []*t_aio.Command{
+							{
+								Kind: t_aio.SearchPromises,
+								SearchPromises: &t_aio.SearchPromisesCommand{
+									Q:      "*",
+									States: []promise.State{promise.Pending},
+									Tags:   map[string]string{"a": "a"},
+									Limit:  10,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+
+	assert.Nil(t, cqes[0].Error)
+
+	result := cqes[0].Completion.Store.Results[0].SearchPromises
+	assert.Equal(t, int64(1), result.RowsReturned)
+	assert.Equal(t, "foo", result.Records[0].Id)
+}
+
+func TestSqliteTransactionIsolation(t *testing.T) {
+	store, err := New(&Config{
+		Path:      ":memory:",
+		TxTimeout: 250 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := store.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	// fail the insert of a specific timeout when the statement is
+	// executed, after the statement is prepared
+	if _, err := store.(*SqliteStore).db.Exec(`
+	CREATE TRIGGER fail BEFORE INSERT ON timeouts WHEN NEW.id = 'fail'
+	BEGIN
+		SELECT RAISE(ABORT, 'fail');
+	END`); err != nil {
+		t.Fatal(err)
+	}
+
+	createPromise := func(id string) *t_aio.Command {
+		return &t_aio.Command{
+			Kind: t_aio.CreatePromise,
+			CreatePromise: &t_aio.CreatePromiseCommand{
+				Id:      id,
+				Timeout: 1,
+				Param: promise.Value{
+					Headers: map[string]string{},
+					Data:    []byte{},
+				},
+				Tags:      map[string]string{},
+				CreatedOn: 1,
+			},
+		}
+	}
+
+	createTimeout := func(id string) *t_aio.Command {
+		return &t_aio.Command{
+			Kind: t_aio.CreateTimeout,
+			CreateTimeout: &t_aio.CreateTimeoutCommand{
+				Id:   id,
+				Time: 1,
+			},
+		}
+	}
+
+	submission := func(commands ...*t_aio.Command) *bus.SQE[t_aio.Submission, t_aio.Completion] {
+		return &bus.SQE[t_aio.Submission, t_aio.Completion]{
+			Submission: &t_aio.Submission{
+				Kind: t_aio.Store,
+				Store: &t_aio.StoreSubmission{
+					Transaction: &t_aio.Transaction{
+						Commands: commands,
+					},
+				},
+			},
+		}
+	}
+
+	cqes := store.NewWorker(0).Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{
+		submission(createPromise("foo")),
+		submission(createPromise("bar"), createTimeout("fail")),
+		submission(createPromise("baz")),
+	})
+
+	assert.Len(t, cqes, 3)
+	assert.Nil(t, cqes[0].Error)
+	assert.NotNil(t, cqes[1].Error)
+	assert.Nil(t, cqes[2].Error)
+
+	// only the changes of the failed transaction are rolled back
+	cqes = store.NewWorker(0).Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{
+		submission(
+			&t_aio.Command{Kind: t_aio.ReadPromise, ReadPromise: &t_aio.ReadPromiseCommand{Id: "foo"}},
+			&t_aio.Command{Kind: t_aio.ReadPromise, ReadPromise: &t_aio.ReadPromiseCommand{Id: "bar"}},
+			&t_aio.Command{Kind: t_aio.ReadPromise, ReadPromise: &t_aio.ReadPromiseCommand{Id: "baz"}},
+		),
+	})
+
+	assert.Nil(t, cqes[0].Error)
+
+	results := cqes[0].Completion.Store.Results
+	assert.Equal(t, int64(1), results[0].ReadPromise.RowsReturned)
+	assert.Equal(t, int64(0), results[1].ReadPromise.RowsReturned)
+	assert.Equal(t, int64(1), results[2].ReadPromise.RowsReturned)
+}
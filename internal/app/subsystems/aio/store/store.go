@@ -7,7 +7,10 @@ import (
 )
 
 type Store interface {
-	Execute([]*t_aio.Transaction) ([][]*t_aio.Result, error)
+	// Execute runs a batch of transactions. Each transaction is isolated,
+	// an error returned at the index of a transaction only fails that
+	// transaction, the returned error fails the whole batch.
+	Execute([]*t_aio.Transaction) ([][]*t_aio.Result, []error, error)
 }
 
 func Process(store Store, sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]) []*bus.CQE[t_aio.Submission, t_aio.Completion] {
@@ -19,9 +22,10 @@ func Process(store Store, sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]) [
 		transactions = append(transactions, sqe.Submission.Store.Transaction)
 	}
 
-	results, err := store.Execute(transactions)
+	results, errs, err := store.Execute(transactions)
 	if err == nil {
 		util.Assert(len(transactions) == len(results), "transactions and results must have equal length")
+		util.Assert(len(transactions) == len(errs), "transactions and errors must have equal length")
 	}
 
 	for i, sqe := range sqes {
@@ -32,6 +36,8 @@ func Process(store Store, sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]) [
 
 		if err != nil {
 			cqe.Error = err
+		} else if errs[i] != nil {
+			cqe.Error = errs[i]
 		} else {
 			cqe.Completion = &t_aio.Completion{
 				Kind: t_aio.Store,
@@ -46,3 +52,76 @@ func Process(store Store, sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]) [
 
 	return cqes
 }
+
+// Conflict returns true if any create or update promise command, or
+// delete dead notification command, affected zero rows, an atomic
+// transaction must be rolled back in this case.
+func Conflict(results []*t_aio.Result) bool {
+	for _, result := range results {
+		switch result.Kind {
+		case t_aio.CreatePromise:
+			if result.CreatePromise.RowsAffected == 0 {
+				return true
+			}
+		case t_aio.UpdatePromise:
+			if result.UpdatePromise.RowsAffected == 0 {
+				return true
+			}
+		case t_aio.DeleteDeadNotification:
+			if result.DeleteDeadNotification.RowsAffected == 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Discard sets rows affected to zero for all results of a rolled back
+// transaction.
+func Discard(results []*t_aio.Result) {
+	for _, result := range results {
+		switch result.Kind {
+		case t_aio.CreatePromise:
+			result.CreatePromise.RowsAffected = 0
+		case t_aio.UpdatePromise:
+			result.UpdatePromise.RowsAffected = 0
+		case t_aio.TimeoutPromises:
+			result.TimeoutPromises.RowsAffected = 0
+		case t_aio.CreateTimeout:
+			result.CreateTimeout.RowsAffected = 0
+		case t_aio.DeleteTimeout:
+			result.DeleteTimeout.RowsAffected = 0
+		case t_aio.CreateSubscription:
+			result.CreateSubscription.RowsAffected = 0
+		case t_aio.DeleteSubscription:
+			result.DeleteSubscription.RowsAffected = 0
+		case t_aio.DeleteSubscriptions:
+			result.DeleteSubscriptions.RowsAffected = 0
+		case t_aio.TimeoutDeleteSubscriptions:
+			result.TimeoutDeleteSubscriptions.RowsAffected = 0
+		case t_aio.CreateNotifications:
+			result.CreateNotifications.RowsAffected = 0
+		case t_aio.CreateNotification:
+			result.CreateNotification.RowsAffected = 0
+		case t_aio.UpdateNotification:
+			result.UpdateNotification.RowsAffected = 0
+		case t_aio.DeleteNotification:
+			result.DeleteNotification.RowsAffected = 0
+		case t_aio.TimeoutCreateNotifications:
+			result.TimeoutCreateNotifications.RowsAffected = 0
+		case t_aio.DeletePromises:
+			result.DeletePromises.RowsAffected = 0
+		case t_aio.DeletePromise:
+			result.DeletePromise.RowsAffected = 0
+		case t_aio.CreateDeadNotification:
+			result.CreateDeadNotification.RowsAffected = 0
+		case t_aio.DeleteDeadNotification:
+			result.DeleteDeadNotification.RowsAffected = 0
+		case t_aio.DeleteDeadNotifications:
+			result.DeleteDeadNotifications.RowsAffected = 0
+		case t_aio.CreateNotificationAttempt:
+			result.CreateNotificationAttempt.RowsAffected = 0
+		}
+	}
+}
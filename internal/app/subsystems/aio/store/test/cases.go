@@ -17,8 +17,13 @@ import (
 type testCase struct {
 	name     string
 	panic    bool
+	atomic   bool
 	commands []*t_aio.Command
 	expected []*t_aio.Result
+
+	// optional, executed as a second transaction in the same batch
+	next         []*t_aio.Command
+	nextExpected []*t_aio.Result
 }
 
 func (c *testCase) Run(t *testing.T, subsystem aio.Subsystem) {
@@ -39,18 +44,34 @@ func (c *testCase) Run(t *testing.T, subsystem aio.Subsystem) {
 					Store: &t_aio.StoreSubmission{
 						Transaction: &t_aio.Transaction{
 							Commands: c.commands,
+							Atomic:   c.atomic,
 						},
 					},
 				},
 			},
 		}
+		expected := [][]*t_aio.Result{c.expected}
+
+		if c.next != nil {
+			sqes = append(sqes, &bus.SQE[t_aio.Submission, t_aio.Completion]{
+				Submission: &t_aio.Submission{
+					Kind: t_aio.Store,
+					Store: &t_aio.StoreSubmission{
+						Transaction: &t_aio.Transaction{
+							Commands: c.next,
+						},
+					},
+				},
+			})
+			expected = append(expected, c.nextExpected)
+		}
 
-		for _, cqe := range subsystem.NewWorker(0).Process(sqes) {
+		for i, cqe := range subsystem.NewWorker(0).Process(sqes) {
 			if cqe.Error != nil {
 				t.Fatal(cqe.Error)
 			}
 
-			assert.Equal(t, c.expected, cqe.Completion.Store.Results)
+			assert.Equal(t, expected[i], cqe.Completion.Store.Results)
 		}
 	})
 }
@@ -1716,118 +1737,493 @@ var TestCases = []*testCase{
 		},
 	},
 	{
-		name: "CreateTimeout",
+		name: "SearchPromisesByTags",
 		commands: []*t_aio.Command{
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.CreateTimeoutCommand{
-					Id:   "foo",
-					Time: 0,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 2,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags: map[string]string{
+						"a": "a",
+						"b": "b",
+					},
+					CreatedOn: 1,
 				},
 			},
-		},
-		expected: []*t_aio.Result{
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.AlterTimeoutsResult{
-					RowsAffected: 1,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "bar",
+					Timeout: 2,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags: map[string]string{
+						"a": "a",
+					},
+					CreatedOn: 1,
 				},
 			},
-		},
-	},
-	{
-		name: "CreateTimeoutTwice",
-		commands: []*t_aio.Command{
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.CreateTimeoutCommand{
-					Id:   "foo",
-					Time: 0,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "baz",
+					Timeout: 2,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags: map[string]string{
+						"a": "b",
+					},
+					CreatedOn: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.CreateTimeoutCommand{
-					Id:   "foo",
-					Time: 1,
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.SearchPromisesCommand{
+					Q: "*",
+					States: []promise.State{
+						promise.Pending,
+					},
+					Tags: map[string]string{
+						"a": "a",
+					},
+					Limit: 3,
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.SearchPromisesCommand{
+					Q: "*",
+					States: []promise.State{
+						promise.Pending,
+					},
+					Tags: map[string]string{
+						"a": "a",
+						"b": "b",
+					},
+					Limit: 3,
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.SearchPromisesCommand{
+					Q: "*",
+					States: []promise.State{
+						promise.Pending,
+					},
+					Tags: map[string]string{
+						"c": "c",
+					},
+					Limit: 3,
 				},
 			},
 		},
 		expected: []*t_aio.Result{
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.AlterTimeoutsResult{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.AlterTimeoutsResult{
-					RowsAffected: 0,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 2,
+					LastSortId:   1,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:           "bar",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      2,
+							CreatedOn:    int64ToPointer(1),
+							Tags:         []byte(`{"a":"a"}`),
+							SortId:       2,
+						},
+						{
+							Id:           "foo",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      2,
+							CreatedOn:    int64ToPointer(1),
+							Tags:         []byte(`{"a":"a","b":"b"}`),
+							SortId:       1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 1,
+					LastSortId:   1,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:           "foo",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      2,
+							CreatedOn:    int64ToPointer(1),
+							Tags:         []byte(`{"a":"a","b":"b"}`),
+							SortId:       1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
 				},
 			},
 		},
 	},
 	{
-		name: "ReadNTimeout",
+		name: "SearchPromisesByTimeAndSort",
 		commands: []*t_aio.Command{
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.CreateTimeoutCommand{
-					Id:   "foo",
-					Time: 0,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 3,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 2,
 				},
 			},
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.CreateTimeoutCommand{
-					Id:   "bar",
-					Time: 1,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "bar",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 3,
 				},
 			},
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.CreateTimeoutCommand{
-					Id:   "baz",
-					Time: 2,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "baz",
+					Timeout: 2,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.CreateTimeoutCommand{
-					Id:   "qux",
-					Time: 3,
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.SearchPromisesCommand{
+					Q: "*",
+					States: []promise.State{
+						promise.Pending,
+					},
+					SortBy:    promise.SortByTimeout,
+					SortOrder: promise.Ascending,
+					Limit:     2,
 				},
 			},
 			{
-				Kind: t_aio.ReadTimeouts,
-				ReadTimeouts: &t_aio.ReadTimeoutsCommand{
-					N: 3,
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.SearchPromisesCommand{
+					Q: "*",
+					States: []promise.State{
+						promise.Pending,
+					},
+					SortBy:    promise.SortByTimeout,
+					SortOrder: promise.Ascending,
+					Limit:     2,
+					SortId:    int64ToPointer(3),
+					SortValue: int64ToPointer(2),
 				},
 			},
-		},
-		expected: []*t_aio.Result{
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.AlterTimeoutsResult{
-					RowsAffected: 1,
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.SearchPromisesCommand{
+					Q: "*",
+					States: []promise.State{
+						promise.Pending,
+					},
+					SortBy:    promise.SortByCreatedOn,
+					SortOrder: promise.Descending,
+					Limit:     2,
 				},
 			},
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.AlterTimeoutsResult{
-					RowsAffected: 1,
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.SearchPromisesCommand{
+					Q: "*",
+					States: []promise.State{
+						promise.Pending,
+					},
+					CreatedAfter:  int64ToPointer(2),
+					TimeoutBefore: int64ToPointer(3),
+					Limit:         2,
 				},
 			},
+		},
+		expected: []*t_aio.Result{
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.AlterTimeoutsResult{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateTimeout,
-				CreateTimeout: &t_aio.AlterTimeoutsResult{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 2,
+					LastSortId:   3,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:           "bar",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      1,
+							CreatedOn:    int64ToPointer(3),
+							Tags:         []byte("{}"),
+							SortId:       2,
+						},
+						{
+							Id:           "baz",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      2,
+							CreatedOn:    int64ToPointer(1),
+							Tags:         []byte("{}"),
+							SortId:       3,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 1,
+					LastSortId:   1,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:           "foo",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      3,
+							CreatedOn:    int64ToPointer(2),
+							Tags:         []byte("{}"),
+							SortId:       1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 2,
+					LastSortId:   1,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:           "bar",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      1,
+							CreatedOn:    int64ToPointer(3),
+							Tags:         []byte("{}"),
+							SortId:       2,
+						},
+						{
+							Id:           "foo",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      3,
+							CreatedOn:    int64ToPointer(2),
+							Tags:         []byte("{}"),
+							SortId:       1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.SearchPromises,
+				SearchPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 1,
+					LastSortId:   2,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:           "bar",
+							State:        1,
+							ParamHeaders: []byte("{}"),
+							ParamData:    []byte{},
+							Timeout:      1,
+							CreatedOn:    int64ToPointer(3),
+							Tags:         []byte("{}"),
+							SortId:       2,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		name: "CreateTimeout",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.CreateTimeoutCommand{
+					Id:   "foo",
+					Time: 0,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.AlterTimeoutsResult{
+					RowsAffected: 1,
+				},
+			},
+		},
+	},
+	{
+		name: "CreateTimeoutTwice",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.CreateTimeoutCommand{
+					Id:   "foo",
+					Time: 0,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.CreateTimeoutCommand{
+					Id:   "foo",
+					Time: 1,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.AlterTimeoutsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.AlterTimeoutsResult{
+					RowsAffected: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "ReadNTimeout",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.CreateTimeoutCommand{
+					Id:   "foo",
+					Time: 0,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.CreateTimeoutCommand{
+					Id:   "bar",
+					Time: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.CreateTimeoutCommand{
+					Id:   "baz",
+					Time: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.CreateTimeoutCommand{
+					Id:   "qux",
+					Time: 3,
+				},
+			},
+			{
+				Kind: t_aio.ReadTimeouts,
+				ReadTimeouts: &t_aio.ReadTimeoutsCommand{
+					N: 3,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.AlterTimeoutsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.AlterTimeoutsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.AlterTimeoutsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateTimeout,
+				CreateTimeout: &t_aio.AlterTimeoutsResult{
 					RowsAffected: 1,
 				},
 			},
@@ -2125,7 +2521,7 @@ var TestCases = []*testCase{
 		},
 	},
 	{
-		name: "ReadSubscriptions",
+		name: "ReadSubscriptionWithRetryPolicy",
 		commands: []*t_aio.Command{
 			{
 				Kind: t_aio.CreateSubscription,
@@ -2133,7 +2529,7 @@ var TestCases = []*testCase{
 					Id:          "a",
 					PromiseId:   "foo",
 					Url:         "https://foo.com/a",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					RetryPolicy: &subscription.RetryPolicy{Kind: "exponential", Delay: 1, Attempts: 1, MaxDelay: 10, Jitter: true},
 					CreatedOn:   1,
 				},
 			},
@@ -2143,47 +2539,130 @@ var TestCases = []*testCase{
 					Id:          "b",
 					PromiseId:   "foo",
 					Url:         "https://foo.com/b",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 2, Attempts: 2},
-					CreatedOn:   2,
+					RetryPolicy: &subscription.RetryPolicy{Kind: "schedule", Attempts: 3, Schedule: []int64{1, 10, 100}},
+					CreatedOn:   1,
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.CreateSubscriptionCommand{
-					Id:          "a",
-					PromiseId:   "bar",
-					Url:         "https://bar.com/a",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 3, Attempts: 3},
-					CreatedOn:   3,
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.ReadSubscriptionCommand{
+					Id:        "a",
+					PromiseId: "foo",
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.CreateSubscriptionCommand{
-					Id:          "b",
-					PromiseId:   "bar",
-					Url:         "https://bar.com/b",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 4, Attempts: 4},
-					CreatedOn:   4,
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.ReadSubscriptionCommand{
+					Id:        "b",
+					PromiseId: "foo",
 				},
 			},
+		},
+		expected: []*t_aio.Result{
 			{
-				Kind: t_aio.ReadSubscriptions,
-				ReadSubscriptions: &t_aio.ReadSubscriptionsCommand{
-					PromiseId: "foo",
-					Limit:     4,
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.ReadSubscriptions,
-				ReadSubscriptions: &t_aio.ReadSubscriptionsCommand{
-					PromiseId: "bar",
-					Limit:     1,
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.ReadSubscriptions,
-				ReadSubscriptions: &t_aio.ReadSubscriptionsCommand{
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.QuerySubscriptionsResult{
+					RowsReturned: 1,
+					Records: []*subscription.SubscriptionRecord{
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							RetryPolicy: []byte("{\"kind\":\"exponential\",\"delay\":1,\"attempts\":1,\"maxDelay\":10,\"jitter\":true}"),
+							CreatedOn:   1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.QuerySubscriptionsResult{
+					RowsReturned: 1,
+					Records: []*subscription.SubscriptionRecord{
+						{
+							Id:          "b",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/b",
+							RetryPolicy: []byte("{\"kind\":\"schedule\",\"delay\":0,\"attempts\":3,\"schedule\":[1,10,100]}"),
+							CreatedOn:   1,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		name: "ReadSubscriptions",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					CreatedOn:   1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "b",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/b",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 2, Attempts: 2},
+					CreatedOn:   2,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "a",
+					PromiseId:   "bar",
+					Url:         "https://bar.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 3, Attempts: 3},
+					CreatedOn:   3,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "b",
+					PromiseId:   "bar",
+					Url:         "https://bar.com/b",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 4, Attempts: 4},
+					CreatedOn:   4,
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscriptions,
+				ReadSubscriptions: &t_aio.ReadSubscriptionsCommand{
+					PromiseId: "foo",
+					Limit:     4,
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscriptions,
+				ReadSubscriptions: &t_aio.ReadSubscriptionsCommand{
+					PromiseId: "bar",
+					Limit:     1,
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscriptions,
+				ReadSubscriptions: &t_aio.ReadSubscriptionsCommand{
 					PromiseId: "bar",
 					Limit:     1,
 					SortId:    int64ToPointer(4),
@@ -2552,13 +3031,13 @@ var TestCases = []*testCase{
 		},
 	},
 	{
-		name: "CreateNotifications",
+		name: "DeletePromises",
 		commands: []*t_aio.Command{
 			{
 				Kind: t_aio.CreatePromise,
 				CreatePromise: &t_aio.CreatePromiseCommand{
 					Id:      "foo",
-					Timeout: 1,
+					Timeout: 10,
 					Param: promise.Value{
 						Headers: map[string]string{},
 						Data:    []byte{},
@@ -2568,40 +3047,58 @@ var TestCases = []*testCase{
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.CreateSubscriptionCommand{
-					Id:          "a",
-					PromiseId:   "foo",
-					Url:         "https://foo.com/a",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
-					CreatedOn:   1,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "bar",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{"resonate:retention": "5ms"},
+					CreatedOn: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.CreateSubscriptionCommand{
-					Id:          "b",
-					PromiseId:   "foo",
-					Url:         "https://foo.com/b",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 2, Attempts: 2},
-					CreatedOn:   2,
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "baz",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{"resonate:retention": "0ms"},
+					CreatedOn: 1,
 				},
 			},
 			{
 				Kind: t_aio.CreateSubscription,
 				CreateSubscription: &t_aio.CreateSubscriptionCommand{
-					Id:          "c",
-					PromiseId:   "foo",
-					Url:         "https://foo.com/c",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 3, Attempts: 3},
-					CreatedOn:   3,
+					Id:          "a",
+					PromiseId:   "bar",
+					Url:         "https://bar.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					CreatedOn:   1,
 				},
 			},
 			{
 				Kind: t_aio.UpdatePromise,
 				UpdatePromise: &t_aio.UpdatePromiseCommand{
 					Id:    "foo",
-					State: 2,
+					State: promise.Resolved,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "bar",
+					State: promise.Resolved,
 					Value: promise.Value{
 						Headers: map[string]string{},
 						Data:    []byte{},
@@ -2612,14 +3109,70 @@ var TestCases = []*testCase{
 			{
 				Kind: t_aio.CreateNotifications,
 				CreateNotifications: &t_aio.CreateNotificationsCommand{
-					PromiseId: "foo",
+					PromiseId: "bar",
 					Time:      2,
 				},
 			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:  6,
+					Limit: 10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:  7,
+					Limit: 10,
+				},
+			},
+			{
+				Kind: t_aio.DeleteNotification,
+				DeleteNotification: &t_aio.DeleteNotificationCommand{
+					Id:        "a",
+					PromiseId: "bar",
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:  7,
+					Limit: 10,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "bar",
+				},
+			},
 			{
 				Kind: t_aio.ReadNotifications,
 				ReadNotifications: &t_aio.ReadNotificationsCommand{
-					N: 3,
+					N: 10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:      7,
+					Retention: int64ToPointer(6),
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:      8,
+					Retention: int64ToPointer(6),
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "foo",
 				},
 			},
 		},
@@ -2631,14 +3184,14 @@ var TestCases = []*testCase{
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
@@ -2654,77 +3207,108 @@ var TestCases = []*testCase{
 					RowsAffected: 1,
 				},
 			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
 			{
 				Kind: t_aio.CreateNotifications,
 				CreateNotifications: &t_aio.AlterNotificationsResult{
-					RowsAffected: 3,
+					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.ReadNotifications,
-				ReadNotifications: &t_aio.QueryNotificationsResult{
-					RowsReturned: 3,
-					Records: []*notification.NotificationRecord{
-						{
-							Id:          "a",
-							PromiseId:   "foo",
-							Url:         "https://foo.com/a",
-							RetryPolicy: []byte("{\"delay\":1,\"attempts\":1}"),
-							Time:        2,
-							Attempt:     0,
-						},
-						{
-							Id:          "b",
-							PromiseId:   "foo",
-							Url:         "https://foo.com/b",
-							RetryPolicy: []byte("{\"delay\":2,\"attempts\":2}"),
-							Time:        2,
-							Attempt:     0,
-						},
-						{
-							Id:          "c",
-							PromiseId:   "foo",
-							Url:         "https://foo.com/c",
-							RetryPolicy: []byte("{\"delay\":3,\"attempts\":3}"),
-							Time:        2,
-							Attempt:     0,
-						},
-					},
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
 				},
 			},
-		},
-	},
-	{
-		name: "UpdateNotification",
-		commands: []*t_aio.Command{
 			{
-				Kind: t_aio.CreatePromise,
-				CreatePromise: &t_aio.CreatePromiseCommand{
-					Id:      "foo",
-					Timeout: 1,
-					Param: promise.Value{
-						Headers: map[string]string{},
-						Data:    []byte{},
-					},
-					Tags:      map[string]string{},
-					CreatedOn: 1,
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.CreateSubscriptionCommand{
-					Id:          "a",
-					PromiseId:   "foo",
-					Url:         "https://foo.com/a",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
-					CreatedOn:   1,
+				Kind: t_aio.DeleteNotification,
+				DeleteNotification: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.UpdatePromise,
-				UpdatePromise: &t_aio.UpdatePromiseCommand{
-					Id:    "foo",
-					State: 2,
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.QueryNotificationsResult{
+					RowsReturned: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "DeletePromisesLimit",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "bar",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "bar",
+					State: promise.Resolved,
 					Value: promise.Value{
 						Headers: map[string]string{},
 						Data:    []byte{},
@@ -2733,25 +3317,51 @@ var TestCases = []*testCase{
 				},
 			},
 			{
-				Kind: t_aio.CreateNotifications,
-				CreateNotifications: &t_aio.CreateNotificationsCommand{
-					PromiseId: "foo",
-					Time:      2,
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: promise.Resolved,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 1,
 				},
 			},
 			{
-				Kind: t_aio.UpdateNotification,
-				UpdateNotification: &t_aio.UpdateNotificationCommand{
-					Id:        "a",
-					PromiseId: "foo",
-					Time:      4,
-					Attempt:   1,
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:      10,
+					Retention: int64ToPointer(0),
+					Limit:     1,
 				},
 			},
 			{
-				Kind: t_aio.ReadNotifications,
-				ReadNotifications: &t_aio.ReadNotificationsCommand{
-					N: 1,
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "foo",
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:      10,
+					Retention: int64ToPointer(0),
+					Limit:     1,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "bar",
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:      10,
+					Retention: int64ToPointer(0),
+					Limit:     1,
 				},
 			},
 		},
@@ -2763,8 +3373,8 @@ var TestCases = []*testCase{
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
@@ -2775,66 +3385,140 @@ var TestCases = []*testCase{
 				},
 			},
 			{
-				Kind: t_aio.CreateNotifications,
-				CreateNotifications: &t_aio.AlterNotificationsResult{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.UpdateNotification,
-				UpdateNotification: &t_aio.AlterNotificationsResult{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.ReadNotifications,
-				ReadNotifications: &t_aio.QueryNotificationsResult{
-					RowsReturned: 1,
-					Records: []*notification.NotificationRecord{
-						{
-							Id:          "a",
-							PromiseId:   "foo",
-							Url:         "https://foo.com/a",
-							RetryPolicy: []byte("{\"delay\":1,\"attempts\":1}"),
-							Time:        4,
-							Attempt:     1,
-						},
-					},
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
 				},
 			},
 		},
 	},
 	{
-		name: "DeleteNotification",
+		name: "DeletePromisesTimedout",
 		commands: []*t_aio.Command{
 			{
 				Kind: t_aio.CreatePromise,
 				CreatePromise: &t_aio.CreatePromiseCommand{
 					Id:      "foo",
-					Timeout: 1,
+					Timeout: 10,
 					Param: promise.Value{
 						Headers: map[string]string{},
 						Data:    []byte{},
 					},
-					Tags:      map[string]string{},
+					Tags:      map[string]string{"resonate:retention": "5ms"},
 					CreatedOn: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.CreateSubscriptionCommand{
-					Id:          "a",
-					PromiseId:   "foo",
-					Url:         "https://foo.com/a",
-					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
-					CreatedOn:   1,
+				Kind: t_aio.TimeoutPromises,
+				TimeoutPromises: &t_aio.TimeoutPromisesCommand{
+					Time: 12,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:  14,
+					Limit: 10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:  15,
+					Limit: 10,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "foo",
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.TimeoutPromises,
+				TimeoutPromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "DeletePromisesDeadNotifications",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
 				},
 			},
 			{
 				Kind: t_aio.UpdatePromise,
 				UpdatePromise: &t_aio.UpdatePromiseCommand{
 					Id:    "foo",
-					State: 2,
+					State: promise.Resolved,
 					Value: promise.Value{
 						Headers: map[string]string{},
 						Data:    []byte{},
@@ -2843,23 +3527,46 @@ var TestCases = []*testCase{
 				},
 			},
 			{
-				Kind: t_aio.CreateNotifications,
-				CreateNotifications: &t_aio.CreateNotificationsCommand{
-					PromiseId: "foo",
-					Time:      2,
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					Attempts:    2,
+					StatusCode:  intToPointer(500),
+					CreatedOn:   3,
 				},
 			},
 			{
-				Kind: t_aio.DeleteNotification,
-				DeleteNotification: &t_aio.DeleteNotificationCommand{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.ReadExpiredPromisesCommand{
+					Time:      10,
+					Retention: int64ToPointer(0),
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:      10,
+					Retention: int64ToPointer(0),
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.DeleteDeadNotificationCommand{
 					Id:        "a",
 					PromiseId: "foo",
 				},
 			},
 			{
-				Kind: t_aio.ReadNotifications,
-				ReadNotifications: &t_aio.ReadNotificationsCommand{
-					N: 1,
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.DeletePromisesCommand{
+					Time:      10,
+					Retention: int64ToPointer(0),
+					Limit:     10,
 				},
 			},
 		},
@@ -2871,32 +3578,1782 @@ var TestCases = []*testCase{
 				},
 			},
 			{
-				Kind: t_aio.CreateSubscription,
-				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.UpdatePromise,
-				UpdatePromise: &t_aio.AlterPromisesResult{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.CreateNotifications,
-				CreateNotifications: &t_aio.AlterNotificationsResult{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
 					RowsAffected: 1,
 				},
 			},
 			{
-				Kind: t_aio.DeleteNotification,
-				DeleteNotification: &t_aio.AlterNotificationsResult{
+				Kind: t_aio.DeletePromises,
+				DeletePromises: &t_aio.AlterPromisesResult{
 					RowsAffected: 1,
 				},
 			},
+		},
+	},
+	{
+		name: "DeletePromiseNotifications",
+		commands: []*t_aio.Command{
 			{
-				Kind: t_aio.ReadNotifications,
-				ReadNotifications: &t_aio.QueryNotificationsResult{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					CreatedOn:   1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: promise.Resolved,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.CreateNotificationsCommand{
+					PromiseId: "foo",
+					Time:      2,
+				},
+			},
+			{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.ReadExpiredPromisesCommand{
+					Time:      10,
+					Retention: int64ToPointer(0),
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "foo",
+				},
+			},
+			{
+				Kind: t_aio.DeleteNotification,
+				DeleteNotification: &t_aio.DeleteNotificationCommand{
+					Id:        "a",
+					PromiseId: "foo",
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "foo",
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.ReadSubscriptionCommand{
+					Id:        "a",
+					PromiseId: "foo",
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeleteNotification,
+				DeleteNotification: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.QuerySubscriptionsResult{
+					RowsReturned: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "ReadExpiredPromisesAndDeletePromise",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "bar",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{"resonate:retention": "5ms"},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "baz",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: promise.Resolved,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "bar",
+					State: promise.Resolved,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.ReadExpiredPromisesCommand{
+					Time:      7,
+					Retention: int64ToPointer(6),
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "baz",
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "bar",
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "bar",
+				},
+			},
+			{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.ReadExpiredPromisesCommand{
+					Time:      8,
+					Retention: int64ToPointer(6),
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "bar",
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 1,
+					LastSortId:   2,
+					Records: []*promise.PromiseRecord{{
+						Id:           "bar",
+						State:        2,
+						ParamHeaders: []byte("{}"),
+						ParamData:    []byte{},
+						ValueHeaders: []byte("{}"),
+						ValueData:    []byte{},
+						Timeout:      10,
+						Tags:         []byte(`{"resonate:retention":"5ms"}`),
+						CreatedOn:    int64ToPointer(1),
+						CompletedOn:  int64ToPointer(2),
+						SortId:       2,
+					}},
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+			{
+				Kind: t_aio.ReadExpiredPromises,
+				ReadExpiredPromises: &t_aio.QueryPromisesResult{
+					RowsReturned: 1,
+					LastSortId:   1,
+					Records: []*promise.PromiseRecord{{
+						Id:           "foo",
+						State:        2,
+						ParamHeaders: []byte("{}"),
+						ParamData:    []byte{},
+						ValueHeaders: []byte("{}"),
+						ValueData:    []byte{},
+						Timeout:      10,
+						Tags:         []byte("{}"),
+						CreatedOn:    int64ToPointer(1),
+						CompletedOn:  int64ToPointer(2),
+						SortId:       1,
+					}},
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "ReadPromisesByIdempotencyKey",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					IdempotencyKey: idempotencyKeyToPointer("a"),
+					Tags:           map[string]string{},
+					CreatedOn:      1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "bar",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					IdempotencyKey: idempotencyKeyToPointer("b"),
+					Tags:           map[string]string{},
+					CreatedOn:      1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "baz",
+					Timeout: 10,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					IdempotencyKey: idempotencyKeyToPointer("a"),
+					Tags:           map[string]string{},
+					CreatedOn:      1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "bar",
+					State: promise.Resolved,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					IdempotencyKey: idempotencyKeyToPointer("a"),
+					CompletedOn:    2,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromisesByIdempotencyKey,
+				ReadPromisesByIdempotencyKey: &t_aio.ReadPromisesByIdempotencyKeyCommand{
+					IdempotencyKey: "a",
+					Limit:          2,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromisesByIdempotencyKey,
+				ReadPromisesByIdempotencyKey: &t_aio.ReadPromisesByIdempotencyKeyCommand{
+					IdempotencyKey: "a",
+					Limit:          2,
+					SortId:         int64ToPointer(2),
+				},
+			},
+			{
+				Kind: t_aio.ReadPromisesByIdempotencyKey,
+				ReadPromisesByIdempotencyKey: &t_aio.ReadPromisesByIdempotencyKeyCommand{
+					IdempotencyKey: "c",
+					Limit:          2,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadPromisesByIdempotencyKey,
+				ReadPromisesByIdempotencyKey: &t_aio.QueryPromisesResult{
+					RowsReturned: 2,
+					LastSortId:   2,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:                      "baz",
+							State:                   1,
+							ParamHeaders:            []byte("{}"),
+							ParamData:               []byte{},
+							Timeout:                 10,
+							IdempotencyKeyForCreate: idempotencyKeyToPointer("a"),
+							Tags:                    []byte("{}"),
+							CreatedOn:               int64ToPointer(1),
+							SortId:                  3,
+						},
+						{
+							Id:                        "bar",
+							State:                     2,
+							ParamHeaders:              []byte("{}"),
+							ParamData:                 []byte{},
+							ValueHeaders:              []byte("{}"),
+							ValueData:                 []byte{},
+							Timeout:                   10,
+							IdempotencyKeyForCreate:   idempotencyKeyToPointer("b"),
+							IdempotencyKeyForComplete: idempotencyKeyToPointer("a"),
+							Tags:                      []byte("{}"),
+							CreatedOn:                 int64ToPointer(1),
+							CompletedOn:               int64ToPointer(2),
+							SortId:                    2,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadPromisesByIdempotencyKey,
+				ReadPromisesByIdempotencyKey: &t_aio.QueryPromisesResult{
+					RowsReturned: 1,
+					LastSortId:   1,
+					Records: []*promise.PromiseRecord{
+						{
+							Id:                      "foo",
+							State:                   1,
+							ParamHeaders:            []byte("{}"),
+							ParamData:               []byte{},
+							Timeout:                 10,
+							IdempotencyKeyForCreate: idempotencyKeyToPointer("a"),
+							Tags:                    []byte("{}"),
+							CreatedOn:               int64ToPointer(1),
+							SortId:                  1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadPromisesByIdempotencyKey,
+				ReadPromisesByIdempotencyKey: &t_aio.QueryPromisesResult{
+					RowsReturned: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "CreateNotification",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreateNotification,
+				CreateNotification: &t_aio.CreateNotificationCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 3},
+					Time:        5,
+					Attempt:     2,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotification,
+				CreateNotification: &t_aio.CreateNotificationCommand{
+					Id:          "a",
+					PromiseId:   "bar",
+					Url:         "https://bar.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 3},
+					Time:        3,
+					Attempt:     0,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotification,
+				CreateNotification: &t_aio.CreateNotificationCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 3},
+					Time:        1,
+					Attempt:     0,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.ReadNotificationsCommand{
+					N: 10,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreateNotification,
+				CreateNotification: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotification,
+				CreateNotification: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotification,
+				CreateNotification: &t_aio.AlterNotificationsResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.QueryNotificationsResult{
+					RowsReturned: 2,
+					Records: []*notification.NotificationRecord{
+						{
+							Id:          "a",
+							PromiseId:   "bar",
+							Url:         "https://bar.com/a",
+							RetryPolicy: []byte("{\"delay\":1,\"attempts\":3}"),
+							Time:        3,
+							Attempt:     0,
+						},
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							RetryPolicy: []byte("{\"delay\":1,\"attempts\":3}"),
+							Time:        5,
+							Attempt:     2,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		name: "CreateSubscriptionWithDelivery",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					Method:      "PUT",
+					Headers:     map[string]string{"Authorization": "Bearer a"},
+					Payload:     &subscription.Payload{ExcludeParamData: true, ExcludeValueData: true},
+					Secret:      "whsec_a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					CreatedOn:   1,
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.ReadSubscriptionCommand{
+					Id:        "a",
+					PromiseId: "foo",
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: 2,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.CreateNotificationsCommand{
+					PromiseId: "foo",
+					Time:      2,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.ReadNotificationsCommand{
+					N: 1,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadSubscription,
+				ReadSubscription: &t_aio.QuerySubscriptionsResult{
+					RowsReturned: 1,
+					Records: []*subscription.SubscriptionRecord{
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							Method:      "PUT",
+							Headers:     []byte(`{"Authorization":"Bearer a"}`),
+							Payload:     []byte(`{"excludeParamData":true,"excludeValueData":true}`),
+							Secret:      "whsec_a",
+							RetryPolicy: []byte(`{"delay":1,"attempts":1}`),
+							CreatedOn:   1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.QueryNotificationsResult{
+					RowsReturned: 1,
+					Records: []*notification.NotificationRecord{
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							Method:      "PUT",
+							Headers:     []byte(`{"Authorization":"Bearer a"}`),
+							Payload:     []byte(`{"excludeParamData":true,"excludeValueData":true}`),
+							Secret:      "whsec_a",
+							RetryPolicy: []byte(`{"delay":1,"attempts":1}`),
+							Time:        2,
+							Attempt:     0,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		name: "CreateNotifications",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					CreatedOn:   1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "b",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/b",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 2, Attempts: 2},
+					CreatedOn:   2,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "c",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/c",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 3, Attempts: 3},
+					CreatedOn:   3,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: 2,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.CreateNotificationsCommand{
+					PromiseId: "foo",
+					Time:      2,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.ReadNotificationsCommand{
+					N: 3,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.AlterNotificationsResult{
+					RowsAffected: 3,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.QueryNotificationsResult{
+					RowsReturned: 3,
+					Records: []*notification.NotificationRecord{
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							RetryPolicy: []byte("{\"delay\":1,\"attempts\":1}"),
+							Time:        2,
+							Attempt:     0,
+						},
+						{
+							Id:          "b",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/b",
+							RetryPolicy: []byte("{\"delay\":2,\"attempts\":2}"),
+							Time:        2,
+							Attempt:     0,
+						},
+						{
+							Id:          "c",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/c",
+							RetryPolicy: []byte("{\"delay\":3,\"attempts\":3}"),
+							Time:        2,
+							Attempt:     0,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		name: "UpdateNotification",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					CreatedOn:   1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: 2,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.CreateNotificationsCommand{
+					PromiseId: "foo",
+					Time:      2,
+				},
+			},
+			{
+				Kind: t_aio.UpdateNotification,
+				UpdateNotification: &t_aio.UpdateNotificationCommand{
+					Id:        "a",
+					PromiseId: "foo",
+					Time:      4,
+					Attempt:   1,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.ReadNotificationsCommand{
+					N: 1,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdateNotification,
+				UpdateNotification: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.QueryNotificationsResult{
+					RowsReturned: 1,
+					Records: []*notification.NotificationRecord{
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							RetryPolicy: []byte("{\"delay\":1,\"attempts\":1}"),
+							Time:        4,
+							Attempt:     1,
+						},
+					},
+				},
+			},
+		},
+	},
+	{
+		name: "DeleteNotification",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					CreatedOn:   1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: 2,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.CreateNotificationsCommand{
+					PromiseId: "foo",
+					Time:      2,
+				},
+			},
+			{
+				Kind: t_aio.DeleteNotification,
+				DeleteNotification: &t_aio.DeleteNotificationCommand{
+					Id:        "a",
+					PromiseId: "foo",
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.ReadNotificationsCommand{
+					N: 1,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.AlterSubscriptionsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotifications,
+				CreateNotifications: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.DeleteNotification,
+				DeleteNotification: &t_aio.AlterNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotifications,
+				ReadNotifications: &t_aio.QueryNotificationsResult{
+					RowsReturned: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "DeadNotifications",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: 2,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					Method:      "PUT",
+					Headers:     map[string]string{"Authorization": "Bearer a"},
+					Payload:     &subscription.Payload{ExcludeParamData: true},
+					Secret:      "whsec_a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					Attempts:    2,
+					StatusCode:  intToPointer(500),
+					CreatedOn:   3,
+				},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+					Id:          "b",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/b",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 0},
+					Attempts:    1,
+					Error:       stringToPointer("connection refused"),
+					CreatedOn:   4,
+				},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+					Id:          "a",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/a",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+					Attempts:    2,
+					CreatedOn:   5,
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotification,
+				ReadDeadNotification: &t_aio.ReadDeadNotificationCommand{
+					Id:        "a",
+					PromiseId: "foo",
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotifications,
+				ReadDeadNotifications: &t_aio.ReadDeadNotificationsCommand{
+					Limit: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotifications,
+				ReadDeadNotifications: &t_aio.ReadDeadNotificationsCommand{
+					Limit:  1,
+					SortId: int64ToPointer(2),
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.DeleteDeadNotificationCommand{
+					Id:        "a",
+					PromiseId: "foo",
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.DeleteDeadNotificationCommand{
+					Id:        "a",
+					PromiseId: "foo",
+				},
+			},
+			{
+				Kind:                    t_aio.DeleteDeadNotifications,
+				DeleteDeadNotifications: &t_aio.DeleteDeadNotificationsCommand{},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+					Id:          "c",
+					PromiseId:   "foo",
+					Url:         "https://foo.com/c",
+					RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 0},
+					Attempts:    1,
+					CreatedOn:   6,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "foo",
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.DeleteDeadNotificationCommand{
+					Id:        "c",
+					PromiseId: "foo",
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "foo",
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotifications,
+				ReadDeadNotifications: &t_aio.ReadDeadNotificationsCommand{
+					Limit: 10,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotification,
+				ReadDeadNotification: &t_aio.QueryDeadNotificationsResult{
+					RowsReturned: 1,
+					Records: []*notification.DeadNotificationRecord{
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							Method:      "PUT",
+							Headers:     []byte(`{"Authorization":"Bearer a"}`),
+							Payload:     []byte(`{"excludeParamData":true}`),
+							Secret:      "whsec_a",
+							RetryPolicy: []byte(`{"delay":1,"attempts":1}`),
+							Attempts:    2,
+							StatusCode:  intToPointer(500),
+							CreatedOn:   3,
+							SortId:      1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotifications,
+				ReadDeadNotifications: &t_aio.QueryDeadNotificationsResult{
+					RowsReturned: 1,
+					LastSortId:   2,
+					Records: []*notification.DeadNotificationRecord{
+						{
+							Id:          "b",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/b",
+							RetryPolicy: []byte(`{"delay":1,"attempts":0}`),
+							Attempts:    1,
+							Error:       stringToPointer("connection refused"),
+							CreatedOn:   4,
+							SortId:      2,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotifications,
+				ReadDeadNotifications: &t_aio.QueryDeadNotificationsResult{
+					RowsReturned: 1,
+					LastSortId:   1,
+					Records: []*notification.DeadNotificationRecord{
+						{
+							Id:          "a",
+							PromiseId:   "foo",
+							Url:         "https://foo.com/a",
+							Method:      "PUT",
+							Headers:     []byte(`{"Authorization":"Bearer a"}`),
+							Payload:     []byte(`{"excludeParamData":true}`),
+							Secret:      "whsec_a",
+							RetryPolicy: []byte(`{"delay":1,"attempts":1}`),
+							Attempts:    2,
+							StatusCode:  intToPointer(500),
+							CreatedOn:   3,
+							SortId:      1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotifications,
+				DeleteDeadNotifications: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.DeleteDeadNotification,
+				DeleteDeadNotification: &t_aio.AlterDeadNotificationsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadDeadNotifications,
+				ReadDeadNotifications: &t_aio.QueryDeadNotificationsResult{
+					RowsReturned: 0,
+				},
+			},
+		},
+	},
+	{
+		name: "NotificationAttempts",
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "foo",
+					State: 2,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 2,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotificationAttempt,
+				CreateNotificationAttempt: &t_aio.CreateNotificationAttemptCommand{
+					Id:         "a",
+					PromiseId:  "foo",
+					Attempt:    1,
+					Url:        "https://foo.com/a",
+					StatusCode: intToPointer(500),
+					Latency:    10,
+					Body:       []byte("error"),
+					Time:       3,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotificationAttempt,
+				CreateNotificationAttempt: &t_aio.CreateNotificationAttemptCommand{
+					Id:        "b",
+					PromiseId: "foo",
+					Attempt:   1,
+					Url:       "https://foo.com/b",
+					Latency:   20,
+					Error:     stringToPointer("connection refused"),
+					Time:      4,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotificationAttempt,
+				CreateNotificationAttempt: &t_aio.CreateNotificationAttemptCommand{
+					Id:         "a",
+					PromiseId:  "foo",
+					Attempt:    2,
+					Url:        "https://foo.com/a",
+					StatusCode: intToPointer(200),
+					Latency:    30,
+					Body:       []byte("ok"),
+					Time:       5,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.ReadNotificationAttemptsCommand{
+					Id:        "a",
+					PromiseId: "foo",
+					Limit:     1,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.ReadNotificationAttemptsCommand{
+					Id:        "a",
+					PromiseId: "foo",
+					Limit:     1,
+					SortId:    int64ToPointer(3),
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.ReadNotificationAttemptsCommand{
+					Id:        "b",
+					PromiseId: "foo",
+					Limit:     10,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.ReadNotificationAttemptsCommand{
+					PromiseId: "foo",
+					Limit:     2,
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.DeletePromiseCommand{
+					Id: "foo",
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.ReadNotificationAttemptsCommand{
+					Id:        "a",
+					PromiseId: "foo",
+					Limit:     10,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotificationAttempt,
+				CreateNotificationAttempt: &t_aio.AlterNotificationAttemptsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotificationAttempt,
+				CreateNotificationAttempt: &t_aio.AlterNotificationAttemptsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreateNotificationAttempt,
+				CreateNotificationAttempt: &t_aio.AlterNotificationAttemptsResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+					RowsReturned: 1,
+					LastSortId:   3,
+					Records: []*notification.NotificationAttemptRecord{
+						{
+							Id:         "a",
+							PromiseId:  "foo",
+							Attempt:    2,
+							Url:        "https://foo.com/a",
+							StatusCode: intToPointer(200),
+							Latency:    30,
+							Body:       []byte("ok"),
+							Time:       5,
+							SortId:     3,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+					RowsReturned: 1,
+					LastSortId:   1,
+					Records: []*notification.NotificationAttemptRecord{
+						{
+							Id:         "a",
+							PromiseId:  "foo",
+							Attempt:    1,
+							Url:        "https://foo.com/a",
+							StatusCode: intToPointer(500),
+							Latency:    10,
+							Body:       []byte("error"),
+							Time:       3,
+							SortId:     1,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+					RowsReturned: 1,
+					LastSortId:   2,
+					Records: []*notification.NotificationAttemptRecord{
+						{
+							Id:        "b",
+							PromiseId: "foo",
+							Attempt:   1,
+							Url:       "https://foo.com/b",
+							Latency:   20,
+							Error:     stringToPointer("connection refused"),
+							Time:      4,
+							SortId:    2,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+					RowsReturned: 2,
+					LastSortId:   2,
+					Records: []*notification.NotificationAttemptRecord{
+						{
+							Id:         "a",
+							PromiseId:  "foo",
+							Attempt:    2,
+							Url:        "https://foo.com/a",
+							StatusCode: intToPointer(200),
+							Latency:    30,
+							Body:       []byte("ok"),
+							Time:       5,
+							SortId:     3,
+						},
+						{
+							Id:        "b",
+							PromiseId: "foo",
+							Attempt:   1,
+							Url:       "https://foo.com/b",
+							Latency:   20,
+							Error:     stringToPointer("connection refused"),
+							Time:      4,
+							SortId:    2,
+						},
+					},
+				},
+			},
+			{
+				Kind: t_aio.DeletePromise,
+				DeletePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.ReadNotificationAttempts,
+				ReadNotificationAttempts: &t_aio.QueryNotificationAttemptsResult{
+					RowsReturned: 0,
+				},
+			},
+		},
+	},
+	{
+		name:   "AtomicTransaction",
+		atomic: true,
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "bar",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 1,
+				},
+			},
+		},
+		next: []*t_aio.Command{
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "foo",
+				},
+			},
+		},
+		nextExpected: []*t_aio.Result{
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
+					RowsReturned: 1,
+					Records: []*promise.PromiseRecord{{
+						Id:           "foo",
+						State:        1,
+						ParamHeaders: []byte("{}"),
+						ParamData:    []byte{},
+						Timeout:      1,
+						Tags:         []byte("{}"),
+						CreatedOn:    int64ToPointer(1),
+					}},
+				},
+			},
+		},
+	},
+	{
+		name:   "AtomicTransactionRollback",
+		atomic: true,
+		commands: []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:      "foo",
+					Timeout: 1,
+					Param: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					Tags:      map[string]string{},
+					CreatedOn: 1,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:    "bar",
+					State: 2,
+					Value: promise.Value{
+						Headers: map[string]string{},
+						Data:    []byte{},
+					},
+					CompletedOn: 1,
+				},
+			},
+		},
+		expected: []*t_aio.Result{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+			{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.AlterPromisesResult{
+					RowsAffected: 0,
+				},
+			},
+		},
+		next: []*t_aio.Command{
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.ReadPromiseCommand{
+					Id: "foo",
+				},
+			},
+		},
+		nextExpected: []*t_aio.Result{
+			{
+				Kind: t_aio.ReadPromise,
+				ReadPromise: &t_aio.QueryPromisesResult{
 					RowsReturned: 0,
 				},
 			},
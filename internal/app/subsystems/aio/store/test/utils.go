@@ -10,3 +10,11 @@ func idempotencyKeyToPointer(s string) *promise.IdempotencyKey {
 func int64ToPointer(i int64) *int64 {
 	return &i
 }
+
+func intToPointer(i int) *int {
+	return &i
+}
+
+func stringToPointer(s string) *string {
+	return &s
+}
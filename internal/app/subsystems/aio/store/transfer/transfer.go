@@ -0,0 +1,631 @@
+package transfer
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/util"
+	"github.com/resonatehq/resonate/pkg/notification"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/subscription"
+)
+
+type Kind string
+
+const (
+	Promise             Kind = "promise"
+	Subscription        Kind = "subscription"
+	Notification        Kind = "notification"
+	DeadNotification    Kind = "dead_notification"
+	NotificationAttempt Kind = "notification_attempt"
+)
+
+// Line is a single line of an export, promises are written in sort id
+// order each followed by its subscriptions and then the delivery
+// attempts of its notifications in sort id order, pending notifications
+// and then dead notifications in sort id order are written last. Sort
+// ids are not preserved by an import, but the order of all rows is.
+type Line struct {
+	Kind                Kind                              `json:"kind"`
+	SortId              int64                             `json:"sortId,omitempty"`
+	Promise             *promise.Promise                  `json:"promise,omitempty"`
+	Subscription        *subscription.Subscription        `json:"subscription,omitempty"`
+	Notification        *notification.Notification        `json:"notification,omitempty"`
+	DeadNotification    *notification.DeadNotification    `json:"deadNotification,omitempty"`
+	NotificationAttempt *notification.NotificationAttempt `json:"notificationAttempt,omitempty"`
+
+	// Secret is the secret of a dead notification, the secret is write
+	// only and not part of a dead notification when serialized.
+	Secret string `json:"secret,omitempty"`
+}
+
+type Stats struct {
+	Promises             int64
+	Subscriptions        int64
+	Notifications        int64
+	DeadNotifications    int64
+	NotificationAttempts int64
+}
+
+func (s *Stats) String() string {
+	return fmt.Sprintf(
+		"promises=%d, subscriptions=%d, notifications=%d, deadNotifications=%d, notificationAttempts=%d",
+		s.Promises,
+		s.Subscriptions,
+		s.Notifications,
+		s.DeadNotifications,
+		s.NotificationAttempts,
+	)
+}
+
+// Export writes all promises, subscriptions, pending notifications,
+// dead notifications, and notification attempts of a store to w as
+// newline delimited json. Rows are read in batches, the store should
+// not be written to during an export.
+func Export(store aio.Worker, w io.Writer, batchSize int) (*Stats, error) {
+	util.Assert(batchSize > 0, "batch size must be greater than zero")
+
+	stats := &Stats{}
+	enc := json.NewEncoder(w)
+
+	var sortId *int64
+
+	for {
+		results, err := execute(store, &t_aio.Command{
+			Kind: t_aio.SearchPromises,
+			SearchPromises: &t_aio.SearchPromisesCommand{
+				Q:         "*",
+				States:    []promise.State{promise.Pending, promise.Resolved, promise.Rejected, promise.Timedout, promise.Canceled},
+				SortBy:    promise.SortBySortId,
+				SortOrder: promise.Ascending,
+				Limit:     batchSize,
+				SortId:    sortId,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		records := results[0].SearchPromises.Records
+		if len(records) == 0 {
+			break
+		}
+
+		for _, record := range records {
+			p, err := record.Promise()
+			if err != nil {
+				return nil, err
+			}
+
+			if err := enc.Encode(&Line{Kind: Promise, SortId: record.SortId, Promise: p}); err != nil {
+				return nil, err
+			}
+			stats.Promises++
+
+			subscriptions, err := readSubscriptions(store, record.Id, batchSize)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, record := range subscriptions {
+				s, err := record.Subscription()
+				if err != nil {
+					return nil, err
+				}
+
+				if err := enc.Encode(&Line{Kind: Subscription, SortId: record.SortId, Subscription: s}); err != nil {
+					return nil, err
+				}
+				stats.Subscriptions++
+			}
+
+			attempts, err := readNotificationAttempts(store, record.Id, batchSize)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, record := range attempts {
+				if err := enc.Encode(&Line{Kind: NotificationAttempt, SortId: record.SortId, NotificationAttempt: record.NotificationAttempt()}); err != nil {
+					return nil, err
+				}
+				stats.NotificationAttempts++
+			}
+		}
+
+		sortId = &records[len(records)-1].SortId
+	}
+
+	// notifications are bounded by the number of in flight deliveries
+	// and can not be paginated, read them all at once
+	results, err := execute(store, &t_aio.Command{
+		Kind: t_aio.ReadNotifications,
+		ReadNotifications: &t_aio.ReadNotificationsCommand{
+			N: math.MaxInt32,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range results[0].ReadNotifications.Records {
+		n, err := record.Notification()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := enc.Encode(&Line{Kind: Notification, Notification: n}); err != nil {
+			return nil, err
+		}
+		stats.Notifications++
+	}
+
+	dead, err := readDeadNotifications(store, batchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, record := range dead {
+		n, err := record.DeadNotification()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := enc.Encode(&Line{Kind: DeadNotification, SortId: record.SortId, DeadNotification: n, Secret: n.Secret}); err != nil {
+			return nil, err
+		}
+		stats.DeadNotifications++
+	}
+
+	return stats, nil
+}
+
+// readSubscriptions returns all subscriptions of a promise in ascending
+// sort id order.
+func readSubscriptions(store aio.Worker, promiseId string, batchSize int) ([]*subscription.SubscriptionRecord, error) {
+	var records []*subscription.SubscriptionRecord
+	var sortId *int64
+
+	for {
+		results, err := execute(store, &t_aio.Command{
+			Kind: t_aio.ReadSubscriptions,
+			ReadSubscriptions: &t_aio.ReadSubscriptionsCommand{
+				PromiseId: promiseId,
+				Limit:     batchSize,
+				SortId:    sortId,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result := results[0].ReadSubscriptions
+		records = append(records, result.Records...)
+
+		if result.RowsReturned < int64(batchSize) {
+			break
+		}
+
+		sortId = &result.LastSortId
+	}
+
+	// subscriptions are read in descending order
+	slices.Reverse(records)
+	return records, nil
+}
+
+// readNotificationAttempts returns the attempts of all notifications of
+// a promise in ascending sort id order.
+func readNotificationAttempts(store aio.Worker, promiseId string, batchSize int) ([]*notification.NotificationAttemptRecord, error) {
+	var records []*notification.NotificationAttemptRecord
+	var sortId *int64
+
+	for {
+		results, err := execute(store, &t_aio.Command{
+			Kind: t_aio.ReadNotificationAttempts,
+			ReadNotificationAttempts: &t_aio.ReadNotificationAttemptsCommand{
+				PromiseId: promiseId,
+				Limit:     batchSize,
+				SortId:    sortId,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result := results[0].ReadNotificationAttempts
+		records = append(records, result.Records...)
+
+		if result.RowsReturned < int64(batchSize) {
+			break
+		}
+
+		sortId = &result.LastSortId
+	}
+
+	// attempts are read in descending order
+	slices.Reverse(records)
+	return records, nil
+}
+
+// readDeadNotifications returns all dead notifications in ascending
+// sort id order.
+func readDeadNotifications(store aio.Worker, batchSize int) ([]*notification.DeadNotificationRecord, error) {
+	var records []*notification.DeadNotificationRecord
+	var sortId *int64
+
+	for {
+		results, err := execute(store, &t_aio.Command{
+			Kind: t_aio.ReadDeadNotifications,
+			ReadDeadNotifications: &t_aio.ReadDeadNotificationsCommand{
+				Limit:  batchSize,
+				SortId: sortId,
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result := results[0].ReadDeadNotifications
+		records = append(records, result.Records...)
+
+		if result.RowsReturned < int64(batchSize) {
+			break
+		}
+
+		sortId = &result.LastSortId
+	}
+
+	// dead notifications are read in descending order
+	slices.Reverse(records)
+	return records, nil
+}
+
+// Import reads an export from r and writes it to a store, the store
+// must not contain any promises. Lines are written in batches, each
+// line in its own transaction, and the import stops on the first line
+// that fails or conflicts with an existing row.
+func Import(store aio.Worker, r io.Reader, batchSize int) (*Stats, error) {
+	util.Assert(batchSize > 0, "batch size must be greater than zero")
+
+	results, err := execute(store, &t_aio.Command{
+		Kind: t_aio.SearchPromises,
+		SearchPromises: &t_aio.SearchPromisesCommand{
+			Q:      "*",
+			States: []promise.State{promise.Pending, promise.Resolved, promise.Rejected, promise.Timedout, promise.Canceled},
+			Limit:  1,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if results[0].SearchPromises.RowsReturned > 0 {
+		return nil, fmt.Errorf("store must be empty")
+	}
+
+	stats := &Stats{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, math.MaxInt32)
+
+	var lines []*Line
+	var sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]
+	var n int
+
+	flush := func() error {
+		if len(sqes) == 0 {
+			return nil
+		}
+
+		for i, cqe := range store.Process(sqes) {
+			line := n - len(sqes) + i + 1
+
+			if cqe.Error != nil {
+				return fmt.Errorf("line %d: %w", line, cqe.Error)
+			}
+
+			for _, result := range cqe.Completion.Store.Results {
+				if rowsAffected(result) != 1 {
+					return fmt.Errorf("line %d: %s %s already exists", line, lines[i].Kind, id(lines[i]))
+				}
+			}
+
+			switch lines[i].Kind {
+			case Promise:
+				stats.Promises++
+			case Subscription:
+				stats.Subscriptions++
+			case Notification:
+				stats.Notifications++
+			case DeadNotification:
+				stats.DeadNotifications++
+			case NotificationAttempt:
+				stats.NotificationAttempts++
+			}
+		}
+
+		lines, sqes = nil, nil
+		return nil
+	}
+
+	for scanner.Scan() {
+		n++
+
+		line := &Line{}
+		if err := json.Unmarshal(scanner.Bytes(), line); err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		commands, err := toCommands(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		lines = append(lines, line)
+		sqes = append(sqes, &bus.SQE[t_aio.Submission, t_aio.Completion]{
+			Submission: &t_aio.Submission{
+				Kind: t_aio.Store,
+				Store: &t_aio.StoreSubmission{
+					Transaction: &t_aio.Transaction{
+						Commands: commands,
+					},
+				},
+			},
+		})
+
+		if len(sqes) == batchSize {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := flush(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// toCommands returns the commands that recreate a line, a completed
+// promise is created and then completed with its original value.
+func toCommands(line *Line) ([]*t_aio.Command, error) {
+	switch line.Kind {
+	case Promise:
+		p := line.Promise
+		if p == nil {
+			return nil, fmt.Errorf("promise must not be empty")
+		}
+		if p.CreatedOn == nil {
+			return nil, fmt.Errorf("promise %s must have a created on timestamp", p.Id)
+		}
+
+		commands := []*t_aio.Command{
+			{
+				Kind: t_aio.CreatePromise,
+				CreatePromise: &t_aio.CreatePromiseCommand{
+					Id:             p.Id,
+					Param:          value(p.Param),
+					Timeout:        p.Timeout,
+					IdempotencyKey: p.IdempotencyKeyForCreate,
+					Tags:           tags(p.Tags),
+					CreatedOn:      *p.CreatedOn,
+				},
+			},
+		}
+
+		if p.State != promise.Pending {
+			if p.CompletedOn == nil {
+				return nil, fmt.Errorf("promise %s must have a completed on timestamp", p.Id)
+			}
+
+			commands = append(commands, &t_aio.Command{
+				Kind: t_aio.UpdatePromise,
+				UpdatePromise: &t_aio.UpdatePromiseCommand{
+					Id:             p.Id,
+					State:          p.State,
+					Value:          value(p.Value),
+					IdempotencyKey: p.IdempotencyKeyForComplete,
+					CompletedOn:    *p.CompletedOn,
+				},
+			})
+		}
+
+		return commands, nil
+
+	case Subscription:
+		s := line.Subscription
+		if s == nil {
+			return nil, fmt.Errorf("subscription must not be empty")
+		}
+		if s.RetryPolicy == nil {
+			return nil, fmt.Errorf("subscription %s must have a retry policy", s.Id)
+		}
+
+		return []*t_aio.Command{
+			{
+				Kind: t_aio.CreateSubscription,
+				CreateSubscription: &t_aio.CreateSubscriptionCommand{
+					Id:          s.Id,
+					PromiseId:   s.PromiseId,
+					Url:         s.Url,
+					Method:      s.Method,
+					Headers:     s.Headers,
+					Payload:     s.Payload,
+					Secret:      s.Secret,
+					RetryPolicy: s.RetryPolicy,
+					CreatedOn:   s.CreatedOn,
+				},
+			},
+		}, nil
+
+	case Notification:
+		n := line.Notification
+		if n == nil {
+			return nil, fmt.Errorf("notification must not be empty")
+		}
+		if n.RetryPolicy == nil {
+			return nil, fmt.Errorf("notification %s must have a retry policy", n.Id)
+		}
+
+		return []*t_aio.Command{
+			{
+				Kind: t_aio.CreateNotification,
+				CreateNotification: &t_aio.CreateNotificationCommand{
+					Id:          n.Id,
+					PromiseId:   n.PromiseId,
+					Url:         n.Url,
+					Method:      n.Method,
+					Headers:     n.Headers,
+					Payload:     n.Payload,
+					Secret:      n.Secret,
+					RetryPolicy: n.RetryPolicy,
+					Time:        n.Time,
+					Attempt:     n.Attempt,
+				},
+			},
+		}, nil
+
+	case DeadNotification:
+		n := line.DeadNotification
+		if n == nil {
+			return nil, fmt.Errorf("dead notification must not be empty")
+		}
+		if n.RetryPolicy == nil {
+			return nil, fmt.Errorf("dead notification %s must have a retry policy", n.Id)
+		}
+
+		return []*t_aio.Command{
+			{
+				Kind: t_aio.CreateDeadNotification,
+				CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+					Id:          n.Id,
+					PromiseId:   n.PromiseId,
+					Url:         n.Url,
+					Method:      n.Method,
+					Headers:     n.Headers,
+					Payload:     n.Payload,
+					Secret:      line.Secret,
+					RetryPolicy: n.RetryPolicy,
+					Attempts:    n.Attempts,
+					StatusCode:  n.StatusCode,
+					Error:       n.Error,
+					CreatedOn:   n.CreatedOn,
+				},
+			},
+		}, nil
+
+	case NotificationAttempt:
+		a := line.NotificationAttempt
+		if a == nil {
+			return nil, fmt.Errorf("notification attempt must not be empty")
+		}
+
+		return []*t_aio.Command{
+			{
+				Kind: t_aio.CreateNotificationAttempt,
+				CreateNotificationAttempt: &t_aio.CreateNotificationAttemptCommand{
+					Id:         a.Id,
+					PromiseId:  a.PromiseId,
+					Attempt:    a.Attempt,
+					Url:        a.Url,
+					StatusCode: a.StatusCode,
+					Latency:    a.Latency,
+					Body:       []byte(a.Body),
+					Error:      a.Error,
+					Time:       a.Time,
+				},
+			},
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported kind %q", line.Kind)
+	}
+}
+
+func execute(store aio.Worker, commands ...*t_aio.Command) ([]*t_aio.Result, error) {
+	cqes := store.Process([]*bus.SQE[t_aio.Submission, t_aio.Completion]{
+		{
+			Submission: &t_aio.Submission{
+				Kind: t_aio.Store,
+				Store: &t_aio.StoreSubmission{
+					Transaction: &t_aio.Transaction{
+						Commands: commands,
+					},
+				},
+			},
+		},
+	})
+
+	if cqes[0].Error != nil {
+		return nil, cqes[0].Error
+	}
+
+	return cqes[0].Completion.Store.Results, nil
+}
+
+func rowsAffected(result *t_aio.Result) int64 {
+	switch result.Kind {
+	case t_aio.CreatePromise:
+		return result.CreatePromise.RowsAffected
+	case t_aio.UpdatePromise:
+		return result.UpdatePromise.RowsAffected
+	case t_aio.CreateSubscription:
+		return result.CreateSubscription.RowsAffected
+	case t_aio.CreateNotification:
+		return result.CreateNotification.RowsAffected
+	case t_aio.CreateDeadNotification:
+		return result.CreateDeadNotification.RowsAffected
+	case t_aio.CreateNotificationAttempt:
+		return result.CreateNotificationAttempt.RowsAffected
+	default:
+		panic("invalid result")
+	}
+}
+
+func id(line *Line) string {
+	switch line.Kind {
+	case Promise:
+		return line.Promise.Id
+	case Subscription:
+		return fmt.Sprintf("%s/%s", line.Subscription.PromiseId, line.Subscription.Id)
+	case Notification:
+		return fmt.Sprintf("%s/%s", line.Notification.PromiseId, line.Notification.Id)
+	case DeadNotification:
+		return fmt.Sprintf("%s/%s", line.DeadNotification.PromiseId, line.DeadNotification.Id)
+	case NotificationAttempt:
+		return fmt.Sprintf("%s/%s/%d", line.NotificationAttempt.PromiseId, line.NotificationAttempt.Id, line.NotificationAttempt.Attempt)
+	default:
+		panic("invalid kind")
+	}
+}
+
+// value normalizes a value, headers and data are omitted from an export
+// when empty but must not be nil when written to a store.
+func value(v promise.Value) promise.Value {
+	if v.Headers == nil {
+		v.Headers = map[string]string{}
+	}
+	if v.Data == nil {
+		v.Data = []byte{}
+	}
+
+	return v
+}
+
+func tags(tags map[string]string) map[string]string {
+	if tags == nil {
+		return map[string]string{}
+	}
+
+	return tags
+}
@@ -0,0 +1,255 @@
+package transfer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/memory"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/sqlite"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/subscription"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportImport(t *testing.T) {
+	src := start(t, func() (aio.Subsystem, error) { return memory.New() })
+	dst := start(t, func() (aio.Subsystem, error) {
+		return sqlite.New(&sqlite.Config{Path: ":memory:", TxTimeout: 250 * time.Millisecond})
+	})
+
+	createPromise := func(id string, key string, tags map[string]string) *t_aio.Command {
+		return &t_aio.Command{
+			Kind: t_aio.CreatePromise,
+			CreatePromise: &t_aio.CreatePromiseCommand{
+				Id:      id,
+				Timeout: 10,
+				Param: promise.Value{
+					Headers: map[string]string{"a": "a"},
+					Data:    []byte(id),
+				},
+				IdempotencyKey: (*promise.IdempotencyKey)(&key),
+				Tags:           tags,
+				CreatedOn:      1,
+			},
+		}
+	}
+
+	createSubscription := func(id string, promiseId string) *t_aio.Command {
+		return &t_aio.Command{
+			Kind: t_aio.CreateSubscription,
+			CreateSubscription: &t_aio.CreateSubscriptionCommand{
+				Id:          id,
+				PromiseId:   promiseId,
+				Url:         "https://" + promiseId + ".com/" + id,
+				Method:      "PUT",
+				Headers:     map[string]string{"Authorization": id},
+				Payload:     &subscription.Payload{ExcludeParamData: true},
+				Secret:      "whsec_" + id,
+				RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 3},
+				CreatedOn:   1,
+			},
+		}
+	}
+
+	if _, err := execute(src.NewWorker(0),
+		createPromise("foo", "foo", map[string]string{"resonate:retention": "1h"}),
+		createPromise("bar", "bar", map[string]string{}),
+		createPromise("baz", "baz", map[string]string{}),
+		createSubscription("a", "foo"),
+		createSubscription("b", "foo"),
+		createSubscription("a", "bar"),
+		&t_aio.Command{
+			Kind: t_aio.UpdatePromise,
+			UpdatePromise: &t_aio.UpdatePromiseCommand{
+				Id:    "bar",
+				State: promise.Rejected,
+				Value: promise.Value{
+					Headers: map[string]string{"b": "b"},
+					Data:    []byte("oops"),
+				},
+				IdempotencyKey: (*promise.IdempotencyKey)(strPointer("bar")),
+				CompletedOn:    2,
+			},
+		},
+		&t_aio.Command{
+			Kind: t_aio.CreateNotifications,
+			CreateNotifications: &t_aio.CreateNotificationsCommand{
+				PromiseId: "bar",
+				Time:      2,
+			},
+		},
+		&t_aio.Command{
+			Kind: t_aio.DeleteSubscriptions,
+			DeleteSubscriptions: &t_aio.DeleteSubscriptionsCommand{
+				PromiseId: "bar",
+			},
+		},
+		&t_aio.Command{
+			Kind: t_aio.CreateNotificationAttempt,
+			CreateNotificationAttempt: &t_aio.CreateNotificationAttemptCommand{
+				Id:         "a",
+				PromiseId:  "bar",
+				Attempt:    1,
+				Url:        "https://bar.com/a",
+				StatusCode: intPointer(500),
+				Latency:    10,
+				Body:       []byte("error"),
+				Time:       3,
+			},
+		},
+		&t_aio.Command{
+			Kind: t_aio.CreateNotificationAttempt,
+			CreateNotificationAttempt: &t_aio.CreateNotificationAttemptCommand{
+				Id:        "b",
+				PromiseId: "bar",
+				Attempt:   1,
+				Url:       "https://bar.com/b",
+				Latency:   20,
+				Error:     strPointer("connection refused"),
+				Time:      4,
+			},
+		},
+		&t_aio.Command{
+			Kind: t_aio.CreateNotificationAttempt,
+			CreateNotificationAttempt: &t_aio.CreateNotificationAttemptCommand{
+				Id:         "a",
+				PromiseId:  "bar",
+				Attempt:    2,
+				Url:        "https://bar.com/a",
+				StatusCode: intPointer(500),
+				Latency:    30,
+				Time:       5,
+			},
+		},
+		&t_aio.Command{
+			Kind: t_aio.CreateDeadNotification,
+			CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+				Id:          "b",
+				PromiseId:   "bar",
+				Url:         "https://bar.com/b",
+				Method:      "POST",
+				Headers:     map[string]string{"Authorization": "b"},
+				Payload:     &subscription.Payload{ExcludeParamData: true},
+				Secret:      "whsec_b",
+				RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 1},
+				Attempts:    1,
+				Error:       strPointer("connection refused"),
+				CreatedOn:   4,
+			},
+		},
+		&t_aio.Command{
+			Kind: t_aio.CreateDeadNotification,
+			CreateDeadNotification: &t_aio.CreateDeadNotificationCommand{
+				Id:          "a",
+				PromiseId:   "bar",
+				Url:         "https://bar.com/a",
+				RetryPolicy: &subscription.RetryPolicy{Delay: 1, Attempts: 2},
+				Attempts:    2,
+				StatusCode:  intPointer(500),
+				CreatedOn:   5,
+			},
+		},
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	// export with a batch size smaller than the number of rows of each
+	// kind to exercise pagination
+	var exported bytes.Buffer
+	stats, err := Export(src.NewWorker(0), &exported, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &Stats{Promises: 3, Subscriptions: 2, Notifications: 1, DeadNotifications: 2, NotificationAttempts: 3}, stats)
+
+	stats, err = Import(dst.NewWorker(0), bytes.NewReader(exported.Bytes()), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, &Stats{Promises: 3, Subscriptions: 2, Notifications: 1, DeadNotifications: 2, NotificationAttempts: 3}, stats)
+
+	// exporting the imported store yields the same export
+	var reexported bytes.Buffer
+	if _, err := Export(dst.NewWorker(0), &reexported, 10); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, exported.String(), reexported.String())
+
+	// the secret of a dead notification is exported
+	results, err := execute(dst.NewWorker(0), &t_aio.Command{
+		Kind: t_aio.ReadDeadNotification,
+		ReadDeadNotification: &t_aio.ReadDeadNotificationCommand{
+			Id:        "b",
+			PromiseId: "bar",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "whsec_b", results[0].ReadDeadNotification.Records[0].Secret)
+
+	// a store can only be imported into when empty
+	_, err = Import(dst.NewWorker(0), bytes.NewReader(exported.Bytes()), 2)
+	assert.ErrorContains(t, err, "store must be empty")
+}
+
+func TestImportInvalid(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		input string
+		err   string
+	}{
+		{
+			name:  "json",
+			input: `{"kind":`,
+			err:   "line 1",
+		},
+		{
+			name:  "kind",
+			input: `{"kind":"timeout"}`,
+			err:   `line 1: unsupported kind "timeout"`,
+		},
+		{
+			name:  "duplicate",
+			input: `{"kind":"promise","promise":{"id":"foo","state":"PENDING","timeout":1,"createdOn":1}}` + "\n" + `{"kind":"promise","promise":{"id":"foo","state":"PENDING","timeout":1,"createdOn":1}}`,
+			err:   "line 2: promise foo already exists",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			store := start(t, func() (aio.Subsystem, error) { return memory.New() })
+
+			_, err := Import(store.NewWorker(0), strings.NewReader(tc.input), 10)
+			assert.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func start(t *testing.T, f func() (aio.Subsystem, error)) aio.Subsystem {
+	store, err := f()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		if err := store.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	return store
+}
+
+func strPointer(s string) *string {
+	return &s
+}
+
+func intPointer(i int) *int {
+	return &i
+}
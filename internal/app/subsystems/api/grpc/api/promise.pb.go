@@ -7,6 +7,7 @@
 package api
 
 import (
+	receiver "github.com/resonatehq/resonate/pkg/receiver"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -20,113 +21,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type State int32
+type SearchState int32
 
 const (
-	State_PENDING           State = 0
-	State_RESOLVED          State = 1
-	State_REJECTED          State = 2
-	State_REJECTED_TIMEDOUT State = 3
-	State_REJECTED_CANCELED State = 4
+	SearchState_SEARCH_ALL      SearchState = 0
+	SearchState_SEARCH_PENDING  SearchState = 1
+	SearchState_SEARCH_RESOLVED SearchState = 2
+	SearchState_SEARCH_REJECTED SearchState = 3
 )
 
-// Enum value maps for State.
+// Enum value maps for SearchState.
 var (
-	State_name = map[int32]string{
-		0: "PENDING",
-		1: "RESOLVED",
-		2: "REJECTED",
-		3: "REJECTED_TIMEDOUT",
-		4: "REJECTED_CANCELED",
-	}
-	State_value = map[string]int32{
-		"PENDING":           0,
-		"RESOLVED":          1,
-		"REJECTED":          2,
-		"REJECTED_TIMEDOUT": 3,
-		"REJECTED_CANCELED": 4,
+	SearchState_name = map[int32]string{
+		0: "SEARCH_ALL",
+		1: "SEARCH_PENDING",
+		2: "SEARCH_RESOLVED",
+		3: "SEARCH_REJECTED",
+	}
+	SearchState_value = map[string]int32{
+		"SEARCH_ALL":      0,
+		"SEARCH_PENDING":  1,
+		"SEARCH_RESOLVED": 2,
+		"SEARCH_REJECTED": 3,
 	}
 )
 
-func (x State) Enum() *State {
-	p := new(State)
+func (x SearchState) Enum() *SearchState {
+	p := new(SearchState)
 	*p = x
 	return p
 }
 
-func (x State) String() string {
+func (x SearchState) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (State) Descriptor() protoreflect.EnumDescriptor {
+func (SearchState) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[0].Descriptor()
 }
 
-func (State) Type() protoreflect.EnumType {
+func (SearchState) Type() protoreflect.EnumType {
 	return &file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[0]
 }
 
-func (x State) Number() protoreflect.EnumNumber {
+func (x SearchState) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use State.Descriptor instead.
-func (State) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use SearchState.Descriptor instead.
+func (SearchState) EnumDescriptor() ([]byte, []int) {
 	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{0}
 }
 
-type SearchState int32
+type SearchSortBy int32
 
 const (
-	SearchState_SEARCH_ALL      SearchState = 0
-	SearchState_SEARCH_PENDING  SearchState = 1
-	SearchState_SEARCH_RESOLVED SearchState = 2
-	SearchState_SEARCH_REJECTED SearchState = 3
+	SearchSortBy_SORT_BY_SORT_ID    SearchSortBy = 0
+	SearchSortBy_SORT_BY_CREATED_ON SearchSortBy = 1
+	SearchSortBy_SORT_BY_TIMEOUT    SearchSortBy = 2
 )
 
-// Enum value maps for SearchState.
+// Enum value maps for SearchSortBy.
 var (
-	SearchState_name = map[int32]string{
-		0: "SEARCH_ALL",
-		1: "SEARCH_PENDING",
-		2: "SEARCH_RESOLVED",
-		3: "SEARCH_REJECTED",
+	SearchSortBy_name = map[int32]string{
+		0: "SORT_BY_SORT_ID",
+		1: "SORT_BY_CREATED_ON",
+		2: "SORT_BY_TIMEOUT",
 	}
-	SearchState_value = map[string]int32{
-		"SEARCH_ALL":      0,
-		"SEARCH_PENDING":  1,
-		"SEARCH_RESOLVED": 2,
-		"SEARCH_REJECTED": 3,
+	SearchSortBy_value = map[string]int32{
+		"SORT_BY_SORT_ID":    0,
+		"SORT_BY_CREATED_ON": 1,
+		"SORT_BY_TIMEOUT":    2,
 	}
 )
 
-func (x SearchState) Enum() *SearchState {
-	p := new(SearchState)
+func (x SearchSortBy) Enum() *SearchSortBy {
+	p := new(SearchSortBy)
 	*p = x
 	return p
 }
 
-func (x SearchState) String() string {
+func (x SearchSortBy) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (SearchState) Descriptor() protoreflect.EnumDescriptor {
+func (SearchSortBy) Descriptor() protoreflect.EnumDescriptor {
 	return file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[1].Descriptor()
 }
 
-func (SearchState) Type() protoreflect.EnumType {
+func (SearchSortBy) Type() protoreflect.EnumType {
 	return &file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[1]
 }
 
-func (x SearchState) Number() protoreflect.EnumNumber {
+func (x SearchSortBy) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use SearchState.Descriptor instead.
-func (SearchState) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use SearchSortBy.Descriptor instead.
+func (SearchSortBy) EnumDescriptor() ([]byte, []int) {
 	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{1}
 }
 
+type SearchSortOrder int32
+
+const (
+	SearchSortOrder_SORT_ORDER_DESC SearchSortOrder = 0
+	SearchSortOrder_SORT_ORDER_ASC  SearchSortOrder = 1
+)
+
+// Enum value maps for SearchSortOrder.
+var (
+	SearchSortOrder_name = map[int32]string{
+		0: "SORT_ORDER_DESC",
+		1: "SORT_ORDER_ASC",
+	}
+	SearchSortOrder_value = map[string]int32{
+		"SORT_ORDER_DESC": 0,
+		"SORT_ORDER_ASC":  1,
+	}
+)
+
+func (x SearchSortOrder) Enum() *SearchSortOrder {
+	p := new(SearchSortOrder)
+	*p = x
+	return p
+}
+
+func (x SearchSortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchSortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[2].Descriptor()
+}
+
+func (SearchSortOrder) Type() protoreflect.EnumType {
+	return &file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[2]
+}
+
+func (x SearchSortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchSortOrder.Descriptor instead.
+func (SearchSortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{2}
+}
+
 type Status int32
 
 const (
@@ -172,11 +213,11 @@ func (x Status) String() string {
 }
 
 func (Status) Descriptor() protoreflect.EnumDescriptor {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[2].Descriptor()
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[3].Descriptor()
 }
 
 func (Status) Type() protoreflect.EnumType {
-	return &file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[2]
+	return &file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes[3]
 }
 
 func (x Status) Number() protoreflect.EnumNumber {
@@ -185,25 +226,20 @@ func (x Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Status.Descriptor instead.
 func (Status) EnumDescriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{2}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{3}
 }
 
-type Promise struct {
+type ReadPromiseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id                        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	State                     State  `protobuf:"varint,2,opt,name=state,proto3,enum=promise.State" json:"state,omitempty"`
-	Param                     *Value `protobuf:"bytes,3,opt,name=param,proto3" json:"param,omitempty"`
-	Value                     *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	Timeout                   int64  `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
-	IdempotencyKeyForCreate   string `protobuf:"bytes,6,opt,name=idempotencyKeyForCreate,proto3" json:"idempotencyKeyForCreate,omitempty"`
-	IdempotencyKeyForComplete string `protobuf:"bytes,7,opt,name=idempotencyKeyForComplete,proto3" json:"idempotencyKeyForComplete,omitempty"`
+	Id   string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Wait int64  `protobuf:"varint,2,opt,name=wait,proto3" json:"wait,omitempty"` // milliseconds, at most five minutes
 }
 
-func (x *Promise) Reset() {
-	*x = Promise{}
+func (x *ReadPromiseRequest) Reset() {
+	*x = ReadPromiseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -211,13 +247,13 @@ func (x *Promise) Reset() {
 	}
 }
 
-func (x *Promise) String() string {
+func (x *ReadPromiseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Promise) ProtoMessage() {}
+func (*ReadPromiseRequest) ProtoMessage() {}
 
-func (x *Promise) ProtoReflect() protoreflect.Message {
+func (x *ReadPromiseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -229,71 +265,36 @@ func (x *Promise) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Promise.ProtoReflect.Descriptor instead.
-func (*Promise) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadPromiseRequest.ProtoReflect.Descriptor instead.
+func (*ReadPromiseRequest) Descriptor() ([]byte, []int) {
 	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Promise) GetId() string {
+func (x *ReadPromiseRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Promise) GetState() State {
-	if x != nil {
-		return x.State
-	}
-	return State_PENDING
-}
-
-func (x *Promise) GetParam() *Value {
-	if x != nil {
-		return x.Param
-	}
-	return nil
-}
-
-func (x *Promise) GetValue() *Value {
-	if x != nil {
-		return x.Value
-	}
-	return nil
-}
-
-func (x *Promise) GetTimeout() int64 {
+func (x *ReadPromiseRequest) GetWait() int64 {
 	if x != nil {
-		return x.Timeout
+		return x.Wait
 	}
 	return 0
 }
 
-func (x *Promise) GetIdempotencyKeyForCreate() string {
-	if x != nil {
-		return x.IdempotencyKeyForCreate
-	}
-	return ""
-}
-
-func (x *Promise) GetIdempotencyKeyForComplete() string {
-	if x != nil {
-		return x.IdempotencyKeyForComplete
-	}
-	return ""
-}
-
-type Value struct {
+type ReadPromiseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Data    []byte            `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Status  Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Promise *receiver.Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
 }
 
-func (x *Value) Reset() {
-	*x = Value{}
+func (x *ReadPromiseResponse) Reset() {
+	*x = ReadPromiseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,13 +302,13 @@ func (x *Value) Reset() {
 	}
 }
 
-func (x *Value) String() string {
+func (x *ReadPromiseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Value) ProtoMessage() {}
+func (*ReadPromiseResponse) ProtoMessage() {}
 
-func (x *Value) ProtoReflect() protoreflect.Message {
+func (x *ReadPromiseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -319,35 +320,35 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Value.ProtoReflect.Descriptor instead.
-func (*Value) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadPromiseResponse.ProtoReflect.Descriptor instead.
+func (*ReadPromiseResponse) Descriptor() ([]byte, []int) {
 	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Value) GetHeaders() map[string]string {
+func (x *ReadPromiseResponse) GetStatus() Status {
 	if x != nil {
-		return x.Headers
+		return x.Status
 	}
-	return nil
+	return Status_UNKNOWN
 }
 
-func (x *Value) GetData() []byte {
+func (x *ReadPromiseResponse) GetPromise() *receiver.Promise {
 	if x != nil {
-		return x.Data
+		return x.Promise
 	}
 	return nil
 }
 
-type ReadPromiseRequest struct {
+type PromiseEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Promise *receiver.Promise `protobuf:"bytes,1,opt,name=promise,proto3" json:"promise,omitempty"`
 }
 
-func (x *ReadPromiseRequest) Reset() {
-	*x = ReadPromiseRequest{}
+func (x *PromiseEvent) Reset() {
+	*x = PromiseEvent{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -355,13 +356,13 @@ func (x *ReadPromiseRequest) Reset() {
 	}
 }
 
-func (x *ReadPromiseRequest) String() string {
+func (x *PromiseEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadPromiseRequest) ProtoMessage() {}
+func (*PromiseEvent) ProtoMessage() {}
 
-func (x *ReadPromiseRequest) ProtoReflect() protoreflect.Message {
+func (x *PromiseEvent) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -373,67 +374,12 @@ func (x *ReadPromiseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadPromiseRequest.ProtoReflect.Descriptor instead.
-func (*ReadPromiseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PromiseEvent.ProtoReflect.Descriptor instead.
+func (*PromiseEvent) Descriptor() ([]byte, []int) {
 	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ReadPromiseRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type ReadPromiseResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status  Status   `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
-	Promise *Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
-}
-
-func (x *ReadPromiseResponse) Reset() {
-	*x = ReadPromiseResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *ReadPromiseResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ReadPromiseResponse) ProtoMessage() {}
-
-func (x *ReadPromiseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ReadPromiseResponse.ProtoReflect.Descriptor instead.
-func (*ReadPromiseResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *ReadPromiseResponse) GetStatus() Status {
-	if x != nil {
-		return x.Status
-	}
-	return Status_UNKNOWN
-}
-
-func (x *ReadPromiseResponse) GetPromise() *Promise {
+func (x *PromiseEvent) GetPromise() *receiver.Promise {
 	if x != nil {
 		return x.Promise
 	}
@@ -445,16 +391,24 @@ type SearchPromisesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Q      string      `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
-	State  SearchState `protobuf:"varint,2,opt,name=state,proto3,enum=promise.SearchState" json:"state,omitempty"`
-	Limit  int32       `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	Cursor string      `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Q               string            `protobuf:"bytes,1,opt,name=q,proto3" json:"q,omitempty"`
+	State           SearchState       `protobuf:"varint,2,opt,name=state,proto3,enum=promise.SearchState" json:"state,omitempty"`
+	Limit           int32             `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor          string            `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Tags            map[string]string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAfter    *int64            `protobuf:"varint,6,opt,name=createdAfter,proto3,oneof" json:"createdAfter,omitempty"`
+	CreatedBefore   *int64            `protobuf:"varint,7,opt,name=createdBefore,proto3,oneof" json:"createdBefore,omitempty"`
+	CompletedAfter  *int64            `protobuf:"varint,8,opt,name=completedAfter,proto3,oneof" json:"completedAfter,omitempty"`
+	CompletedBefore *int64            `protobuf:"varint,9,opt,name=completedBefore,proto3,oneof" json:"completedBefore,omitempty"`
+	TimeoutBefore   *int64            `protobuf:"varint,10,opt,name=timeoutBefore,proto3,oneof" json:"timeoutBefore,omitempty"`
+	SortBy          SearchSortBy      `protobuf:"varint,11,opt,name=sortBy,proto3,enum=promise.SearchSortBy" json:"sortBy,omitempty"`
+	SortOrder       SearchSortOrder   `protobuf:"varint,12,opt,name=sortOrder,proto3,enum=promise.SearchSortOrder" json:"sortOrder,omitempty"`
 }
 
 func (x *SearchPromisesRequest) Reset() {
 	*x = SearchPromisesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[4]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -467,7 +421,7 @@ func (x *SearchPromisesRequest) String() string {
 func (*SearchPromisesRequest) ProtoMessage() {}
 
 func (x *SearchPromisesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[4]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -480,7 +434,7 @@ func (x *SearchPromisesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPromisesRequest.ProtoReflect.Descriptor instead.
 func (*SearchPromisesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{4}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *SearchPromisesRequest) GetQ() string {
@@ -511,20 +465,76 @@ func (x *SearchPromisesRequest) GetCursor() string {
 	return ""
 }
 
+func (x *SearchPromisesRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *SearchPromisesRequest) GetCreatedAfter() int64 {
+	if x != nil && x.CreatedAfter != nil {
+		return *x.CreatedAfter
+	}
+	return 0
+}
+
+func (x *SearchPromisesRequest) GetCreatedBefore() int64 {
+	if x != nil && x.CreatedBefore != nil {
+		return *x.CreatedBefore
+	}
+	return 0
+}
+
+func (x *SearchPromisesRequest) GetCompletedAfter() int64 {
+	if x != nil && x.CompletedAfter != nil {
+		return *x.CompletedAfter
+	}
+	return 0
+}
+
+func (x *SearchPromisesRequest) GetCompletedBefore() int64 {
+	if x != nil && x.CompletedBefore != nil {
+		return *x.CompletedBefore
+	}
+	return 0
+}
+
+func (x *SearchPromisesRequest) GetTimeoutBefore() int64 {
+	if x != nil && x.TimeoutBefore != nil {
+		return *x.TimeoutBefore
+	}
+	return 0
+}
+
+func (x *SearchPromisesRequest) GetSortBy() SearchSortBy {
+	if x != nil {
+		return x.SortBy
+	}
+	return SearchSortBy_SORT_BY_SORT_ID
+}
+
+func (x *SearchPromisesRequest) GetSortOrder() SearchSortOrder {
+	if x != nil {
+		return x.SortOrder
+	}
+	return SearchSortOrder_SORT_ORDER_DESC
+}
+
 type SearchPromisesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   Status     `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
-	Cursor   string     `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
-	Promises []*Promise `protobuf:"bytes,3,rep,name=promises,proto3" json:"promises,omitempty"`
+	Status   Status              `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Cursor   string              `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Promises []*receiver.Promise `protobuf:"bytes,3,rep,name=promises,proto3" json:"promises,omitempty"`
 }
 
 func (x *SearchPromisesResponse) Reset() {
 	*x = SearchPromisesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[5]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -537,7 +547,7 @@ func (x *SearchPromisesResponse) String() string {
 func (*SearchPromisesResponse) ProtoMessage() {}
 
 func (x *SearchPromisesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[5]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -550,7 +560,7 @@ func (x *SearchPromisesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SearchPromisesResponse.ProtoReflect.Descriptor instead.
 func (*SearchPromisesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{5}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SearchPromisesResponse) GetStatus() Status {
@@ -567,7 +577,7 @@ func (x *SearchPromisesResponse) GetCursor() string {
 	return ""
 }
 
-func (x *SearchPromisesResponse) GetPromises() []*Promise {
+func (x *SearchPromisesResponse) GetPromises() []*receiver.Promise {
 	if x != nil {
 		return x.Promises
 	}
@@ -579,17 +589,18 @@ type CreatePromiseRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
-	Strict         bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
-	Param          *Value `protobuf:"bytes,4,opt,name=param,proto3" json:"param,omitempty"`
-	Timeout        int64  `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Id             string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string            `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	Strict         bool              `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	Param          *receiver.Value   `protobuf:"bytes,4,opt,name=param,proto3" json:"param,omitempty"`
+	Timeout        int64             `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Tags           map[string]string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *CreatePromiseRequest) Reset() {
 	*x = CreatePromiseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[6]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -602,7 +613,7 @@ func (x *CreatePromiseRequest) String() string {
 func (*CreatePromiseRequest) ProtoMessage() {}
 
 func (x *CreatePromiseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[6]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -615,7 +626,7 @@ func (x *CreatePromiseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePromiseRequest.ProtoReflect.Descriptor instead.
 func (*CreatePromiseRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{6}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreatePromiseRequest) GetId() string {
@@ -639,7 +650,7 @@ func (x *CreatePromiseRequest) GetStrict() bool {
 	return false
 }
 
-func (x *CreatePromiseRequest) GetParam() *Value {
+func (x *CreatePromiseRequest) GetParam() *receiver.Value {
 	if x != nil {
 		return x.Param
 	}
@@ -653,19 +664,26 @@ func (x *CreatePromiseRequest) GetTimeout() int64 {
 	return 0
 }
 
+func (x *CreatePromiseRequest) GetTags() map[string]string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type CreatePromiseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  Status   `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
-	Promise *Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
+	Status  Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Promise *receiver.Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
 }
 
 func (x *CreatePromiseResponse) Reset() {
 	*x = CreatePromiseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[7]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -678,7 +696,7 @@ func (x *CreatePromiseResponse) String() string {
 func (*CreatePromiseResponse) ProtoMessage() {}
 
 func (x *CreatePromiseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[7]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -691,7 +709,7 @@ func (x *CreatePromiseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreatePromiseResponse.ProtoReflect.Descriptor instead.
 func (*CreatePromiseResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{7}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreatePromiseResponse) GetStatus() Status {
@@ -701,7 +719,7 @@ func (x *CreatePromiseResponse) GetStatus() Status {
 	return Status_UNKNOWN
 }
 
-func (x *CreatePromiseResponse) GetPromise() *Promise {
+func (x *CreatePromiseResponse) GetPromise() *receiver.Promise {
 	if x != nil {
 		return x.Promise
 	}
@@ -713,16 +731,16 @@ type CancelPromiseRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
-	Strict         bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
-	Value          *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Id             string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string          `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	Strict         bool            `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	Value          *receiver.Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (x *CancelPromiseRequest) Reset() {
 	*x = CancelPromiseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[8]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +753,7 @@ func (x *CancelPromiseRequest) String() string {
 func (*CancelPromiseRequest) ProtoMessage() {}
 
 func (x *CancelPromiseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[8]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +766,7 @@ func (x *CancelPromiseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPromiseRequest.ProtoReflect.Descriptor instead.
 func (*CancelPromiseRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{8}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CancelPromiseRequest) GetId() string {
@@ -772,7 +790,7 @@ func (x *CancelPromiseRequest) GetStrict() bool {
 	return false
 }
 
-func (x *CancelPromiseRequest) GetValue() *Value {
+func (x *CancelPromiseRequest) GetValue() *receiver.Value {
 	if x != nil {
 		return x.Value
 	}
@@ -784,14 +802,14 @@ type CancelPromiseResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  Status   `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
-	Promise *Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
+	Status  Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Promise *receiver.Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
 }
 
 func (x *CancelPromiseResponse) Reset() {
 	*x = CancelPromiseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[9]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -804,7 +822,7 @@ func (x *CancelPromiseResponse) String() string {
 func (*CancelPromiseResponse) ProtoMessage() {}
 
 func (x *CancelPromiseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[9]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,7 +835,7 @@ func (x *CancelPromiseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPromiseResponse.ProtoReflect.Descriptor instead.
 func (*CancelPromiseResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{9}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CancelPromiseResponse) GetStatus() Status {
@@ -827,7 +845,7 @@ func (x *CancelPromiseResponse) GetStatus() Status {
 	return Status_UNKNOWN
 }
 
-func (x *CancelPromiseResponse) GetPromise() *Promise {
+func (x *CancelPromiseResponse) GetPromise() *receiver.Promise {
 	if x != nil {
 		return x.Promise
 	}
@@ -839,16 +857,16 @@ type ResolvePromiseRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
-	Strict         bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
-	Value          *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Id             string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string          `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	Strict         bool            `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	Value          *receiver.Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (x *ResolvePromiseRequest) Reset() {
 	*x = ResolvePromiseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[10]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -861,7 +879,7 @@ func (x *ResolvePromiseRequest) String() string {
 func (*ResolvePromiseRequest) ProtoMessage() {}
 
 func (x *ResolvePromiseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[10]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,7 +892,7 @@ func (x *ResolvePromiseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvePromiseRequest.ProtoReflect.Descriptor instead.
 func (*ResolvePromiseRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{10}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ResolvePromiseRequest) GetId() string {
@@ -898,7 +916,7 @@ func (x *ResolvePromiseRequest) GetStrict() bool {
 	return false
 }
 
-func (x *ResolvePromiseRequest) GetValue() *Value {
+func (x *ResolvePromiseRequest) GetValue() *receiver.Value {
 	if x != nil {
 		return x.Value
 	}
@@ -910,14 +928,14 @@ type ResolvePromiseResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  Status   `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
-	Promise *Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
+	Status  Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Promise *receiver.Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
 }
 
 func (x *ResolvePromiseResponse) Reset() {
 	*x = ResolvePromiseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[11]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -930,7 +948,7 @@ func (x *ResolvePromiseResponse) String() string {
 func (*ResolvePromiseResponse) ProtoMessage() {}
 
 func (x *ResolvePromiseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[11]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -943,7 +961,7 @@ func (x *ResolvePromiseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolvePromiseResponse.ProtoReflect.Descriptor instead.
 func (*ResolvePromiseResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{11}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ResolvePromiseResponse) GetStatus() Status {
@@ -953,7 +971,7 @@ func (x *ResolvePromiseResponse) GetStatus() Status {
 	return Status_UNKNOWN
 }
 
-func (x *ResolvePromiseResponse) GetPromise() *Promise {
+func (x *ResolvePromiseResponse) GetPromise() *receiver.Promise {
 	if x != nil {
 		return x.Promise
 	}
@@ -965,16 +983,16 @@ type RejectPromiseRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IdempotencyKey string `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
-	Strict         bool   `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
-	Value          *Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Id             string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IdempotencyKey string          `protobuf:"bytes,2,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	Strict         bool            `protobuf:"varint,3,opt,name=strict,proto3" json:"strict,omitempty"`
+	Value          *receiver.Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
 }
 
 func (x *RejectPromiseRequest) Reset() {
 	*x = RejectPromiseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[12]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -987,7 +1005,7 @@ func (x *RejectPromiseRequest) String() string {
 func (*RejectPromiseRequest) ProtoMessage() {}
 
 func (x *RejectPromiseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[12]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1000,7 +1018,7 @@ func (x *RejectPromiseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectPromiseRequest.ProtoReflect.Descriptor instead.
 func (*RejectPromiseRequest) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{12}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *RejectPromiseRequest) GetId() string {
@@ -1024,7 +1042,7 @@ func (x *RejectPromiseRequest) GetStrict() bool {
 	return false
 }
 
-func (x *RejectPromiseRequest) GetValue() *Value {
+func (x *RejectPromiseRequest) GetValue() *receiver.Value {
 	if x != nil {
 		return x.Value
 	}
@@ -1036,14 +1054,14 @@ type RejectPromiseResponse struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status  Status   `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
-	Promise *Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
+	Status  Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Promise *receiver.Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
 }
 
 func (x *RejectPromiseResponse) Reset() {
 	*x = RejectPromiseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[13]
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1074,7 @@ func (x *RejectPromiseResponse) String() string {
 func (*RejectPromiseResponse) ProtoMessage() {}
 
 func (x *RejectPromiseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[13]
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1087,7 @@ func (x *RejectPromiseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RejectPromiseResponse.ProtoReflect.Descriptor instead.
 func (*RejectPromiseResponse) Descriptor() ([]byte, []int) {
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{13}
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RejectPromiseResponse) GetStatus() Status {
@@ -1079,326 +1097,2921 @@ func (x *RejectPromiseResponse) GetStatus() Status {
 	return Status_UNKNOWN
 }
 
-func (x *RejectPromiseResponse) GetPromise() *Promise {
+func (x *RejectPromiseResponse) GetPromise() *receiver.Promise {
 	if x != nil {
 		return x.Promise
 	}
 	return nil
 }
 
-var File_internal_app_subsystems_api_grpc_api_promise_proto protoreflect.FileDescriptor
+type BatchRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_internal_app_subsystems_api_grpc_api_promise_proto_rawDesc = []byte{
-	0x0a, 0x32, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x73,
-	0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x22, 0x9d, 0x02,
-	0x0a, 0x07, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x24, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
-	0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x38, 0x0a, 0x17, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
-	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x46, 0x6f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12,
-	0x3c, 0x0a, 0x19, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
-	0x79, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x19, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
-	0x65, 0x79, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0x8e, 0x01,
-	0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x24,
-	0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x22, 0x6a, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d,
-	0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72,
-	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
-	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x22, 0x7f, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72,
-	0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d,
-	0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70,
-	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2c, 0x0a,
-	0x08, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73,
-	0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x14,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64,
-	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74,
-	0x72, 0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x22, 0x6c, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
-	0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f,
-	0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69,
-	0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
-	0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f,
-	0x6d, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x22, 0x6c, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
-	0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50,
-	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x22,
-	0x8d, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65,
-	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
-	0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
-	0x6d, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
-	0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72,
-	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x22, 0x8c,
-	0x01, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
-	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a,
-	0x15, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2a, 0x5e, 0x0a, 0x05, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
-	0x00, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12,
-	0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a,
-	0x11, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f,
-	0x55, 0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44,
-	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x2a, 0x5b, 0x0a, 0x0b, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x45,
-	0x41, 0x52, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45,
-	0x41, 0x52, 0x43, 0x48, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x13,
-	0x0a, 0x0f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x52, 0x45,
-	0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x6a, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
-	0x07, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0xc8, 0x01, 0x12, 0x0c, 0x0a, 0x07, 0x43, 0x52, 0x45, 0x41,
-	0x54, 0x45, 0x44, 0x10, 0xc9, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x4e, 0x4f, 0x43, 0x4f, 0x4e, 0x54,
-	0x45, 0x4e, 0x54, 0x10, 0xcc, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x46, 0x4f, 0x52, 0x42, 0x49, 0x44,
-	0x44, 0x45, 0x4e, 0x10, 0x93, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x4e, 0x4f, 0x54, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0x94, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43,
-	0x54, 0x10, 0x99, 0x03, 0x32, 0xfc, 0x03, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b, 0x52, 0x65, 0x61, 0x64, 0x50,
-	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65,
-	0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f,
-	0x6d, 0x69, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
-	0x69, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72,
-	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d,
-	0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
-	0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e,
-	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1e,
-	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x50, 0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x69,
-	0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a,
-	0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65,
-	0x63, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x42, 0x45, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x68, 0x71, 0x2f, 0x72, 0x65, 0x73,
-	0x6f, 0x6e, 0x61, 0x74, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61,
-	0x70, 0x70, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	Requests []*BatchItem `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-var (
-	file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescOnce sync.Once
-	file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData = file_internal_app_subsystems_api_grpc_api_promise_proto_rawDesc
-)
+func (x *BatchRequest) Reset() {
+	*x = BatchRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP() []byte {
-	file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescOnce.Do(func() {
-		file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData)
-	})
-	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData
+func (x *BatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
-var file_internal_app_subsystems_api_grpc_api_promise_proto_goTypes = []interface{}{
-	(State)(0),                     // 0: promise.State
-	(SearchState)(0),               // 1: promise.SearchState
-	(Status)(0),                    // 2: promise.Status
-	(*Promise)(nil),                // 3: promise.Promise
-	(*Value)(nil),                  // 4: promise.Value
-	(*ReadPromiseRequest)(nil),     // 5: promise.ReadPromiseRequest
-	(*ReadPromiseResponse)(nil),    // 6: promise.ReadPromiseResponse
-	(*SearchPromisesRequest)(nil),  // 7: promise.SearchPromisesRequest
-	(*SearchPromisesResponse)(nil), // 8: promise.SearchPromisesResponse
-	(*CreatePromiseRequest)(nil),   // 9: promise.CreatePromiseRequest
-	(*CreatePromiseResponse)(nil),  // 10: promise.CreatePromiseResponse
-	(*CancelPromiseRequest)(nil),   // 11: promise.CancelPromiseRequest
-	(*CancelPromiseResponse)(nil),  // 12: promise.CancelPromiseResponse
-	(*ResolvePromiseRequest)(nil),  // 13: promise.ResolvePromiseRequest
-	(*ResolvePromiseResponse)(nil), // 14: promise.ResolvePromiseResponse
-	(*RejectPromiseRequest)(nil),   // 15: promise.RejectPromiseRequest
-	(*RejectPromiseResponse)(nil),  // 16: promise.RejectPromiseResponse
-	nil,                            // 17: promise.Value.HeadersEntry
+func (*BatchRequest) ProtoMessage() {}
+
+func (x *BatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_internal_app_subsystems_api_grpc_api_promise_proto_depIdxs = []int32{
-	0,  // 0: promise.Promise.state:type_name -> promise.State
-	4,  // 1: promise.Promise.param:type_name -> promise.Value
-	4,  // 2: promise.Promise.value:type_name -> promise.Value
-	17, // 3: promise.Value.headers:type_name -> promise.Value.HeadersEntry
-	2,  // 4: promise.ReadPromiseResponse.status:type_name -> promise.Status
-	3,  // 5: promise.ReadPromiseResponse.promise:type_name -> promise.Promise
-	1,  // 6: promise.SearchPromisesRequest.state:type_name -> promise.SearchState
-	2,  // 7: promise.SearchPromisesResponse.status:type_name -> promise.Status
-	3,  // 8: promise.SearchPromisesResponse.promises:type_name -> promise.Promise
-	4,  // 9: promise.CreatePromiseRequest.param:type_name -> promise.Value
-	2,  // 10: promise.CreatePromiseResponse.status:type_name -> promise.Status
-	3,  // 11: promise.CreatePromiseResponse.promise:type_name -> promise.Promise
-	4,  // 12: promise.CancelPromiseRequest.value:type_name -> promise.Value
-	2,  // 13: promise.CancelPromiseResponse.status:type_name -> promise.Status
-	3,  // 14: promise.CancelPromiseResponse.promise:type_name -> promise.Promise
-	4,  // 15: promise.ResolvePromiseRequest.value:type_name -> promise.Value
-	2,  // 16: promise.ResolvePromiseResponse.status:type_name -> promise.Status
-	3,  // 17: promise.ResolvePromiseResponse.promise:type_name -> promise.Promise
-	4,  // 18: promise.RejectPromiseRequest.value:type_name -> promise.Value
-	2,  // 19: promise.RejectPromiseResponse.status:type_name -> promise.Status
-	3,  // 20: promise.RejectPromiseResponse.promise:type_name -> promise.Promise
-	5,  // 21: promise.PromiseService.ReadPromise:input_type -> promise.ReadPromiseRequest
-	7,  // 22: promise.PromiseService.SearchPromises:input_type -> promise.SearchPromisesRequest
-	9,  // 23: promise.PromiseService.CreatePromise:input_type -> promise.CreatePromiseRequest
-	11, // 24: promise.PromiseService.CancelPromise:input_type -> promise.CancelPromiseRequest
-	13, // 25: promise.PromiseService.ResolvePromise:input_type -> promise.ResolvePromiseRequest
-	15, // 26: promise.PromiseService.RejectPromise:input_type -> promise.RejectPromiseRequest
-	6,  // 27: promise.PromiseService.ReadPromise:output_type -> promise.ReadPromiseResponse
-	8,  // 28: promise.PromiseService.SearchPromises:output_type -> promise.SearchPromisesResponse
-	10, // 29: promise.PromiseService.CreatePromise:output_type -> promise.CreatePromiseResponse
-	12, // 30: promise.PromiseService.CancelPromise:output_type -> promise.CancelPromiseResponse
-	14, // 31: promise.PromiseService.ResolvePromise:output_type -> promise.ResolvePromiseResponse
-	16, // 32: promise.PromiseService.RejectPromise:output_type -> promise.RejectPromiseResponse
-	27, // [27:33] is the sub-list for method output_type
-	21, // [21:27] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+
+// Deprecated: Use BatchRequest.ProtoReflect.Descriptor instead.
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{13}
 }
 
-func init() { file_internal_app_subsystems_api_grpc_api_promise_proto_init() }
-func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
-	if File_internal_app_subsystems_api_grpc_api_promise_proto != nil {
-		return
+func (x *BatchRequest) GetRequests() []*BatchItem {
+	if x != nil {
+		return x.Requests
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Promise); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Value); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return nil
+}
+
+type BatchItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Request:
+	//	*BatchItem_Create
+	//	*BatchItem_Cancel
+	//	*BatchItem_Resolve
+	//	*BatchItem_Reject
+	Request isBatchItem_Request `protobuf_oneof:"request"`
+}
+
+func (x *BatchItem) Reset() {
+	*x = BatchItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItem) ProtoMessage() {}
+
+func (x *BatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPromiseRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItem.ProtoReflect.Descriptor instead.
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{14}
+}
+
+func (m *BatchItem) GetRequest() isBatchItem_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (x *BatchItem) GetCreate() *CreatePromiseRequest {
+	if x, ok := x.GetRequest().(*BatchItem_Create); ok {
+		return x.Create
+	}
+	return nil
+}
+
+func (x *BatchItem) GetCancel() *CancelPromiseRequest {
+	if x, ok := x.GetRequest().(*BatchItem_Cancel); ok {
+		return x.Cancel
+	}
+	return nil
+}
+
+func (x *BatchItem) GetResolve() *ResolvePromiseRequest {
+	if x, ok := x.GetRequest().(*BatchItem_Resolve); ok {
+		return x.Resolve
+	}
+	return nil
+}
+
+func (x *BatchItem) GetReject() *RejectPromiseRequest {
+	if x, ok := x.GetRequest().(*BatchItem_Reject); ok {
+		return x.Reject
+	}
+	return nil
+}
+
+type isBatchItem_Request interface {
+	isBatchItem_Request()
+}
+
+type BatchItem_Create struct {
+	Create *CreatePromiseRequest `protobuf:"bytes,1,opt,name=create,proto3,oneof"`
+}
+
+type BatchItem_Cancel struct {
+	Cancel *CancelPromiseRequest `protobuf:"bytes,2,opt,name=cancel,proto3,oneof"`
+}
+
+type BatchItem_Resolve struct {
+	Resolve *ResolvePromiseRequest `protobuf:"bytes,3,opt,name=resolve,proto3,oneof"`
+}
+
+type BatchItem_Reject struct {
+	Reject *RejectPromiseRequest `protobuf:"bytes,4,opt,name=reject,proto3,oneof"`
+}
+
+func (*BatchItem_Create) isBatchItem_Request() {}
+
+func (*BatchItem_Cancel) isBatchItem_Request() {}
+
+func (*BatchItem_Resolve) isBatchItem_Request() {}
+
+func (*BatchItem_Reject) isBatchItem_Request() {}
+
+type BatchResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Responses []*BatchItemResponse `protobuf:"bytes,1,rep,name=responses,proto3" json:"responses,omitempty"`
+}
+
+func (x *BatchResponse) Reset() {
+	*x = BatchResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchResponse) ProtoMessage() {}
+
+func (x *BatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadPromiseResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchResponse.ProtoReflect.Descriptor instead.
+func (*BatchResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BatchResponse) GetResponses() []*BatchItemResponse {
+	if x != nil {
+		return x.Responses
+	}
+	return nil
+}
+
+type BatchItemResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Promise *receiver.Promise `protobuf:"bytes,2,opt,name=promise,proto3" json:"promise,omitempty"`
+	Error   string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchItemResponse) Reset() {
+	*x = BatchItemResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchItemResponse) ProtoMessage() {}
+
+func (x *BatchItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchItemResponse.ProtoReflect.Descriptor instead.
+func (*BatchItemResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *BatchItemResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *BatchItemResponse) GetPromise() *receiver.Promise {
+	if x != nil {
+		return x.Promise
+	}
+	return nil
+}
+
+func (x *BatchItemResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type CompleteAndCreatePromisesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Complete:
+	//	*CompleteAndCreatePromisesRequest_Resolve
+	//	*CompleteAndCreatePromisesRequest_Reject
+	//	*CompleteAndCreatePromisesRequest_Cancel
+	Complete isCompleteAndCreatePromisesRequest_Complete `protobuf_oneof:"complete"`
+	Creates  []*CreatePromiseRequest                     `protobuf:"bytes,4,rep,name=creates,proto3" json:"creates,omitempty"`
+}
+
+func (x *CompleteAndCreatePromisesRequest) Reset() {
+	*x = CompleteAndCreatePromisesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteAndCreatePromisesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAndCreatePromisesRequest) ProtoMessage() {}
+
+func (x *CompleteAndCreatePromisesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAndCreatePromisesRequest.ProtoReflect.Descriptor instead.
+func (*CompleteAndCreatePromisesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *CompleteAndCreatePromisesRequest) GetComplete() isCompleteAndCreatePromisesRequest_Complete {
+	if m != nil {
+		return m.Complete
+	}
+	return nil
+}
+
+func (x *CompleteAndCreatePromisesRequest) GetResolve() *ResolvePromiseRequest {
+	if x, ok := x.GetComplete().(*CompleteAndCreatePromisesRequest_Resolve); ok {
+		return x.Resolve
+	}
+	return nil
+}
+
+func (x *CompleteAndCreatePromisesRequest) GetReject() *RejectPromiseRequest {
+	if x, ok := x.GetComplete().(*CompleteAndCreatePromisesRequest_Reject); ok {
+		return x.Reject
+	}
+	return nil
+}
+
+func (x *CompleteAndCreatePromisesRequest) GetCancel() *CancelPromiseRequest {
+	if x, ok := x.GetComplete().(*CompleteAndCreatePromisesRequest_Cancel); ok {
+		return x.Cancel
+	}
+	return nil
+}
+
+func (x *CompleteAndCreatePromisesRequest) GetCreates() []*CreatePromiseRequest {
+	if x != nil {
+		return x.Creates
+	}
+	return nil
+}
+
+type isCompleteAndCreatePromisesRequest_Complete interface {
+	isCompleteAndCreatePromisesRequest_Complete()
+}
+
+type CompleteAndCreatePromisesRequest_Resolve struct {
+	Resolve *ResolvePromiseRequest `protobuf:"bytes,1,opt,name=resolve,proto3,oneof"`
+}
+
+type CompleteAndCreatePromisesRequest_Reject struct {
+	Reject *RejectPromiseRequest `protobuf:"bytes,2,opt,name=reject,proto3,oneof"`
+}
+
+type CompleteAndCreatePromisesRequest_Cancel struct {
+	Cancel *CancelPromiseRequest `protobuf:"bytes,3,opt,name=cancel,proto3,oneof"`
+}
+
+func (*CompleteAndCreatePromisesRequest_Resolve) isCompleteAndCreatePromisesRequest_Complete() {}
+
+func (*CompleteAndCreatePromisesRequest_Reject) isCompleteAndCreatePromisesRequest_Complete() {}
+
+func (*CompleteAndCreatePromisesRequest_Cancel) isCompleteAndCreatePromisesRequest_Complete() {}
+
+type CompleteAndCreatePromisesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   Status               `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Complete *BatchItemResponse   `protobuf:"bytes,2,opt,name=complete,proto3" json:"complete,omitempty"`
+	Creates  []*BatchItemResponse `protobuf:"bytes,3,rep,name=creates,proto3" json:"creates,omitempty"`
+}
+
+func (x *CompleteAndCreatePromisesResponse) Reset() {
+	*x = CompleteAndCreatePromisesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompleteAndCreatePromisesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteAndCreatePromisesResponse) ProtoMessage() {}
+
+func (x *CompleteAndCreatePromisesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteAndCreatePromisesResponse.ProtoReflect.Descriptor instead.
+func (*CompleteAndCreatePromisesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CompleteAndCreatePromisesResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *CompleteAndCreatePromisesResponse) GetComplete() *BatchItemResponse {
+	if x != nil {
+		return x.Complete
+	}
+	return nil
+}
+
+func (x *CompleteAndCreatePromisesResponse) GetCreates() []*BatchItemResponse {
+	if x != nil {
+		return x.Creates
+	}
+	return nil
+}
+
+type ReadPromisesByIdempotencyKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdempotencyKey string `protobuf:"bytes,1,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	Limit          int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor         string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ReadPromisesByIdempotencyKeyRequest) Reset() {
+	*x = ReadPromisesByIdempotencyKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPromisesByIdempotencyKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPromisesByIdempotencyKeyRequest) ProtoMessage() {}
+
+func (x *ReadPromisesByIdempotencyKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPromisesByIdempotencyKeyRequest.ProtoReflect.Descriptor instead.
+func (*ReadPromisesByIdempotencyKeyRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReadPromisesByIdempotencyKeyRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *ReadPromisesByIdempotencyKeyRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadPromisesByIdempotencyKeyRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ReadPromisesByIdempotencyKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   Status              `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Cursor   string              `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Promises []*receiver.Promise `protobuf:"bytes,3,rep,name=promises,proto3" json:"promises,omitempty"`
+}
+
+func (x *ReadPromisesByIdempotencyKeyResponse) Reset() {
+	*x = ReadPromisesByIdempotencyKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadPromisesByIdempotencyKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadPromisesByIdempotencyKeyResponse) ProtoMessage() {}
+
+func (x *ReadPromisesByIdempotencyKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadPromisesByIdempotencyKeyResponse.ProtoReflect.Descriptor instead.
+func (*ReadPromisesByIdempotencyKeyResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReadPromisesByIdempotencyKeyResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *ReadPromisesByIdempotencyKeyResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReadPromisesByIdempotencyKeyResponse) GetPromises() []*receiver.Promise {
+	if x != nil {
+		return x.Promises
+	}
+	return nil
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId   string            `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+	Url         string            `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	RetryPolicy *RetryPolicy      `protobuf:"bytes,4,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	CreatedOn   int64             `protobuf:"varint,5,opt,name=createdOn,proto3" json:"createdOn,omitempty"`
+	Method      string            `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	Headers     map[string]string `protobuf:"bytes,7,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Payload     *Payload          `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Subscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Subscription) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+func (x *Subscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Subscription) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *Subscription) GetCreatedOn() int64 {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return 0
+}
+
+func (x *Subscription) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Subscription) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Subscription) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delay    int64   `protobuf:"varint,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	Attempts int64   `protobuf:"varint,2,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Kind     string  `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	MaxDelay int64   `protobuf:"varint,4,opt,name=maxDelay,proto3" json:"maxDelay,omitempty"`
+	Jitter   bool    `protobuf:"varint,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	Schedule []int64 `protobuf:"varint,6,rep,packed,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RetryPolicy) GetDelay() int64 {
+	if x != nil {
+		return x.Delay
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *RetryPolicy) GetMaxDelay() int64 {
+	if x != nil {
+		return x.MaxDelay
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() bool {
+	if x != nil {
+		return x.Jitter
+	}
+	return false
+}
+
+func (x *RetryPolicy) GetSchedule() []int64 {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type Payload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExcludeParamData bool `protobuf:"varint,1,opt,name=excludeParamData,proto3" json:"excludeParamData,omitempty"`
+	ExcludeValueData bool `protobuf:"varint,2,opt,name=excludeValueData,proto3" json:"excludeValueData,omitempty"`
+}
+
+func (x *Payload) Reset() {
+	*x = Payload{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payload) ProtoMessage() {}
+
+func (x *Payload) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payload.ProtoReflect.Descriptor instead.
+func (*Payload) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Payload) GetExcludeParamData() bool {
+	if x != nil {
+		return x.ExcludeParamData
+	}
+	return false
+}
+
+func (x *Payload) GetExcludeValueData() bool {
+	if x != nil {
+		return x.ExcludeValueData
+	}
+	return false
+}
+
+type ReadSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PromiseId string `protobuf:"bytes,1,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+	Limit     int32  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor    string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ReadSubscriptionsRequest) Reset() {
+	*x = ReadSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ReadSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ReadSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReadSubscriptionsRequest) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+func (x *ReadSubscriptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadSubscriptionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ReadSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status        Status          `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Cursor        string          `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Subscriptions []*Subscription `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *ReadSubscriptionsResponse) Reset() {
+	*x = ReadSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ReadSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ReadSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReadSubscriptionsResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *ReadSubscriptionsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReadSubscriptionsResponse) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type CreateSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId   string            `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+	Url         string            `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	RetryPolicy *RetryPolicy      `protobuf:"bytes,4,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	Method      string            `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Headers     map[string]string `protobuf:"bytes,6,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Payload     *Payload          `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	Secret      string            `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"`
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CreateSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *CreateSubscriptionRequest) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+type CreateSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       Status        `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Subscription *Subscription `protobuf:"bytes,2,opt,name=subscription,proto3" json:"subscription,omitempty"`
+}
+
+func (x *CreateSubscriptionResponse) Reset() {
+	*x = CreateSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionResponse) ProtoMessage() {}
+
+func (x *CreateSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *CreateSubscriptionResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *CreateSubscriptionResponse) GetSubscription() *Subscription {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+type DeleteSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId string `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteSubscriptionRequest) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+type DeleteSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+}
+
+func (x *DeleteSubscriptionResponse) Reset() {
+	*x = DeleteSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteSubscriptionResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+type DeadNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId   string            `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+	Url         string            `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Method      string            `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Headers     map[string]string `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Payload     *Payload          `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+	RetryPolicy *RetryPolicy      `protobuf:"bytes,7,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	Attempts    int64             `protobuf:"varint,8,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	StatusCode  *int32            `protobuf:"varint,9,opt,name=statusCode,proto3,oneof" json:"statusCode,omitempty"`
+	Error       *string           `protobuf:"bytes,10,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	CreatedOn   int64             `protobuf:"varint,11,opt,name=createdOn,proto3" json:"createdOn,omitempty"`
+}
+
+func (x *DeadNotification) Reset() {
+	*x = DeadNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadNotification) ProtoMessage() {}
+
+func (x *DeadNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadNotification.ProtoReflect.Descriptor instead.
+func (*DeadNotification) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DeadNotification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadNotification) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+func (x *DeadNotification) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *DeadNotification) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DeadNotification) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *DeadNotification) GetPayload() *Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *DeadNotification) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *DeadNotification) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *DeadNotification) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *DeadNotification) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *DeadNotification) GetCreatedOn() int64 {
+	if x != nil {
+		return x.CreatedOn
+	}
+	return 0
+}
+
+type ReadDeadNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limit  int32  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ReadDeadNotificationsRequest) Reset() {
+	*x = ReadDeadNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeadNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeadNotificationsRequest) ProtoMessage() {}
+
+func (x *ReadDeadNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeadNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ReadDeadNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReadDeadNotificationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadDeadNotificationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ReadDeadNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status            Status              `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Cursor            string              `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	DeadNotifications []*DeadNotification `protobuf:"bytes,3,rep,name=deadNotifications,proto3" json:"deadNotifications,omitempty"`
+}
+
+func (x *ReadDeadNotificationsResponse) Reset() {
+	*x = ReadDeadNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeadNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeadNotificationsResponse) ProtoMessage() {}
+
+func (x *ReadDeadNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeadNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*ReadDeadNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReadDeadNotificationsResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *ReadDeadNotificationsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReadDeadNotificationsResponse) GetDeadNotifications() []*DeadNotification {
+	if x != nil {
+		return x.DeadNotifications
+	}
+	return nil
+}
+
+type ReadDeadNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId string `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+}
+
+func (x *ReadDeadNotificationRequest) Reset() {
+	*x = ReadDeadNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeadNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeadNotificationRequest) ProtoMessage() {}
+
+func (x *ReadDeadNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeadNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ReadDeadNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReadDeadNotificationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReadDeadNotificationRequest) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+type ReadDeadNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status           Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	DeadNotification *DeadNotification `protobuf:"bytes,2,opt,name=deadNotification,proto3" json:"deadNotification,omitempty"`
+}
+
+func (x *ReadDeadNotificationResponse) Reset() {
+	*x = ReadDeadNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadDeadNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadDeadNotificationResponse) ProtoMessage() {}
+
+func (x *ReadDeadNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadDeadNotificationResponse.ProtoReflect.Descriptor instead.
+func (*ReadDeadNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ReadDeadNotificationResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *ReadDeadNotificationResponse) GetDeadNotification() *DeadNotification {
+	if x != nil {
+		return x.DeadNotification
+	}
+	return nil
+}
+
+type ReplayDeadNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId string `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+}
+
+func (x *ReplayDeadNotificationRequest) Reset() {
+	*x = ReplayDeadNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadNotificationRequest) ProtoMessage() {}
+
+func (x *ReplayDeadNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ReplayDeadNotificationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplayDeadNotificationRequest) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+type ReplayDeadNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status           Status            `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	DeadNotification *DeadNotification `protobuf:"bytes,2,opt,name=deadNotification,proto3" json:"deadNotification,omitempty"`
+}
+
+func (x *ReplayDeadNotificationResponse) Reset() {
+	*x = ReplayDeadNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadNotificationResponse) ProtoMessage() {}
+
+func (x *ReplayDeadNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadNotificationResponse.ProtoReflect.Descriptor instead.
+func (*ReplayDeadNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReplayDeadNotificationResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *ReplayDeadNotificationResponse) GetDeadNotification() *DeadNotification {
+	if x != nil {
+		return x.DeadNotification
+	}
+	return nil
+}
+
+type DeleteDeadNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId string `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+}
+
+func (x *DeleteDeadNotificationRequest) Reset() {
+	*x = DeleteDeadNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeadNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeadNotificationRequest) ProtoMessage() {}
+
+func (x *DeleteDeadNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeadNotificationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeadNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DeleteDeadNotificationRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteDeadNotificationRequest) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+type DeleteDeadNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status Status `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+}
+
+func (x *DeleteDeadNotificationResponse) Reset() {
+	*x = DeleteDeadNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeadNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeadNotificationResponse) ProtoMessage() {}
+
+func (x *DeleteDeadNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeadNotificationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDeadNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeleteDeadNotificationResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+type DeleteDeadNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteDeadNotificationsRequest) Reset() {
+	*x = DeleteDeadNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeadNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeadNotificationsRequest) ProtoMessage() {}
+
+func (x *DeleteDeadNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeadNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeadNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{39}
+}
+
+type DeleteDeadNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  Status `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Deleted int64  `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *DeleteDeadNotificationsResponse) Reset() {
+	*x = DeleteDeadNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeadNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeadNotificationsResponse) ProtoMessage() {}
+
+func (x *DeleteDeadNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeadNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDeadNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteDeadNotificationsResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *DeleteDeadNotificationsResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+type NotificationAttempt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId  string  `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+	Attempt    int64   `protobuf:"varint,3,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Url        string  `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	StatusCode *int32  `protobuf:"varint,5,opt,name=statusCode,proto3,oneof" json:"statusCode,omitempty"`
+	Latency    int64   `protobuf:"varint,6,opt,name=latency,proto3" json:"latency,omitempty"`
+	Body       string  `protobuf:"bytes,7,opt,name=body,proto3" json:"body,omitempty"`
+	Error      *string `protobuf:"bytes,8,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	Time       int64   `protobuf:"varint,9,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *NotificationAttempt) Reset() {
+	*x = NotificationAttempt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationAttempt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationAttempt) ProtoMessage() {}
+
+func (x *NotificationAttempt) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationAttempt.ProtoReflect.Descriptor instead.
+func (*NotificationAttempt) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *NotificationAttempt) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NotificationAttempt) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+func (x *NotificationAttempt) GetAttempt() int64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *NotificationAttempt) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *NotificationAttempt) GetStatusCode() int32 {
+	if x != nil && x.StatusCode != nil {
+		return *x.StatusCode
+	}
+	return 0
+}
+
+func (x *NotificationAttempt) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *NotificationAttempt) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NotificationAttempt) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *NotificationAttempt) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type ReadNotificationAttemptsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PromiseId string `protobuf:"bytes,2,opt,name=promiseId,proto3" json:"promiseId,omitempty"`
+	Limit     int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor    string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *ReadNotificationAttemptsRequest) Reset() {
+	*x = ReadNotificationAttemptsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadNotificationAttemptsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadNotificationAttemptsRequest) ProtoMessage() {}
+
+func (x *ReadNotificationAttemptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadNotificationAttemptsRequest.ProtoReflect.Descriptor instead.
+func (*ReadNotificationAttemptsRequest) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReadNotificationAttemptsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReadNotificationAttemptsRequest) GetPromiseId() string {
+	if x != nil {
+		return x.PromiseId
+	}
+	return ""
+}
+
+func (x *ReadNotificationAttemptsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ReadNotificationAttemptsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ReadNotificationAttemptsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   Status                 `protobuf:"varint,1,opt,name=status,proto3,enum=promise.Status" json:"status,omitempty"`
+	Cursor   string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Attempts []*NotificationAttempt `protobuf:"bytes,3,rep,name=attempts,proto3" json:"attempts,omitempty"`
+}
+
+func (x *ReadNotificationAttemptsResponse) Reset() {
+	*x = ReadNotificationAttemptsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadNotificationAttemptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadNotificationAttemptsResponse) ProtoMessage() {}
+
+func (x *ReadNotificationAttemptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadNotificationAttemptsResponse.ProtoReflect.Descriptor instead.
+func (*ReadNotificationAttemptsResponse) Descriptor() ([]byte, []int) {
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ReadNotificationAttemptsResponse) GetStatus() Status {
+	if x != nil {
+		return x.Status
+	}
+	return Status_UNKNOWN
+}
+
+func (x *ReadNotificationAttemptsResponse) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ReadNotificationAttemptsResponse) GetAttempts() []*NotificationAttempt {
+	if x != nil {
+		return x.Attempts
+	}
+	return nil
+}
+
+var File_internal_app_subsystems_api_grpc_api_promise_proto protoreflect.FileDescriptor
+
+var file_internal_app_subsystems_api_grpc_api_promise_proto_rawDesc = []byte{
+	0x0a, 0x32, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x73,
+	0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x1a, 0x1b, 0x70,
+	0x6b, 0x67, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x38, 0x0a, 0x12, 0x52, 0x65,
+	0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x77, 0x61, 0x69, 0x74, 0x22, 0x6a, 0x0a, 0x13, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x22, 0x3a, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x22, 0x94, 0x05, 0x0a,
+	0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x01, 0x71, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3c,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x67,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x27, 0x0a, 0x0c,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2b, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a,
+	0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x0f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0d,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x06, 0x73, 0x6f, 0x72, 0x74, 0x42,
+	0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x52, 0x06,
+	0x73, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x72, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x52, 0x09, 0x73, 0x6f, 0x72, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x1a, 0x37,
+	0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x66, 0x74, 0x65, 0x72, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x2c, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x22, 0x9c, 0x02,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x12, 0x18, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x3b, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x54, 0x61, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x6c, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a,
+	0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x15, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72,
+	0x69, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x12, 0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6d, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x12,
+	0x24, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x6c, 0x0a, 0x15, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x22, 0x3e, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x48, 0x00, 0x52, 0x06, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12,
+	0x37, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x22, 0x7e,
+	0x0a, 0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52,
+	0x07, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x95,
+	0x02, 0x0a, 0x20, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x12,
+	0x37, 0x0a, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x06, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x63, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x06, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xba, 0x01, 0x0a, 0x21, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x34, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x49, 0x74,
+	0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x73, 0x22, 0x7b, 0x0a, 0x23, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x73, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64,
+	0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x22, 0x95, 0x01, 0x0a, 0x24, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x73, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x22, 0xe2, 0x02, 0x0a, 0x0c, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x3c, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa3, 0x01,
+	0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x6a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x03, 0x52, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x22, 0x61, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x2a,
+	0x0a, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x66, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x99,
+	0x01, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3b, 0x0a,
+	0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf6, 0x02, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x49, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x50,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x80, 0x01, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0c, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49,
+	0x64, 0x22, 0x45, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xdf, 0x03, 0x0a, 0x10, 0x44, 0x65, 0x61,
+	0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x2e, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
+	0x61, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x36, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x4f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x4f, 0x6e, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x1c, 0x52, 0x65,
+	0x61, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x52, 0x65, 0x61,
+	0x64, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x47, 0x0a, 0x11, 0x64,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x2e, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x11, 0x64, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x1b, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49,
+	0x64, 0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x64,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x10, 0x64, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49,
+	0x64, 0x22, 0x90, 0x01, 0x0a, 0x1e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a,
+	0x10, 0x64, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x10, 0x64, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x1d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65,
+	0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x49, 0x64, 0x22, 0x49, 0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61,
+	0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x20,
+	0x0a, 0x1e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x64, 0x0a, 0x1f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x8a, 0x02, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x19, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0x7d, 0x0a, 0x1f, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x22, 0x9d, 0x01, 0x0a, 0x20, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x73, 0x2a, 0x5b, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x10,
+	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f, 0x50, 0x45, 0x4e, 0x44,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45, 0x41, 0x52, 0x43, 0x48, 0x5f,
+	0x52, 0x45, 0x53, 0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x45,
+	0x41, 0x52, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x50, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x72, 0x74, 0x42, 0x79, 0x12,
+	0x13, 0x0a, 0x0f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x4f, 0x52, 0x54, 0x5f,
+	0x49, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f,
+	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f,
+	0x53, 0x4f, 0x52, 0x54, 0x5f, 0x42, 0x59, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10,
+	0x02, 0x2a, 0x3a, 0x0a, 0x0f, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x53, 0x6f, 0x72, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x53, 0x4f, 0x52, 0x54, 0x5f, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x4f, 0x52,
+	0x54, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x2a, 0x6a, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0xc8, 0x01, 0x12, 0x0c, 0x0a,
+	0x07, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0xc9, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x4e,
+	0x4f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x10, 0xcc, 0x01, 0x12, 0x0e, 0x0a, 0x09, 0x46,
+	0x4f, 0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x93, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x4e,
+	0x4f, 0x54, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x94, 0x03, 0x12, 0x0d, 0x0a, 0x08, 0x43, 0x4f,
+	0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x99, 0x03, 0x32, 0xf3, 0x06, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x0b,
+	0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x53, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x73, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12, 0x1e, 0x2e, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x50, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50,
+	0x0a, 0x0d, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x12,
+	0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x50,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x38, 0x0a, 0x05, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x6d,
+	0x69, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x19, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6e, 0x64, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x7d, 0x0a, 0x1c, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73,
+	0x42, 0x79, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79,
+	0x12, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x73, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x50, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x73, 0x42, 0x79, 0x49, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xb5, 0x02, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5c, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x64, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x72,
+	0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70,
+	0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xa3, 0x05, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x68, 0x0a, 0x15, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69,
+	0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65,
+	0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x65, 0x0a, 0x14, 0x52, 0x65, 0x61,
+	0x64, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x6b, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f,
+	0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a,
+	0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x17, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x18, 0x52, 0x65,
+	0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x6d, 0x69, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x74, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x45, 0x5a,
+	0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x73, 0x6f,
+	0x6e, 0x61, 0x74, 0x65, 0x68, 0x71, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6e, 0x61, 0x74, 0x65, 0x2f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x61, 0x70, 0x70, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x61, 0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescOnce sync.Once
+	file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData = file_internal_app_subsystems_api_grpc_api_promise_proto_rawDesc
+)
+
+func file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescGZIP() []byte {
+	file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescOnce.Do(func() {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData)
+	})
+	return file_internal_app_subsystems_api_grpc_api_promise_proto_rawDescData
+}
+
+var file_internal_app_subsystems_api_grpc_api_promise_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
+var file_internal_app_subsystems_api_grpc_api_promise_proto_goTypes = []interface{}{
+	(SearchState)(0),                             // 0: promise.SearchState
+	(SearchSortBy)(0),                            // 1: promise.SearchSortBy
+	(SearchSortOrder)(0),                         // 2: promise.SearchSortOrder
+	(Status)(0),                                  // 3: promise.Status
+	(*ReadPromiseRequest)(nil),                   // 4: promise.ReadPromiseRequest
+	(*ReadPromiseResponse)(nil),                  // 5: promise.ReadPromiseResponse
+	(*PromiseEvent)(nil),                         // 6: promise.PromiseEvent
+	(*SearchPromisesRequest)(nil),                // 7: promise.SearchPromisesRequest
+	(*SearchPromisesResponse)(nil),               // 8: promise.SearchPromisesResponse
+	(*CreatePromiseRequest)(nil),                 // 9: promise.CreatePromiseRequest
+	(*CreatePromiseResponse)(nil),                // 10: promise.CreatePromiseResponse
+	(*CancelPromiseRequest)(nil),                 // 11: promise.CancelPromiseRequest
+	(*CancelPromiseResponse)(nil),                // 12: promise.CancelPromiseResponse
+	(*ResolvePromiseRequest)(nil),                // 13: promise.ResolvePromiseRequest
+	(*ResolvePromiseResponse)(nil),               // 14: promise.ResolvePromiseResponse
+	(*RejectPromiseRequest)(nil),                 // 15: promise.RejectPromiseRequest
+	(*RejectPromiseResponse)(nil),                // 16: promise.RejectPromiseResponse
+	(*BatchRequest)(nil),                         // 17: promise.BatchRequest
+	(*BatchItem)(nil),                            // 18: promise.BatchItem
+	(*BatchResponse)(nil),                        // 19: promise.BatchResponse
+	(*BatchItemResponse)(nil),                    // 20: promise.BatchItemResponse
+	(*CompleteAndCreatePromisesRequest)(nil),     // 21: promise.CompleteAndCreatePromisesRequest
+	(*CompleteAndCreatePromisesResponse)(nil),    // 22: promise.CompleteAndCreatePromisesResponse
+	(*ReadPromisesByIdempotencyKeyRequest)(nil),  // 23: promise.ReadPromisesByIdempotencyKeyRequest
+	(*ReadPromisesByIdempotencyKeyResponse)(nil), // 24: promise.ReadPromisesByIdempotencyKeyResponse
+	(*Subscription)(nil),                         // 25: promise.Subscription
+	(*RetryPolicy)(nil),                          // 26: promise.RetryPolicy
+	(*Payload)(nil),                              // 27: promise.Payload
+	(*ReadSubscriptionsRequest)(nil),             // 28: promise.ReadSubscriptionsRequest
+	(*ReadSubscriptionsResponse)(nil),            // 29: promise.ReadSubscriptionsResponse
+	(*CreateSubscriptionRequest)(nil),            // 30: promise.CreateSubscriptionRequest
+	(*CreateSubscriptionResponse)(nil),           // 31: promise.CreateSubscriptionResponse
+	(*DeleteSubscriptionRequest)(nil),            // 32: promise.DeleteSubscriptionRequest
+	(*DeleteSubscriptionResponse)(nil),           // 33: promise.DeleteSubscriptionResponse
+	(*DeadNotification)(nil),                     // 34: promise.DeadNotification
+	(*ReadDeadNotificationsRequest)(nil),         // 35: promise.ReadDeadNotificationsRequest
+	(*ReadDeadNotificationsResponse)(nil),        // 36: promise.ReadDeadNotificationsResponse
+	(*ReadDeadNotificationRequest)(nil),          // 37: promise.ReadDeadNotificationRequest
+	(*ReadDeadNotificationResponse)(nil),         // 38: promise.ReadDeadNotificationResponse
+	(*ReplayDeadNotificationRequest)(nil),        // 39: promise.ReplayDeadNotificationRequest
+	(*ReplayDeadNotificationResponse)(nil),       // 40: promise.ReplayDeadNotificationResponse
+	(*DeleteDeadNotificationRequest)(nil),        // 41: promise.DeleteDeadNotificationRequest
+	(*DeleteDeadNotificationResponse)(nil),       // 42: promise.DeleteDeadNotificationResponse
+	(*DeleteDeadNotificationsRequest)(nil),       // 43: promise.DeleteDeadNotificationsRequest
+	(*DeleteDeadNotificationsResponse)(nil),      // 44: promise.DeleteDeadNotificationsResponse
+	(*NotificationAttempt)(nil),                  // 45: promise.NotificationAttempt
+	(*ReadNotificationAttemptsRequest)(nil),      // 46: promise.ReadNotificationAttemptsRequest
+	(*ReadNotificationAttemptsResponse)(nil),     // 47: promise.ReadNotificationAttemptsResponse
+	nil,                                          // 48: promise.SearchPromisesRequest.TagsEntry
+	nil,                                          // 49: promise.CreatePromiseRequest.TagsEntry
+	nil,                                          // 50: promise.Subscription.HeadersEntry
+	nil,                                          // 51: promise.CreateSubscriptionRequest.HeadersEntry
+	nil,                                          // 52: promise.DeadNotification.HeadersEntry
+	(*receiver.Promise)(nil),                     // 53: promise.Promise
+	(*receiver.Value)(nil),                       // 54: promise.Value
+}
+var file_internal_app_subsystems_api_grpc_api_promise_proto_depIdxs = []int32{
+	3,  // 0: promise.ReadPromiseResponse.status:type_name -> promise.Status
+	53, // 1: promise.ReadPromiseResponse.promise:type_name -> promise.Promise
+	53, // 2: promise.PromiseEvent.promise:type_name -> promise.Promise
+	0,  // 3: promise.SearchPromisesRequest.state:type_name -> promise.SearchState
+	48, // 4: promise.SearchPromisesRequest.tags:type_name -> promise.SearchPromisesRequest.TagsEntry
+	1,  // 5: promise.SearchPromisesRequest.sortBy:type_name -> promise.SearchSortBy
+	2,  // 6: promise.SearchPromisesRequest.sortOrder:type_name -> promise.SearchSortOrder
+	3,  // 7: promise.SearchPromisesResponse.status:type_name -> promise.Status
+	53, // 8: promise.SearchPromisesResponse.promises:type_name -> promise.Promise
+	54, // 9: promise.CreatePromiseRequest.param:type_name -> promise.Value
+	49, // 10: promise.CreatePromiseRequest.tags:type_name -> promise.CreatePromiseRequest.TagsEntry
+	3,  // 11: promise.CreatePromiseResponse.status:type_name -> promise.Status
+	53, // 12: promise.CreatePromiseResponse.promise:type_name -> promise.Promise
+	54, // 13: promise.CancelPromiseRequest.value:type_name -> promise.Value
+	3,  // 14: promise.CancelPromiseResponse.status:type_name -> promise.Status
+	53, // 15: promise.CancelPromiseResponse.promise:type_name -> promise.Promise
+	54, // 16: promise.ResolvePromiseRequest.value:type_name -> promise.Value
+	3,  // 17: promise.ResolvePromiseResponse.status:type_name -> promise.Status
+	53, // 18: promise.ResolvePromiseResponse.promise:type_name -> promise.Promise
+	54, // 19: promise.RejectPromiseRequest.value:type_name -> promise.Value
+	3,  // 20: promise.RejectPromiseResponse.status:type_name -> promise.Status
+	53, // 21: promise.RejectPromiseResponse.promise:type_name -> promise.Promise
+	18, // 22: promise.BatchRequest.requests:type_name -> promise.BatchItem
+	9,  // 23: promise.BatchItem.create:type_name -> promise.CreatePromiseRequest
+	11, // 24: promise.BatchItem.cancel:type_name -> promise.CancelPromiseRequest
+	13, // 25: promise.BatchItem.resolve:type_name -> promise.ResolvePromiseRequest
+	15, // 26: promise.BatchItem.reject:type_name -> promise.RejectPromiseRequest
+	20, // 27: promise.BatchResponse.responses:type_name -> promise.BatchItemResponse
+	3,  // 28: promise.BatchItemResponse.status:type_name -> promise.Status
+	53, // 29: promise.BatchItemResponse.promise:type_name -> promise.Promise
+	13, // 30: promise.CompleteAndCreatePromisesRequest.resolve:type_name -> promise.ResolvePromiseRequest
+	15, // 31: promise.CompleteAndCreatePromisesRequest.reject:type_name -> promise.RejectPromiseRequest
+	11, // 32: promise.CompleteAndCreatePromisesRequest.cancel:type_name -> promise.CancelPromiseRequest
+	9,  // 33: promise.CompleteAndCreatePromisesRequest.creates:type_name -> promise.CreatePromiseRequest
+	3,  // 34: promise.CompleteAndCreatePromisesResponse.status:type_name -> promise.Status
+	20, // 35: promise.CompleteAndCreatePromisesResponse.complete:type_name -> promise.BatchItemResponse
+	20, // 36: promise.CompleteAndCreatePromisesResponse.creates:type_name -> promise.BatchItemResponse
+	3,  // 37: promise.ReadPromisesByIdempotencyKeyResponse.status:type_name -> promise.Status
+	53, // 38: promise.ReadPromisesByIdempotencyKeyResponse.promises:type_name -> promise.Promise
+	26, // 39: promise.Subscription.retryPolicy:type_name -> promise.RetryPolicy
+	50, // 40: promise.Subscription.headers:type_name -> promise.Subscription.HeadersEntry
+	27, // 41: promise.Subscription.payload:type_name -> promise.Payload
+	3,  // 42: promise.ReadSubscriptionsResponse.status:type_name -> promise.Status
+	25, // 43: promise.ReadSubscriptionsResponse.subscriptions:type_name -> promise.Subscription
+	26, // 44: promise.CreateSubscriptionRequest.retryPolicy:type_name -> promise.RetryPolicy
+	51, // 45: promise.CreateSubscriptionRequest.headers:type_name -> promise.CreateSubscriptionRequest.HeadersEntry
+	27, // 46: promise.CreateSubscriptionRequest.payload:type_name -> promise.Payload
+	3,  // 47: promise.CreateSubscriptionResponse.status:type_name -> promise.Status
+	25, // 48: promise.CreateSubscriptionResponse.subscription:type_name -> promise.Subscription
+	3,  // 49: promise.DeleteSubscriptionResponse.status:type_name -> promise.Status
+	52, // 50: promise.DeadNotification.headers:type_name -> promise.DeadNotification.HeadersEntry
+	27, // 51: promise.DeadNotification.payload:type_name -> promise.Payload
+	26, // 52: promise.DeadNotification.retryPolicy:type_name -> promise.RetryPolicy
+	3,  // 53: promise.ReadDeadNotificationsResponse.status:type_name -> promise.Status
+	34, // 54: promise.ReadDeadNotificationsResponse.deadNotifications:type_name -> promise.DeadNotification
+	3,  // 55: promise.ReadDeadNotificationResponse.status:type_name -> promise.Status
+	34, // 56: promise.ReadDeadNotificationResponse.deadNotification:type_name -> promise.DeadNotification
+	3,  // 57: promise.ReplayDeadNotificationResponse.status:type_name -> promise.Status
+	34, // 58: promise.ReplayDeadNotificationResponse.deadNotification:type_name -> promise.DeadNotification
+	3,  // 59: promise.DeleteDeadNotificationResponse.status:type_name -> promise.Status
+	3,  // 60: promise.DeleteDeadNotificationsResponse.status:type_name -> promise.Status
+	3,  // 61: promise.ReadNotificationAttemptsResponse.status:type_name -> promise.Status
+	45, // 62: promise.ReadNotificationAttemptsResponse.attempts:type_name -> promise.NotificationAttempt
+	4,  // 63: promise.PromiseService.ReadPromise:input_type -> promise.ReadPromiseRequest
+	4,  // 64: promise.PromiseService.WatchPromise:input_type -> promise.ReadPromiseRequest
+	7,  // 65: promise.PromiseService.SearchPromises:input_type -> promise.SearchPromisesRequest
+	9,  // 66: promise.PromiseService.CreatePromise:input_type -> promise.CreatePromiseRequest
+	11, // 67: promise.PromiseService.CancelPromise:input_type -> promise.CancelPromiseRequest
+	13, // 68: promise.PromiseService.ResolvePromise:input_type -> promise.ResolvePromiseRequest
+	15, // 69: promise.PromiseService.RejectPromise:input_type -> promise.RejectPromiseRequest
+	17, // 70: promise.PromiseService.Batch:input_type -> promise.BatchRequest
+	21, // 71: promise.PromiseService.CompleteAndCreatePromises:input_type -> promise.CompleteAndCreatePromisesRequest
+	23, // 72: promise.PromiseService.ReadPromisesByIdempotencyKey:input_type -> promise.ReadPromisesByIdempotencyKeyRequest
+	28, // 73: promise.SubscriptionService.ReadSubscriptions:input_type -> promise.ReadSubscriptionsRequest
+	30, // 74: promise.SubscriptionService.CreateSubscription:input_type -> promise.CreateSubscriptionRequest
+	32, // 75: promise.SubscriptionService.DeleteSubscription:input_type -> promise.DeleteSubscriptionRequest
+	35, // 76: promise.NotificationService.ReadDeadNotifications:input_type -> promise.ReadDeadNotificationsRequest
+	37, // 77: promise.NotificationService.ReadDeadNotification:input_type -> promise.ReadDeadNotificationRequest
+	39, // 78: promise.NotificationService.ReplayDeadNotification:input_type -> promise.ReplayDeadNotificationRequest
+	41, // 79: promise.NotificationService.DeleteDeadNotification:input_type -> promise.DeleteDeadNotificationRequest
+	43, // 80: promise.NotificationService.DeleteDeadNotifications:input_type -> promise.DeleteDeadNotificationsRequest
+	46, // 81: promise.NotificationService.ReadNotificationAttempts:input_type -> promise.ReadNotificationAttemptsRequest
+	5,  // 82: promise.PromiseService.ReadPromise:output_type -> promise.ReadPromiseResponse
+	6,  // 83: promise.PromiseService.WatchPromise:output_type -> promise.PromiseEvent
+	8,  // 84: promise.PromiseService.SearchPromises:output_type -> promise.SearchPromisesResponse
+	10, // 85: promise.PromiseService.CreatePromise:output_type -> promise.CreatePromiseResponse
+	12, // 86: promise.PromiseService.CancelPromise:output_type -> promise.CancelPromiseResponse
+	14, // 87: promise.PromiseService.ResolvePromise:output_type -> promise.ResolvePromiseResponse
+	16, // 88: promise.PromiseService.RejectPromise:output_type -> promise.RejectPromiseResponse
+	19, // 89: promise.PromiseService.Batch:output_type -> promise.BatchResponse
+	22, // 90: promise.PromiseService.CompleteAndCreatePromises:output_type -> promise.CompleteAndCreatePromisesResponse
+	24, // 91: promise.PromiseService.ReadPromisesByIdempotencyKey:output_type -> promise.ReadPromisesByIdempotencyKeyResponse
+	29, // 92: promise.SubscriptionService.ReadSubscriptions:output_type -> promise.ReadSubscriptionsResponse
+	31, // 93: promise.SubscriptionService.CreateSubscription:output_type -> promise.CreateSubscriptionResponse
+	33, // 94: promise.SubscriptionService.DeleteSubscription:output_type -> promise.DeleteSubscriptionResponse
+	36, // 95: promise.NotificationService.ReadDeadNotifications:output_type -> promise.ReadDeadNotificationsResponse
+	38, // 96: promise.NotificationService.ReadDeadNotification:output_type -> promise.ReadDeadNotificationResponse
+	40, // 97: promise.NotificationService.ReplayDeadNotification:output_type -> promise.ReplayDeadNotificationResponse
+	42, // 98: promise.NotificationService.DeleteDeadNotification:output_type -> promise.DeleteDeadNotificationResponse
+	44, // 99: promise.NotificationService.DeleteDeadNotifications:output_type -> promise.DeleteDeadNotificationsResponse
+	47, // 100: promise.NotificationService.ReadNotificationAttempts:output_type -> promise.ReadNotificationAttemptsResponse
+	82, // [82:101] is the sub-list for method output_type
+	63, // [63:82] is the sub-list for method input_type
+	63, // [63:63] is the sub-list for extension type_name
+	63, // [63:63] is the sub-list for extension extendee
+	0,  // [0:63] is the sub-list for field type_name
+}
+
+func init() { file_internal_app_subsystems_api_grpc_api_promise_proto_init() }
+func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
+	if File_internal_app_subsystems_api_grpc_api_promise_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPromiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPromiseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PromiseEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchPromisesRequest); i {
 			case 0:
 				return &v.state
@@ -1410,8 +4023,104 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchPromisesResponse); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchPromisesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePromiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePromiseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPromiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelPromiseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolvePromiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolvePromiseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectPromiseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectPromiseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1422,8 +4131,8 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePromiseRequest); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1434,8 +4143,8 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePromiseResponse); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchItem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1446,8 +4155,8 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelPromiseRequest); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1458,8 +4167,8 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CancelPromiseResponse); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchItemResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1470,8 +4179,8 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolvePromiseRequest); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteAndCreatePromisesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1482,8 +4191,8 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResolvePromiseResponse); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompleteAndCreatePromisesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1494,8 +4203,8 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RejectPromiseRequest); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPromisesByIdempotencyKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1506,8 +4215,248 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
-		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RejectPromiseResponse); i {
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadPromisesByIdempotencyKeyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Subscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadNotification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeadNotificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeadNotificationsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeadNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadDeadNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeadNotificationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeadNotificationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeadNotificationsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeadNotificationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1518,16 +4467,66 @@ func file_internal_app_subsystems_api_grpc_api_promise_proto_init() {
 				return nil
 			}
 		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationAttempt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotificationAttemptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotificationAttemptsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[14].OneofWrappers = []interface{}{
+		(*BatchItem_Create)(nil),
+		(*BatchItem_Cancel)(nil),
+		(*BatchItem_Resolve)(nil),
+		(*BatchItem_Reject)(nil),
+	}
+	file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*CompleteAndCreatePromisesRequest_Resolve)(nil),
+		(*CompleteAndCreatePromisesRequest_Reject)(nil),
+		(*CompleteAndCreatePromisesRequest_Cancel)(nil),
 	}
+	file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[30].OneofWrappers = []interface{}{}
+	file_internal_app_subsystems_api_grpc_api_promise_proto_msgTypes[41].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_app_subsystems_api_grpc_api_promise_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   15,
+			NumEnums:      4,
+			NumMessages:   49,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   3,
 		},
 		GoTypes:           file_internal_app_subsystems_api_grpc_api_promise_proto_goTypes,
 		DependencyIndexes: file_internal_app_subsystems_api_grpc_api_promise_proto_depIdxs,
@@ -19,12 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	PromiseService_ReadPromise_FullMethodName    = "/promise.PromiseService/ReadPromise"
-	PromiseService_SearchPromises_FullMethodName = "/promise.PromiseService/SearchPromises"
-	PromiseService_CreatePromise_FullMethodName  = "/promise.PromiseService/CreatePromise"
-	PromiseService_CancelPromise_FullMethodName  = "/promise.PromiseService/CancelPromise"
-	PromiseService_ResolvePromise_FullMethodName = "/promise.PromiseService/ResolvePromise"
-	PromiseService_RejectPromise_FullMethodName  = "/promise.PromiseService/RejectPromise"
+	PromiseService_ReadPromise_FullMethodName                  = "/promise.PromiseService/ReadPromise"
+	PromiseService_WatchPromise_FullMethodName                 = "/promise.PromiseService/WatchPromise"
+	PromiseService_SearchPromises_FullMethodName               = "/promise.PromiseService/SearchPromises"
+	PromiseService_CreatePromise_FullMethodName                = "/promise.PromiseService/CreatePromise"
+	PromiseService_CancelPromise_FullMethodName                = "/promise.PromiseService/CancelPromise"
+	PromiseService_ResolvePromise_FullMethodName               = "/promise.PromiseService/ResolvePromise"
+	PromiseService_RejectPromise_FullMethodName                = "/promise.PromiseService/RejectPromise"
+	PromiseService_Batch_FullMethodName                        = "/promise.PromiseService/Batch"
+	PromiseService_CompleteAndCreatePromises_FullMethodName    = "/promise.PromiseService/CompleteAndCreatePromises"
+	PromiseService_ReadPromisesByIdempotencyKey_FullMethodName = "/promise.PromiseService/ReadPromisesByIdempotencyKey"
 )
 
 // PromiseServiceClient is the client API for PromiseService service.
@@ -33,11 +37,15 @@ const (
 type PromiseServiceClient interface {
 	// Promise
 	ReadPromise(ctx context.Context, in *ReadPromiseRequest, opts ...grpc.CallOption) (*ReadPromiseResponse, error)
+	WatchPromise(ctx context.Context, in *ReadPromiseRequest, opts ...grpc.CallOption) (PromiseService_WatchPromiseClient, error)
 	SearchPromises(ctx context.Context, in *SearchPromisesRequest, opts ...grpc.CallOption) (*SearchPromisesResponse, error)
 	CreatePromise(ctx context.Context, in *CreatePromiseRequest, opts ...grpc.CallOption) (*CreatePromiseResponse, error)
 	CancelPromise(ctx context.Context, in *CancelPromiseRequest, opts ...grpc.CallOption) (*CancelPromiseResponse, error)
 	ResolvePromise(ctx context.Context, in *ResolvePromiseRequest, opts ...grpc.CallOption) (*ResolvePromiseResponse, error)
 	RejectPromise(ctx context.Context, in *RejectPromiseRequest, opts ...grpc.CallOption) (*RejectPromiseResponse, error)
+	Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error)
+	CompleteAndCreatePromises(ctx context.Context, in *CompleteAndCreatePromisesRequest, opts ...grpc.CallOption) (*CompleteAndCreatePromisesResponse, error)
+	ReadPromisesByIdempotencyKey(ctx context.Context, in *ReadPromisesByIdempotencyKeyRequest, opts ...grpc.CallOption) (*ReadPromisesByIdempotencyKeyResponse, error)
 }
 
 type promiseServiceClient struct {
@@ -57,6 +65,38 @@ func (c *promiseServiceClient) ReadPromise(ctx context.Context, in *ReadPromiseR
 	return out, nil
 }
 
+func (c *promiseServiceClient) WatchPromise(ctx context.Context, in *ReadPromiseRequest, opts ...grpc.CallOption) (PromiseService_WatchPromiseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PromiseService_ServiceDesc.Streams[0], PromiseService_WatchPromise_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &promiseServiceWatchPromiseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PromiseService_WatchPromiseClient interface {
+	Recv() (*PromiseEvent, error)
+	grpc.ClientStream
+}
+
+type promiseServiceWatchPromiseClient struct {
+	grpc.ClientStream
+}
+
+func (x *promiseServiceWatchPromiseClient) Recv() (*PromiseEvent, error) {
+	m := new(PromiseEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *promiseServiceClient) SearchPromises(ctx context.Context, in *SearchPromisesRequest, opts ...grpc.CallOption) (*SearchPromisesResponse, error) {
 	out := new(SearchPromisesResponse)
 	err := c.cc.Invoke(ctx, PromiseService_SearchPromises_FullMethodName, in, out, opts...)
@@ -102,17 +142,48 @@ func (c *promiseServiceClient) RejectPromise(ctx context.Context, in *RejectProm
 	return out, nil
 }
 
+func (c *promiseServiceClient) Batch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*BatchResponse, error) {
+	out := new(BatchResponse)
+	err := c.cc.Invoke(ctx, PromiseService_Batch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promiseServiceClient) CompleteAndCreatePromises(ctx context.Context, in *CompleteAndCreatePromisesRequest, opts ...grpc.CallOption) (*CompleteAndCreatePromisesResponse, error) {
+	out := new(CompleteAndCreatePromisesResponse)
+	err := c.cc.Invoke(ctx, PromiseService_CompleteAndCreatePromises_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *promiseServiceClient) ReadPromisesByIdempotencyKey(ctx context.Context, in *ReadPromisesByIdempotencyKeyRequest, opts ...grpc.CallOption) (*ReadPromisesByIdempotencyKeyResponse, error) {
+	out := new(ReadPromisesByIdempotencyKeyResponse)
+	err := c.cc.Invoke(ctx, PromiseService_ReadPromisesByIdempotencyKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PromiseServiceServer is the server API for PromiseService service.
 // All implementations must embed UnimplementedPromiseServiceServer
 // for forward compatibility
 type PromiseServiceServer interface {
 	// Promise
 	ReadPromise(context.Context, *ReadPromiseRequest) (*ReadPromiseResponse, error)
+	WatchPromise(*ReadPromiseRequest, PromiseService_WatchPromiseServer) error
 	SearchPromises(context.Context, *SearchPromisesRequest) (*SearchPromisesResponse, error)
 	CreatePromise(context.Context, *CreatePromiseRequest) (*CreatePromiseResponse, error)
 	CancelPromise(context.Context, *CancelPromiseRequest) (*CancelPromiseResponse, error)
 	ResolvePromise(context.Context, *ResolvePromiseRequest) (*ResolvePromiseResponse, error)
 	RejectPromise(context.Context, *RejectPromiseRequest) (*RejectPromiseResponse, error)
+	Batch(context.Context, *BatchRequest) (*BatchResponse, error)
+	CompleteAndCreatePromises(context.Context, *CompleteAndCreatePromisesRequest) (*CompleteAndCreatePromisesResponse, error)
+	ReadPromisesByIdempotencyKey(context.Context, *ReadPromisesByIdempotencyKeyRequest) (*ReadPromisesByIdempotencyKeyResponse, error)
 	mustEmbedUnimplementedPromiseServiceServer()
 }
 
@@ -123,6 +194,9 @@ type UnimplementedPromiseServiceServer struct {
 func (UnimplementedPromiseServiceServer) ReadPromise(context.Context, *ReadPromiseRequest) (*ReadPromiseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadPromise not implemented")
 }
+func (UnimplementedPromiseServiceServer) WatchPromise(*ReadPromiseRequest, PromiseService_WatchPromiseServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPromise not implemented")
+}
 func (UnimplementedPromiseServiceServer) SearchPromises(context.Context, *SearchPromisesRequest) (*SearchPromisesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchPromises not implemented")
 }
@@ -138,6 +212,15 @@ func (UnimplementedPromiseServiceServer) ResolvePromise(context.Context, *Resolv
 func (UnimplementedPromiseServiceServer) RejectPromise(context.Context, *RejectPromiseRequest) (*RejectPromiseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectPromise not implemented")
 }
+func (UnimplementedPromiseServiceServer) Batch(context.Context, *BatchRequest) (*BatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedPromiseServiceServer) CompleteAndCreatePromises(context.Context, *CompleteAndCreatePromisesRequest) (*CompleteAndCreatePromisesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteAndCreatePromises not implemented")
+}
+func (UnimplementedPromiseServiceServer) ReadPromisesByIdempotencyKey(context.Context, *ReadPromisesByIdempotencyKeyRequest) (*ReadPromisesByIdempotencyKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadPromisesByIdempotencyKey not implemented")
+}
 func (UnimplementedPromiseServiceServer) mustEmbedUnimplementedPromiseServiceServer() {}
 
 // UnsafePromiseServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -169,6 +252,27 @@ func _PromiseService_ReadPromise_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PromiseService_WatchPromise_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadPromiseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PromiseServiceServer).WatchPromise(m, &promiseServiceWatchPromiseServer{stream})
+}
+
+type PromiseService_WatchPromiseServer interface {
+	Send(*PromiseEvent) error
+	grpc.ServerStream
+}
+
+type promiseServiceWatchPromiseServer struct {
+	grpc.ServerStream
+}
+
+func (x *promiseServiceWatchPromiseServer) Send(m *PromiseEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _PromiseService_SearchPromises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchPromisesRequest)
 	if err := dec(in); err != nil {
@@ -259,6 +363,60 @@ func _PromiseService_RejectPromise_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PromiseService_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromiseServiceServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromiseService_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromiseServiceServer).Batch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromiseService_CompleteAndCreatePromises_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteAndCreatePromisesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromiseServiceServer).CompleteAndCreatePromises(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromiseService_CompleteAndCreatePromises_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromiseServiceServer).CompleteAndCreatePromises(ctx, req.(*CompleteAndCreatePromisesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PromiseService_ReadPromisesByIdempotencyKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadPromisesByIdempotencyKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PromiseServiceServer).ReadPromisesByIdempotencyKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PromiseService_ReadPromisesByIdempotencyKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PromiseServiceServer).ReadPromisesByIdempotencyKey(ctx, req.(*ReadPromisesByIdempotencyKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PromiseService_ServiceDesc is the grpc.ServiceDesc for PromiseService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -290,6 +448,467 @@ var PromiseService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RejectPromise",
 			Handler:    _PromiseService_RejectPromise_Handler,
 		},
+		{
+			MethodName: "Batch",
+			Handler:    _PromiseService_Batch_Handler,
+		},
+		{
+			MethodName: "CompleteAndCreatePromises",
+			Handler:    _PromiseService_CompleteAndCreatePromises_Handler,
+		},
+		{
+			MethodName: "ReadPromisesByIdempotencyKey",
+			Handler:    _PromiseService_ReadPromisesByIdempotencyKey_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPromise",
+			Handler:       _PromiseService_WatchPromise_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "internal/app/subsystems/api/grpc/api/promise.proto",
+}
+
+const (
+	SubscriptionService_ReadSubscriptions_FullMethodName  = "/promise.SubscriptionService/ReadSubscriptions"
+	SubscriptionService_CreateSubscription_FullMethodName = "/promise.SubscriptionService/CreateSubscription"
+	SubscriptionService_DeleteSubscription_FullMethodName = "/promise.SubscriptionService/DeleteSubscription"
+)
+
+// SubscriptionServiceClient is the client API for SubscriptionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SubscriptionServiceClient interface {
+	// Subscription
+	ReadSubscriptions(ctx context.Context, in *ReadSubscriptionsRequest, opts ...grpc.CallOption) (*ReadSubscriptionsResponse, error)
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*CreateSubscriptionResponse, error)
+	DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error)
+}
+
+type subscriptionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSubscriptionServiceClient(cc grpc.ClientConnInterface) SubscriptionServiceClient {
+	return &subscriptionServiceClient{cc}
+}
+
+func (c *subscriptionServiceClient) ReadSubscriptions(ctx context.Context, in *ReadSubscriptionsRequest, opts ...grpc.CallOption) (*ReadSubscriptionsResponse, error) {
+	out := new(ReadSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_ReadSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*CreateSubscriptionResponse, error) {
+	out := new(CreateSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_CreateSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *subscriptionServiceClient) DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error) {
+	out := new(DeleteSubscriptionResponse)
+	err := c.cc.Invoke(ctx, SubscriptionService_DeleteSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SubscriptionServiceServer is the server API for SubscriptionService service.
+// All implementations must embed UnimplementedSubscriptionServiceServer
+// for forward compatibility
+type SubscriptionServiceServer interface {
+	// Subscription
+	ReadSubscriptions(context.Context, *ReadSubscriptionsRequest) (*ReadSubscriptionsResponse, error)
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error)
+	DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error)
+	mustEmbedUnimplementedSubscriptionServiceServer()
+}
+
+// UnimplementedSubscriptionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedSubscriptionServiceServer struct {
+}
+
+func (UnimplementedSubscriptionServiceServer) ReadSubscriptions(context.Context, *ReadSubscriptionsRequest) (*ReadSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadSubscriptions not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*CreateSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
+func (UnimplementedSubscriptionServiceServer) mustEmbedUnimplementedSubscriptionServiceServer() {}
+
+// UnsafeSubscriptionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SubscriptionServiceServer will
+// result in compilation errors.
+type UnsafeSubscriptionServiceServer interface {
+	mustEmbedUnimplementedSubscriptionServiceServer()
+}
+
+func RegisterSubscriptionServiceServer(s grpc.ServiceRegistrar, srv SubscriptionServiceServer) {
+	s.RegisterService(&SubscriptionService_ServiceDesc, srv)
+}
+
+func _SubscriptionService_ReadSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).ReadSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_ReadSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).ReadSubscriptions(ctx, req.(*ReadSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SubscriptionService_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SubscriptionServiceServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SubscriptionService_DeleteSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SubscriptionServiceServer).DeleteSubscription(ctx, req.(*DeleteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SubscriptionService_ServiceDesc is the grpc.ServiceDesc for SubscriptionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SubscriptionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "promise.SubscriptionService",
+	HandlerType: (*SubscriptionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadSubscriptions",
+			Handler:    _SubscriptionService_ReadSubscriptions_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _SubscriptionService_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _SubscriptionService_DeleteSubscription_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/app/subsystems/api/grpc/api/promise.proto",
+}
+
+const (
+	NotificationService_ReadDeadNotifications_FullMethodName    = "/promise.NotificationService/ReadDeadNotifications"
+	NotificationService_ReadDeadNotification_FullMethodName     = "/promise.NotificationService/ReadDeadNotification"
+	NotificationService_ReplayDeadNotification_FullMethodName   = "/promise.NotificationService/ReplayDeadNotification"
+	NotificationService_DeleteDeadNotification_FullMethodName   = "/promise.NotificationService/DeleteDeadNotification"
+	NotificationService_DeleteDeadNotifications_FullMethodName  = "/promise.NotificationService/DeleteDeadNotifications"
+	NotificationService_ReadNotificationAttempts_FullMethodName = "/promise.NotificationService/ReadNotificationAttempts"
+)
+
+// NotificationServiceClient is the client API for NotificationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationServiceClient interface {
+	// Notification
+	ReadDeadNotifications(ctx context.Context, in *ReadDeadNotificationsRequest, opts ...grpc.CallOption) (*ReadDeadNotificationsResponse, error)
+	ReadDeadNotification(ctx context.Context, in *ReadDeadNotificationRequest, opts ...grpc.CallOption) (*ReadDeadNotificationResponse, error)
+	ReplayDeadNotification(ctx context.Context, in *ReplayDeadNotificationRequest, opts ...grpc.CallOption) (*ReplayDeadNotificationResponse, error)
+	DeleteDeadNotification(ctx context.Context, in *DeleteDeadNotificationRequest, opts ...grpc.CallOption) (*DeleteDeadNotificationResponse, error)
+	DeleteDeadNotifications(ctx context.Context, in *DeleteDeadNotificationsRequest, opts ...grpc.CallOption) (*DeleteDeadNotificationsResponse, error)
+	ReadNotificationAttempts(ctx context.Context, in *ReadNotificationAttemptsRequest, opts ...grpc.CallOption) (*ReadNotificationAttemptsResponse, error)
+}
+
+type notificationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationServiceClient(cc grpc.ClientConnInterface) NotificationServiceClient {
+	return &notificationServiceClient{cc}
+}
+
+func (c *notificationServiceClient) ReadDeadNotifications(ctx context.Context, in *ReadDeadNotificationsRequest, opts ...grpc.CallOption) (*ReadDeadNotificationsResponse, error) {
+	out := new(ReadDeadNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ReadDeadNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ReadDeadNotification(ctx context.Context, in *ReadDeadNotificationRequest, opts ...grpc.CallOption) (*ReadDeadNotificationResponse, error) {
+	out := new(ReadDeadNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ReadDeadNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ReplayDeadNotification(ctx context.Context, in *ReplayDeadNotificationRequest, opts ...grpc.CallOption) (*ReplayDeadNotificationResponse, error) {
+	out := new(ReplayDeadNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ReplayDeadNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteDeadNotification(ctx context.Context, in *DeleteDeadNotificationRequest, opts ...grpc.CallOption) (*DeleteDeadNotificationResponse, error) {
+	out := new(DeleteDeadNotificationResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteDeadNotification_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteDeadNotifications(ctx context.Context, in *DeleteDeadNotificationsRequest, opts ...grpc.CallOption) (*DeleteDeadNotificationsResponse, error) {
+	out := new(DeleteDeadNotificationsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_DeleteDeadNotifications_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ReadNotificationAttempts(ctx context.Context, in *ReadNotificationAttemptsRequest, opts ...grpc.CallOption) (*ReadNotificationAttemptsResponse, error) {
+	out := new(ReadNotificationAttemptsResponse)
+	err := c.cc.Invoke(ctx, NotificationService_ReadNotificationAttempts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationServiceServer is the server API for NotificationService service.
+// All implementations must embed UnimplementedNotificationServiceServer
+// for forward compatibility
+type NotificationServiceServer interface {
+	// Notification
+	ReadDeadNotifications(context.Context, *ReadDeadNotificationsRequest) (*ReadDeadNotificationsResponse, error)
+	ReadDeadNotification(context.Context, *ReadDeadNotificationRequest) (*ReadDeadNotificationResponse, error)
+	ReplayDeadNotification(context.Context, *ReplayDeadNotificationRequest) (*ReplayDeadNotificationResponse, error)
+	DeleteDeadNotification(context.Context, *DeleteDeadNotificationRequest) (*DeleteDeadNotificationResponse, error)
+	DeleteDeadNotifications(context.Context, *DeleteDeadNotificationsRequest) (*DeleteDeadNotificationsResponse, error)
+	ReadNotificationAttempts(context.Context, *ReadNotificationAttemptsRequest) (*ReadNotificationAttemptsResponse, error)
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+// UnimplementedNotificationServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedNotificationServiceServer struct {
+}
+
+func (UnimplementedNotificationServiceServer) ReadDeadNotifications(context.Context, *ReadDeadNotificationsRequest) (*ReadDeadNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeadNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) ReadDeadNotification(context.Context, *ReadDeadNotificationRequest) (*ReadDeadNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadDeadNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) ReplayDeadNotification(context.Context, *ReplayDeadNotificationRequest) (*ReplayDeadNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteDeadNotification(context.Context, *DeleteDeadNotificationRequest) (*DeleteDeadNotificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeadNotification not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteDeadNotifications(context.Context, *DeleteDeadNotificationsRequest) (*DeleteDeadNotificationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeadNotifications not implemented")
+}
+func (UnimplementedNotificationServiceServer) ReadNotificationAttempts(context.Context, *ReadNotificationAttemptsRequest) (*ReadNotificationAttemptsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadNotificationAttempts not implemented")
+}
+func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
+
+// UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationServiceServer will
+// result in compilation errors.
+type UnsafeNotificationServiceServer interface {
+	mustEmbedUnimplementedNotificationServiceServer()
+}
+
+func RegisterNotificationServiceServer(s grpc.ServiceRegistrar, srv NotificationServiceServer) {
+	s.RegisterService(&NotificationService_ServiceDesc, srv)
+}
+
+func _NotificationService_ReadDeadNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeadNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ReadDeadNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ReadDeadNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ReadDeadNotifications(ctx, req.(*ReadDeadNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ReadDeadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadDeadNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ReadDeadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ReadDeadNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ReadDeadNotification(ctx, req.(*ReadDeadNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ReplayDeadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ReplayDeadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ReplayDeadNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ReplayDeadNotification(ctx, req.(*ReplayDeadNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteDeadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeadNotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteDeadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteDeadNotification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteDeadNotification(ctx, req.(*DeleteDeadNotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteDeadNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeadNotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteDeadNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_DeleteDeadNotifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteDeadNotifications(ctx, req.(*DeleteDeadNotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ReadNotificationAttempts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadNotificationAttemptsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ReadNotificationAttempts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_ReadNotificationAttempts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ReadNotificationAttempts(ctx, req.(*ReadNotificationAttemptsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "promise.NotificationService",
+	HandlerType: (*NotificationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReadDeadNotifications",
+			Handler:    _NotificationService_ReadDeadNotifications_Handler,
+		},
+		{
+			MethodName: "ReadDeadNotification",
+			Handler:    _NotificationService_ReadDeadNotification_Handler,
+		},
+		{
+			MethodName: "ReplayDeadNotification",
+			Handler:    _NotificationService_ReplayDeadNotification_Handler,
+		},
+		{
+			MethodName: "DeleteDeadNotification",
+			Handler:    _NotificationService_DeleteDeadNotification_Handler,
+		},
+		{
+			MethodName: "DeleteDeadNotifications",
+			Handler:    _NotificationService_DeleteDeadNotifications_Handler,
+		},
+		{
+			MethodName: "ReadNotificationAttempts",
+			Handler:    _NotificationService_ReadNotificationAttempts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/app/subsystems/api/grpc/api/promise.proto",
@@ -2,14 +2,20 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"github.com/resonatehq/resonate/internal/app/subsystems/api/service"
 	"log/slog"
 	"net"
+	"time"
 
 	"github.com/resonatehq/resonate/internal/api"
+	"github.com/resonatehq/resonate/internal/app/events"
 	grpcApi "github.com/resonatehq/resonate/internal/app/subsystems/api/grpc/api"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/pkg/notification"
 	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/receiver"
+	"github.com/resonatehq/resonate/pkg/subscription"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	grpcStatus "google.golang.org/grpc/status"
@@ -22,17 +28,24 @@ type Config struct {
 type Grpc struct {
 	config *Config
 	server *grpc.Server
+	cancel context.CancelFunc
 }
 
-func New(api api.API, config *Config) api.Subsystem {
-	s := &server{service: &service.Service{Api: api}}
+func New(api api.API, events *events.Events, config *Config) api.Subsystem {
+	// canceled on stop so that streams, which would otherwise
+	// hold up a graceful stop, return
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &server{service: &service.Service{Api: api, Events: events}, ctx: ctx}
 
-	server := grpc.NewServer(grpc.UnaryInterceptor(s.log)) // nosemgrep
+	server := grpc.NewServer(grpc.UnaryInterceptor(s.log), grpc.StreamInterceptor(s.logStream)) // nosemgrep
 	grpcApi.RegisterPromiseServiceServer(server, s)
+	grpcApi.RegisterSubscriptionServiceServer(server, s)
+	grpcApi.RegisterNotificationServiceServer(server, s)
 
 	return &Grpc{
 		config: config,
 		server: server,
+		cancel: cancel,
 	}
 }
 
@@ -52,6 +65,7 @@ func (g *Grpc) Start(errors chan<- error) {
 }
 
 func (g *Grpc) Stop() error {
+	g.cancel()
 	g.server.GracefulStop()
 	return nil
 }
@@ -62,7 +76,10 @@ func (g *Grpc) String() string {
 
 type server struct {
 	grpcApi.UnimplementedPromiseServiceServer
+	grpcApi.UnimplementedSubscriptionServiceServer
+	grpcApi.UnimplementedNotificationServiceServer
 	service *service.Service
+	ctx     context.Context
 }
 
 func (s *server) log(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
@@ -72,24 +89,83 @@ func (s *server) log(ctx context.Context, req interface{}, info *grpc.UnaryServe
 	return res, err
 }
 
+func (s *server) logStream(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	err := handler(srv, stream)
+
+	slog.Debug("grpc", "method", info.FullMethod, "error", err)
+	return err
+}
+
 func (s *server) ReadPromise(ctx context.Context, req *grpcApi.ReadPromiseRequest) (*grpcApi.ReadPromiseResponse, error) {
-	resp, err := s.service.ReadPromise(req.Id)
+	params := &service.ReadPromiseParams{
+		Wait: time.Duration(req.Wait) * time.Millisecond,
+	}
+
+	resp, err := s.service.ReadPromise(ctx, req.Id, params)
 	if err != nil {
-		return nil, grpcStatus.Error(codes.Internal, err.Error())
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		} else {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return &grpcApi.ReadPromiseResponse{
 		Status:  protoStatus(resp.Status),
-		Promise: protoPromise(resp.Promise),
+		Promise: receiver.FromPromise(resp.Promise),
 	}, nil
 }
 
+func (s *server) WatchPromise(req *grpcApi.ReadPromiseRequest, stream grpcApi.PromiseService_WatchPromiseServer) error {
+	resp, watch, err := s.service.WatchPromise(req.Id)
+	if err != nil {
+		return grpcStatus.Error(codes.Internal, err.Error())
+	}
+	defer watch.Close()
+
+	if resp.Status != t_api.ResponseOK {
+		return grpcStatus.Error(codes.NotFound, "promise not found")
+	}
+
+	if err := stream.Send(&grpcApi.PromiseEvent{Promise: receiver.FromPromise(resp.Promise)}); err != nil {
+		return err
+	}
+
+	if resp.Promise.State != promise.Pending {
+		return nil
+	}
+
+	// stop waiting when the server shuts down
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	stop := context.AfterFunc(s.ctx, cancel)
+	defer stop()
+
+	if p := watch.Wait(ctx); p != nil {
+		return stream.Send(&grpcApi.PromiseEvent{Promise: receiver.FromPromise(p)})
+	}
+
+	if s.ctx.Err() != nil {
+		return grpcStatus.Error(codes.Unavailable, "server is shutting down")
+	}
+	return stream.Context().Err()
+}
+
 func (s *server) SearchPromises(ctx context.Context, req *grpcApi.SearchPromisesRequest) (*grpcApi.SearchPromisesResponse, error) {
 	params := &service.SearchPromiseParams{
-		Q:      req.Q,
-		State:  searchState(req.State),
-		Limit:  int(req.Limit),
-		Cursor: req.Cursor,
+		Q:               req.Q,
+		State:           searchState(req.State),
+		Tags:            req.Tags,
+		CreatedAfter:    req.CreatedAfter,
+		CreatedBefore:   req.CreatedBefore,
+		CompletedAfter:  req.CompletedAfter,
+		CompletedBefore: req.CompletedBefore,
+		TimeoutBefore:   req.TimeoutBefore,
+		SortBy:          searchSortBy(req.SortBy),
+		SortOrder:       searchSortOrder(req.SortOrder),
+		Limit:           int(req.Limit),
+		Cursor:          req.Cursor,
 	}
 	resp, err := s.service.SearchPromises(params)
 	if err != nil {
@@ -100,9 +176,9 @@ func (s *server) SearchPromises(ctx context.Context, req *grpcApi.SearchPromises
 		}
 	}
 
-	promises := make([]*grpcApi.Promise, len(resp.Promises))
+	promises := make([]*receiver.Promise, len(resp.Promises))
 	for i, promise := range resp.Promises {
-		promises[i] = protoPromise(promise)
+		promises[i] = receiver.FromPromise(promise)
 	}
 
 	cursor := ""
@@ -149,16 +225,21 @@ func (s *server) CreatePromise(ctx context.Context, req *grpcApi.CreatePromiseRe
 			Data:    data,
 		},
 		Timeout: req.Timeout,
+		Tags:    req.Tags,
 	}
 
 	resp, err := s.service.CreatePromise(req.Id, header, body)
 	if err != nil {
-		return nil, grpcStatus.Error(codes.Internal, err.Error())
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		} else {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return &grpcApi.CreatePromiseResponse{
 		Status:  protoStatus(resp.Status),
-		Promise: protoPromise(resp.Promise),
+		Promise: receiver.FromPromise(resp.Promise),
 	}, nil
 }
 
@@ -197,7 +278,7 @@ func (s *server) CancelPromise(ctx context.Context, req *grpcApi.CancelPromiseRe
 
 	return &grpcApi.CancelPromiseResponse{
 		Status:  protoStatus(resp.Status),
-		Promise: protoPromise(resp.Promise),
+		Promise: receiver.FromPromise(resp.Promise),
 	}, nil
 }
 
@@ -237,7 +318,7 @@ func (s *server) ResolvePromise(ctx context.Context, req *grpcApi.ResolvePromise
 
 	return &grpcApi.ResolvePromiseResponse{
 		Status:  protoStatus(resp.Status),
-		Promise: protoPromise(resp.Promise),
+		Promise: receiver.FromPromise(resp.Promise),
 	}, nil
 }
 
@@ -278,7 +359,367 @@ func (s *server) RejectPromise(ctx context.Context, req *grpcApi.RejectPromiseRe
 
 	return &grpcApi.RejectPromiseResponse{
 		Status:  protoStatus(resp.Status),
-		Promise: protoPromise(resp.Promise),
+		Promise: receiver.FromPromise(resp.Promise),
+	}, nil
+}
+
+func (s *server) Batch(ctx context.Context, req *grpcApi.BatchRequest) (*grpcApi.BatchResponse, error) {
+	body := &service.BatchBody{
+		Requests: make([]*service.BatchRequestBody, len(req.Requests)),
+	}
+
+	for i, r := range req.Requests {
+		switch r := r.Request.(type) {
+		case *grpcApi.BatchItem_Create:
+			body.Requests[i] = batchCreate(r.Create)
+		case *grpcApi.BatchItem_Cancel:
+			body.Requests[i] = batchComplete("cancel", r.Cancel.Id, r.Cancel.IdempotencyKey, r.Cancel.Strict, r.Cancel.Value)
+		case *grpcApi.BatchItem_Resolve:
+			body.Requests[i] = batchComplete("resolve", r.Resolve.Id, r.Resolve.IdempotencyKey, r.Resolve.Strict, r.Resolve.Value)
+		case *grpcApi.BatchItem_Reject:
+			body.Requests[i] = batchComplete("reject", r.Reject.Id, r.Reject.IdempotencyKey, r.Reject.Strict, r.Reject.Value)
+		default:
+			return nil, grpcStatus.Error(codes.InvalidArgument, fmt.Sprintf("requests[%d]: request must be provided", i))
+		}
+	}
+
+	resp, err := s.service.Batch(body)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		}
+		return nil, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	responses := make([]*grpcApi.BatchItemResponse, len(resp.Responses))
+	for i, r := range resp.Responses {
+		if err := resp.Errors[i]; err != nil {
+			// the outcome of a failed request is unknown
+			responses[i] = &grpcApi.BatchItemResponse{
+				Status: grpcApi.Status_UNKNOWN,
+				Error:  err.Error(),
+			}
+		} else {
+			responses[i] = protoBatchItemResponse(r)
+		}
+	}
+
+	return &grpcApi.BatchResponse{
+		Responses: responses,
+	}, nil
+}
+
+func (s *server) CompleteAndCreatePromises(ctx context.Context, req *grpcApi.CompleteAndCreatePromisesRequest) (*grpcApi.CompleteAndCreatePromisesResponse, error) {
+	body := &service.CompleteAndCreatePromisesBody{
+		Creates: make([]*service.BatchRequestBody, len(req.Creates)),
+	}
+
+	switch r := req.Complete.(type) {
+	case *grpcApi.CompleteAndCreatePromisesRequest_Resolve:
+		body.Complete = batchComplete("resolve", r.Resolve.Id, r.Resolve.IdempotencyKey, r.Resolve.Strict, r.Resolve.Value)
+	case *grpcApi.CompleteAndCreatePromisesRequest_Reject:
+		body.Complete = batchComplete("reject", r.Reject.Id, r.Reject.IdempotencyKey, r.Reject.Strict, r.Reject.Value)
+	case *grpcApi.CompleteAndCreatePromisesRequest_Cancel:
+		body.Complete = batchComplete("cancel", r.Cancel.Id, r.Cancel.IdempotencyKey, r.Cancel.Strict, r.Cancel.Value)
+	}
+
+	for i, r := range req.Creates {
+		if r == nil {
+			return nil, grpcStatus.Error(codes.InvalidArgument, fmt.Sprintf("creates[%d]: request must be provided", i))
+		}
+		body.Creates[i] = batchCreate(r)
+	}
+
+	resp, err := s.service.CompleteAndCreatePromises(body)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		}
+		return nil, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	creates := make([]*grpcApi.BatchItemResponse, len(resp.Creates))
+	for i, r := range resp.Creates {
+		creates[i] = &grpcApi.BatchItemResponse{
+			Status:  protoStatus(r.Status),
+			Promise: receiver.FromPromise(r.Promise),
+		}
+	}
+
+	return &grpcApi.CompleteAndCreatePromisesResponse{
+		Status:   protoStatus(resp.Status),
+		Complete: protoBatchItemResponse(resp.Complete),
+		Creates:  creates,
+	}, nil
+}
+
+func batchCreate(req *grpcApi.CreatePromiseRequest) *service.BatchRequestBody {
+	return &service.BatchRequestBody{
+		Kind:           "create",
+		Id:             req.Id,
+		IdempotencyKey: protoIdempotencyKey(req.IdempotencyKey),
+		Strict:         req.Strict,
+		Param:          protoValue(req.Param),
+		Timeout:        req.Timeout,
+		Tags:           req.Tags,
+	}
+}
+
+func batchComplete(kind string, id string, idempotencyKey string, strict bool, value *receiver.Value) *service.BatchRequestBody {
+	return &service.BatchRequestBody{
+		Kind:           kind,
+		Id:             id,
+		IdempotencyKey: protoIdempotencyKey(idempotencyKey),
+		Strict:         strict,
+		Value:          protoValue(value),
+	}
+}
+
+func (s *server) ReadPromisesByIdempotencyKey(ctx context.Context, req *grpcApi.ReadPromisesByIdempotencyKeyRequest) (*grpcApi.ReadPromisesByIdempotencyKeyResponse, error) {
+	params := &service.ReadPromisesByIdempotencyKeyParams{
+		Limit:  int(req.Limit),
+		Cursor: req.Cursor,
+	}
+
+	resp, err := s.service.ReadPromisesByIdempotencyKey(promise.IdempotencyKey(req.IdempotencyKey), params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		} else {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	promises := make([]*receiver.Promise, len(resp.Promises))
+	for i, promise := range resp.Promises {
+		promises[i] = receiver.FromPromise(promise)
+	}
+
+	cursor := ""
+	if resp.Cursor != nil {
+		var err error
+		cursor, err = resp.Cursor.Encode()
+		if err != nil {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &grpcApi.ReadPromisesByIdempotencyKeyResponse{
+		Status:   protoStatus(resp.Status),
+		Cursor:   cursor,
+		Promises: promises,
+	}, nil
+}
+
+func (s *server) ReadSubscriptions(ctx context.Context, req *grpcApi.ReadSubscriptionsRequest) (*grpcApi.ReadSubscriptionsResponse, error) {
+	params := &service.ReadSubscriptionsParams{
+		Limit:  int(req.Limit),
+		Cursor: req.Cursor,
+	}
+
+	resp, err := s.service.ReadSubscriptions(req.PromiseId, params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		} else {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	subscriptions := make([]*grpcApi.Subscription, len(resp.Subscriptions))
+	for i, subscription := range resp.Subscriptions {
+		subscriptions[i] = protoSubscription(subscription)
+	}
+
+	cursor := ""
+	if resp.Cursor != nil {
+		var err error
+		cursor, err = resp.Cursor.Encode()
+		if err != nil {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &grpcApi.ReadSubscriptionsResponse{
+		Status:        protoStatus(resp.Status),
+		Cursor:        cursor,
+		Subscriptions: subscriptions,
+	}, nil
+}
+
+func (s *server) CreateSubscription(ctx context.Context, req *grpcApi.CreateSubscriptionRequest) (*grpcApi.CreateSubscriptionResponse, error) {
+	var retryPolicy *subscription.RetryPolicy
+	if req.RetryPolicy != nil {
+		retryPolicy = &subscription.RetryPolicy{
+			Kind:     req.RetryPolicy.Kind,
+			Delay:    req.RetryPolicy.Delay,
+			Attempts: req.RetryPolicy.Attempts,
+			MaxDelay: req.RetryPolicy.MaxDelay,
+			Jitter:   req.RetryPolicy.Jitter,
+			Schedule: req.RetryPolicy.Schedule,
+		}
+	}
+
+	var payload *subscription.Payload
+	if req.Payload != nil {
+		payload = &subscription.Payload{
+			ExcludeParamData: req.Payload.ExcludeParamData,
+			ExcludeValueData: req.Payload.ExcludeValueData,
+		}
+	}
+
+	body := &service.CreateSubscriptionBody{
+		Id:          req.Id,
+		Url:         req.Url,
+		Method:      req.Method,
+		Headers:     req.Headers,
+		Payload:     payload,
+		Secret:      req.Secret,
+		RetryPolicy: retryPolicy,
+	}
+
+	resp, err := s.service.CreateSubscription(req.PromiseId, body)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		} else {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &grpcApi.CreateSubscriptionResponse{
+		Status:       protoStatus(resp.Status),
+		Subscription: protoSubscription(resp.Subscription),
+	}, nil
+}
+
+func (s *server) DeleteSubscription(ctx context.Context, req *grpcApi.DeleteSubscriptionRequest) (*grpcApi.DeleteSubscriptionResponse, error) {
+	resp, err := s.service.DeleteSubscription(req.PromiseId, req.Id)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	return &grpcApi.DeleteSubscriptionResponse{
+		Status: protoStatus(resp.Status),
+	}, nil
+}
+
+func (s *server) ReadDeadNotifications(ctx context.Context, req *grpcApi.ReadDeadNotificationsRequest) (*grpcApi.ReadDeadNotificationsResponse, error) {
+	params := &service.ReadDeadNotificationsParams{
+		Limit:  int(req.Limit),
+		Cursor: req.Cursor,
+	}
+
+	resp, err := s.service.ReadDeadNotifications(params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		} else {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	deadNotifications := make([]*grpcApi.DeadNotification, len(resp.DeadNotifications))
+	for i, deadNotification := range resp.DeadNotifications {
+		deadNotifications[i] = protoDeadNotification(deadNotification)
+	}
+
+	cursor := ""
+	if resp.Cursor != nil {
+		var err error
+		cursor, err = resp.Cursor.Encode()
+		if err != nil {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &grpcApi.ReadDeadNotificationsResponse{
+		Status:            protoStatus(resp.Status),
+		Cursor:            cursor,
+		DeadNotifications: deadNotifications,
+	}, nil
+}
+
+func (s *server) ReadDeadNotification(ctx context.Context, req *grpcApi.ReadDeadNotificationRequest) (*grpcApi.ReadDeadNotificationResponse, error) {
+	resp, err := s.service.ReadDeadNotification(req.PromiseId, req.Id)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	return &grpcApi.ReadDeadNotificationResponse{
+		Status:           protoStatus(resp.Status),
+		DeadNotification: protoDeadNotification(resp.DeadNotification),
+	}, nil
+}
+
+func (s *server) ReplayDeadNotification(ctx context.Context, req *grpcApi.ReplayDeadNotificationRequest) (*grpcApi.ReplayDeadNotificationResponse, error) {
+	resp, err := s.service.ReplayDeadNotification(req.PromiseId, req.Id)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	return &grpcApi.ReplayDeadNotificationResponse{
+		Status:           protoStatus(resp.Status),
+		DeadNotification: protoDeadNotification(resp.DeadNotification),
+	}, nil
+}
+
+func (s *server) DeleteDeadNotification(ctx context.Context, req *grpcApi.DeleteDeadNotificationRequest) (*grpcApi.DeleteDeadNotificationResponse, error) {
+	resp, err := s.service.DeleteDeadNotification(req.PromiseId, req.Id)
+	if err != nil {
+		return nil, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	return &grpcApi.DeleteDeadNotificationResponse{
+		Status: protoStatus(resp.Status),
+	}, nil
+}
+
+func (s *server) DeleteDeadNotifications(ctx context.Context, req *grpcApi.DeleteDeadNotificationsRequest) (*grpcApi.DeleteDeadNotificationsResponse, error) {
+	resp, err := s.service.DeleteDeadNotifications()
+	if err != nil {
+		return nil, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	return &grpcApi.DeleteDeadNotificationsResponse{
+		Status:  protoStatus(resp.Status),
+		Deleted: resp.Deleted,
+	}, nil
+}
+
+func (s *server) ReadNotificationAttempts(ctx context.Context, req *grpcApi.ReadNotificationAttemptsRequest) (*grpcApi.ReadNotificationAttemptsResponse, error) {
+	params := &service.ReadNotificationAttemptsParams{
+		Limit:  int(req.Limit),
+		Cursor: req.Cursor,
+	}
+
+	resp, err := s.service.ReadNotificationAttempts(req.PromiseId, req.Id, params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			return nil, grpcStatus.Error(codes.InvalidArgument, verr.Error())
+		} else {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	attempts := make([]*grpcApi.NotificationAttempt, len(resp.Attempts))
+	for i, attempt := range resp.Attempts {
+		attempts[i] = protoNotificationAttempt(attempt)
+	}
+
+	cursor := ""
+	if resp.Cursor != nil {
+		var err error
+		cursor, err = resp.Cursor.Encode()
+		if err != nil {
+			return nil, grpcStatus.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &grpcApi.ReadNotificationAttemptsResponse{
+		Status:   protoStatus(resp.Status),
+		Cursor:   cursor,
+		Attempts: attempts,
 	}, nil
 }
 
@@ -294,55 +735,154 @@ func protoStatus(status t_api.ResponseStatus) grpcApi.Status {
 		return grpcApi.Status_FORBIDDEN
 	case t_api.ResponseNotFound:
 		return grpcApi.Status_NOTFOUND
+	case t_api.ResponseConflict:
+		return grpcApi.Status_CONFLICT
 	default:
 		return grpcApi.Status_UNKNOWN
 	}
 }
 
-func protoPromise(promise *promise.Promise) *grpcApi.Promise {
-	if promise == nil {
+func protoBatchItemResponse(r *t_api.Response) *grpcApi.BatchItemResponse {
+	var status t_api.ResponseStatus
+	var p *promise.Promise
+
+	switch r.Kind {
+	case t_api.CreatePromise:
+		status, p = r.CreatePromise.Status, r.CreatePromise.Promise
+	case t_api.CancelPromise:
+		status, p = r.CancelPromise.Status, r.CancelPromise.Promise
+	case t_api.ResolvePromise:
+		status, p = r.ResolvePromise.Status, r.ResolvePromise.Promise
+	case t_api.RejectPromise:
+		status, p = r.RejectPromise.Status, r.RejectPromise.Promise
+	}
+
+	return &grpcApi.BatchItemResponse{
+		Status:  protoStatus(status),
+		Promise: receiver.FromPromise(p),
+	}
+}
+
+func protoIdempotencyKey(idempotencyKey string) *promise.IdempotencyKey {
+	if idempotencyKey == "" {
 		return nil
 	}
 
-	var idempotencyKeyForCreate, idempotencyKeyForComplete string
-	if promise.IdempotencyKeyForCreate != nil {
-		idempotencyKeyForCreate = string(*promise.IdempotencyKeyForCreate)
+	i := promise.IdempotencyKey(idempotencyKey)
+	return &i
+}
+
+func protoValue(value *receiver.Value) promise.Value {
+	if value == nil {
+		return promise.Value{}
 	}
-	if promise.IdempotencyKeyForComplete != nil {
-		idempotencyKeyForComplete = string(*promise.IdempotencyKeyForComplete)
+
+	return promise.Value{
+		Headers: value.Headers,
+		Data:    value.Data,
 	}
+}
 
-	return &grpcApi.Promise{
-		Id:    promise.Id,
-		State: protoState(promise.State),
-		Param: &grpcApi.Value{
-			Headers: promise.Param.Headers,
-			Data:    promise.Param.Data,
-		},
-		Value: &grpcApi.Value{
-			Headers: promise.Param.Headers,
-			Data:    promise.Param.Data,
-		},
-		Timeout:                   promise.Timeout,
-		IdempotencyKeyForCreate:   idempotencyKeyForCreate,
-		IdempotencyKeyForComplete: idempotencyKeyForComplete,
-	}
-}
-
-func protoState(state promise.State) grpcApi.State {
-	switch state {
-	case promise.Pending:
-		return grpcApi.State_PENDING
-	case promise.Resolved:
-		return grpcApi.State_RESOLVED
-	case promise.Rejected:
-		return grpcApi.State_REJECTED
-	case promise.Timedout:
-		return grpcApi.State_REJECTED_TIMEDOUT
-	case promise.Canceled:
-		return grpcApi.State_REJECTED_CANCELED
-	default:
-		panic("invalid state")
+func protoSubscription(subscription *subscription.Subscription) *grpcApi.Subscription {
+	if subscription == nil {
+		return nil
+	}
+
+	var retryPolicy *grpcApi.RetryPolicy
+	if subscription.RetryPolicy != nil {
+		retryPolicy = &grpcApi.RetryPolicy{
+			Kind:     subscription.RetryPolicy.Kind,
+			Delay:    subscription.RetryPolicy.Delay,
+			Attempts: subscription.RetryPolicy.Attempts,
+			MaxDelay: subscription.RetryPolicy.MaxDelay,
+			Jitter:   subscription.RetryPolicy.Jitter,
+			Schedule: subscription.RetryPolicy.Schedule,
+		}
+	}
+
+	var payload *grpcApi.Payload
+	if subscription.Payload != nil {
+		payload = &grpcApi.Payload{
+			ExcludeParamData: subscription.Payload.ExcludeParamData,
+			ExcludeValueData: subscription.Payload.ExcludeValueData,
+		}
+	}
+
+	return &grpcApi.Subscription{
+		Id:          subscription.Id,
+		PromiseId:   subscription.PromiseId,
+		Url:         subscription.Url,
+		RetryPolicy: retryPolicy,
+		CreatedOn:   subscription.CreatedOn,
+		Method:      subscription.Method,
+		Headers:     subscription.Headers,
+		Payload:     payload,
+	}
+}
+
+func protoDeadNotification(deadNotification *notification.DeadNotification) *grpcApi.DeadNotification {
+	if deadNotification == nil {
+		return nil
+	}
+
+	var retryPolicy *grpcApi.RetryPolicy
+	if deadNotification.RetryPolicy != nil {
+		retryPolicy = &grpcApi.RetryPolicy{
+			Kind:     deadNotification.RetryPolicy.Kind,
+			Delay:    deadNotification.RetryPolicy.Delay,
+			Attempts: deadNotification.RetryPolicy.Attempts,
+			MaxDelay: deadNotification.RetryPolicy.MaxDelay,
+			Jitter:   deadNotification.RetryPolicy.Jitter,
+			Schedule: deadNotification.RetryPolicy.Schedule,
+		}
+	}
+
+	var payload *grpcApi.Payload
+	if deadNotification.Payload != nil {
+		payload = &grpcApi.Payload{
+			ExcludeParamData: deadNotification.Payload.ExcludeParamData,
+			ExcludeValueData: deadNotification.Payload.ExcludeValueData,
+		}
+	}
+
+	var statusCode *int32
+	if deadNotification.StatusCode != nil {
+		s := int32(*deadNotification.StatusCode)
+		statusCode = &s
+	}
+
+	return &grpcApi.DeadNotification{
+		Id:          deadNotification.Id,
+		PromiseId:   deadNotification.PromiseId,
+		Url:         deadNotification.Url,
+		Method:      deadNotification.Method,
+		Headers:     deadNotification.Headers,
+		Payload:     payload,
+		RetryPolicy: retryPolicy,
+		Attempts:    deadNotification.Attempts,
+		StatusCode:  statusCode,
+		Error:       deadNotification.Error,
+		CreatedOn:   deadNotification.CreatedOn,
+	}
+}
+
+func protoNotificationAttempt(attempt *notification.NotificationAttempt) *grpcApi.NotificationAttempt {
+	var statusCode *int32
+	if attempt.StatusCode != nil {
+		s := int32(*attempt.StatusCode)
+		statusCode = &s
+	}
+
+	return &grpcApi.NotificationAttempt{
+		Id:         attempt.Id,
+		PromiseId:  attempt.PromiseId,
+		Attempt:    attempt.Attempt,
+		Url:        attempt.Url,
+		StatusCode: statusCode,
+		Latency:    attempt.Latency,
+		Body:       attempt.Body,
+		Error:      attempt.Error,
+		Time:       attempt.Time,
 	}
 }
 
@@ -360,3 +900,27 @@ func searchState(searchState grpcApi.SearchState) string {
 		panic("invalid state")
 	}
 }
+
+func searchSortBy(sortBy grpcApi.SearchSortBy) string {
+	switch sortBy {
+	case grpcApi.SearchSortBy_SORT_BY_SORT_ID:
+		return "sortId"
+	case grpcApi.SearchSortBy_SORT_BY_CREATED_ON:
+		return "createdOn"
+	case grpcApi.SearchSortBy_SORT_BY_TIMEOUT:
+		return "timeout"
+	default:
+		return sortBy.String() // rejected by the service
+	}
+}
+
+func searchSortOrder(sortOrder grpcApi.SearchSortOrder) string {
+	switch sortOrder {
+	case grpcApi.SearchSortOrder_SORT_ORDER_DESC:
+		return "desc"
+	case grpcApi.SearchSortOrder_SORT_ORDER_ASC:
+		return "asc"
+	default:
+		return sortOrder.String() // rejected by the service
+	}
+}
@@ -3,6 +3,7 @@ package http
 import (
 	"context"
 	"github.com/resonatehq/resonate/internal/app/subsystems/api/service"
+	"net"
 	"net/http"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/resonatehq/resonate/internal/api"
+	"github.com/resonatehq/resonate/internal/app/events"
 )
 
 type Config struct {
@@ -20,31 +22,55 @@ type Config struct {
 type Http struct {
 	config *Config
 	server *http.Server
+	cancel context.CancelFunc
 }
 
-func New(api api.API, config *Config) api.Subsystem {
+func New(api api.API, events *events.Events, config *Config) api.Subsystem {
 	gin.SetMode(gin.ReleaseMode)
 
 	r := gin.New()
-	s := &server{service: &service.Service{Api: api, ServerProtocol: "http"}}
+	s := &server{service: &service.Service{Api: api, Events: events, ServerProtocol: "http"}}
+
+	// canceled on stop so that long lived requests, such as event
+	// streams, do not hold up a graceful shutdown
+	ctx, cancel := context.WithCancel(context.Background())
 
 	// Middleware
 	r.Use(s.log)
 
 	// Promise API
 	r.GET("/promises", s.searchPromises)
+	r.POST("/promises/batch", s.batch)
+	r.POST("/promises/complete-and-create", s.completeAndCreatePromises)
 	r.GET("/promises/:id", s.readPromise)
+	r.GET("/promises/:id/events", s.promiseEvents)
 	r.POST("/promises/:id/create", s.createPromise)
 	r.POST("/promises/:id/cancel", s.cancelPromise)
 	r.POST("/promises/:id/resolve", s.resolvePromise)
 	r.POST("/promises/:id/reject", s.rejectPromise)
+	r.GET("/idempotency-keys/:key", s.readPromisesByIdempotencyKey)
+
+	// Subscription API
+	r.GET("/promises/:id/subscriptions", s.readSubscriptions)
+	r.POST("/promises/:id/subscriptions", s.createSubscription)
+	r.DELETE("/promises/:id/subscriptions/:subscriptionId", s.deleteSubscription)
+	r.GET("/promises/:id/subscriptions/:subscriptionId/attempts", s.readNotificationAttempts)
+
+	// Notification API
+	r.GET("/dead-notifications", s.readDeadNotifications)
+	r.DELETE("/dead-notifications", s.deleteDeadNotifications)
+	r.GET("/promises/:id/dead-notifications/:notificationId", s.readDeadNotification)
+	r.POST("/promises/:id/dead-notifications/:notificationId/replay", s.replayDeadNotification)
+	r.DELETE("/promises/:id/dead-notifications/:notificationId", s.deleteDeadNotification)
 
 	return &Http{
 		config: config,
 		server: &http.Server{
-			Addr:    config.Addr,
-			Handler: r,
+			Addr:        config.Addr,
+			Handler:     r,
+			BaseContext: func(net.Listener) context.Context { return ctx },
 		},
+		cancel: cancel,
 	}
 }
 
@@ -56,6 +82,8 @@ func (h *Http) Start(errors chan<- error) {
 }
 
 func (h *Http) Stop() error {
+	h.cancel()
+
 	ctx, cancel := context.WithTimeout(context.Background(), h.config.Timeout)
 	defer cancel()
 
@@ -0,0 +1,99 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/resonatehq/resonate/internal/app/subsystems/api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Read Dead Notifications
+
+func (s *server) readDeadNotifications(c *gin.Context) {
+	var params service.ReadDeadNotificationsParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.ReadDeadNotifications(&params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(int(resp.Status), gin.H{
+		"cursor":            resp.Cursor,
+		"deadNotifications": resp.DeadNotifications,
+	})
+}
+
+// Read Dead Notification
+
+func (s *server) readDeadNotification(c *gin.Context) {
+	resp, err := s.service.ReadDeadNotification(c.Param("id"), c.Param("notificationId"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(int(resp.Status), resp.DeadNotification)
+}
+
+// Replay Dead Notification
+
+func (s *server) replayDeadNotification(c *gin.Context) {
+	resp, err := s.service.ReplayDeadNotification(c.Param("id"), c.Param("notificationId"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(int(resp.Status), resp.DeadNotification)
+}
+
+// Delete Dead Notification
+
+func (s *server) deleteDeadNotification(c *gin.Context) {
+	resp, err := s.service.DeleteDeadNotification(c.Param("id"), c.Param("notificationId"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(int(resp.Status))
+}
+
+// Delete Dead Notifications
+
+func (s *server) deleteDeadNotifications(c *gin.Context) {
+	resp, err := s.service.DeleteDeadNotifications()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.JSON(int(resp.Status), gin.H{
+		"deleted": resp.Deleted,
+	})
+}
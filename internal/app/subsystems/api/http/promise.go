@@ -2,6 +2,8 @@ package http
 
 import (
 	"github.com/resonatehq/resonate/internal/app/subsystems/api/service"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/pkg/promise"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -10,15 +12,64 @@ import (
 // Read Promise
 
 func (s *server) readPromise(c *gin.Context) {
-	resp, err := s.service.ReadPromise(c.Param("id"))
+	var params service.ReadPromiseParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.ReadPromise(c.Request.Context(), c.Param("id"), &params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(int(resp.Status), resp.Promise)
+}
+
+// Promise Events
+
+func (s *server) promiseEvents(c *gin.Context) {
+	resp, watch, err := s.service.WatchPromise(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{
 			"error": err.Error(),
 		})
 		return
 	}
+	defer watch.Close()
 
-	c.JSON(int(resp.Status), resp.Promise)
+	if resp.Status != t_api.ResponseOK {
+		c.JSON(int(resp.Status), resp.Promise)
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	p := resp.Promise
+	if p.State == promise.Pending {
+		if p = watch.Wait(c.Request.Context()); p == nil {
+			return
+		}
+	}
+
+	c.SSEvent("promise", p)
+	c.Writer.Flush()
 }
 
 // Search Promise
@@ -33,6 +84,10 @@ func (s *server) searchPromises(c *gin.Context) {
 		return
 	}
 
+	if tags := c.QueryMap("tags"); len(tags) > 0 {
+		params.Tags = tags
+	}
+
 	resp, err := s.service.SearchPromises(&params)
 
 	if err != nil {
@@ -53,6 +108,38 @@ func (s *server) searchPromises(c *gin.Context) {
 	})
 }
 
+// Read Promises By Idempotency Key
+
+func (s *server) readPromisesByIdempotencyKey(c *gin.Context) {
+	var params service.ReadPromisesByIdempotencyKeyParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.ReadPromisesByIdempotencyKey(promise.IdempotencyKey(c.Param("key")), &params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(int(resp.Status), gin.H{
+		"cursor":   resp.Cursor,
+		"promises": resp.Promises,
+	})
+}
+
 // Create Promise
 func (s *server) createPromise(c *gin.Context) {
 	var header service.CreatePromiseHeader
@@ -73,9 +160,15 @@ func (s *server) createPromise(c *gin.Context) {
 
 	resp, err := s.service.CreatePromise(c.Param("id"), &header, body)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
 		return
 	}
 
@@ -165,3 +258,105 @@ func (s *server) rejectPromise(c *gin.Context) {
 	}
 	c.JSON(int(resp.Status), resp.Promise)
 }
+
+// Batch
+
+func (s *server) batch(c *gin.Context) {
+	var body *service.BatchBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.Batch(body)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	responses := make([]gin.H, len(resp.Responses))
+	for i, r := range resp.Responses {
+		if err := resp.Errors[i]; err != nil {
+			responses[i] = gin.H{
+				"status": http.StatusInternalServerError,
+				"error":  err.Error(),
+			}
+		} else {
+			responses[i] = batchResponse(r)
+		}
+	}
+
+	c.JSON(int(resp.Status), gin.H{
+		"responses": responses,
+	})
+}
+
+// Complete And Create Promises
+
+func (s *server) completeAndCreatePromises(c *gin.Context) {
+	var body *service.CompleteAndCreatePromisesBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.CompleteAndCreatePromises(body)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	creates := make([]gin.H, len(resp.Creates))
+	for i, r := range resp.Creates {
+		creates[i] = gin.H{
+			"status":  r.Status,
+			"promise": r.Promise,
+		}
+	}
+
+	c.JSON(int(resp.Status), gin.H{
+		"complete": batchResponse(resp.Complete),
+		"creates":  creates,
+	})
+}
+
+func batchResponse(r *t_api.Response) gin.H {
+	var status t_api.ResponseStatus
+	var p *promise.Promise
+
+	switch r.Kind {
+	case t_api.CreatePromise:
+		status, p = r.CreatePromise.Status, r.CreatePromise.Promise
+	case t_api.CancelPromise:
+		status, p = r.CancelPromise.Status, r.CancelPromise.Promise
+	case t_api.ResolvePromise:
+		status, p = r.ResolvePromise.Status, r.ResolvePromise.Promise
+	case t_api.RejectPromise:
+		status, p = r.RejectPromise.Status, r.RejectPromise.Promise
+	}
+
+	return gin.H{
+		"status":  status,
+		"promise": p,
+	}
+}
@@ -0,0 +1,115 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/resonatehq/resonate/internal/app/subsystems/api/service"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Read Subscriptions
+
+func (s *server) readSubscriptions(c *gin.Context) {
+	var params service.ReadSubscriptionsParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.ReadSubscriptions(c.Param("id"), &params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(int(resp.Status), gin.H{
+		"cursor":        resp.Cursor,
+		"subscriptions": resp.Subscriptions,
+	})
+}
+
+// Create Subscription
+
+func (s *server) createSubscription(c *gin.Context) {
+	var body *service.CreateSubscriptionBody
+	if err := c.ShouldBindJSON(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.CreateSubscription(c.Param("id"), body)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(int(resp.Status), resp.Subscription)
+}
+
+// Delete Subscription
+
+func (s *server) deleteSubscription(c *gin.Context) {
+	resp, err := s.service.DeleteSubscription(c.Param("id"), c.Param("subscriptionId"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	c.Status(int(resp.Status))
+}
+
+// Read Notification Attempts
+
+func (s *server) readNotificationAttempts(c *gin.Context) {
+	var params service.ReadNotificationAttemptsParams
+
+	if err := c.ShouldBindQuery(&params); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp, err := s.service.ReadNotificationAttempts(c.Param("id"), c.Param("subscriptionId"), &params)
+	if err != nil {
+		if verr, ok := err.(*service.ValidationError); ok {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": verr.Error(),
+			})
+		} else {
+			c.JSON(http.StatusInternalServerError, gin.H{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	c.JSON(int(resp.Status), gin.H{
+		"cursor":   resp.Cursor,
+		"attempts": resp.Attempts,
+	})
+}
@@ -1,16 +1,33 @@
 package service
 
-import "github.com/resonatehq/resonate/pkg/promise"
+import (
+	"time"
+
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/resonatehq/resonate/pkg/subscription"
+)
 
 type ValidationError struct {
 	msg string // description of error
 }
 
+type ReadPromiseParams struct {
+	Wait time.Duration `form:"wait" json:"wait"`
+}
+
 type SearchPromiseParams struct {
-	Q      string `form:"q" json:"q"`
-	State  string `form:"state" json:"state"`
-	Limit  int    `form:"limit" json:"limit"`
-	Cursor string `form:"cursor" json:"cursor"`
+	Q               string            `form:"q" json:"q"`
+	State           string            `form:"state" json:"state"`
+	Tags            map[string]string `form:"-" json:"tags"`
+	CreatedAfter    *int64            `form:"createdAfter" json:"createdAfter"`
+	CreatedBefore   *int64            `form:"createdBefore" json:"createdBefore"`
+	CompletedAfter  *int64            `form:"completedAfter" json:"completedAfter"`
+	CompletedBefore *int64            `form:"completedBefore" json:"completedBefore"`
+	TimeoutBefore   *int64            `form:"timeoutBefore" json:"timeoutBefore"`
+	SortBy          string            `form:"sortBy" json:"sortBy"`
+	SortOrder       string            `form:"sortOrder" json:"sortOrder"`
+	Limit           int               `form:"limit" json:"limit"`
+	Cursor          string            `form:"cursor" json:"cursor"`
 }
 
 type CreatePromiseHeader struct {
@@ -50,3 +67,53 @@ type RejectPromiseHeader struct {
 type RejectPromiseBody struct {
 	Value promise.Value `json:"value"`
 }
+
+type BatchBody struct {
+	Requests []*BatchRequestBody `json:"requests"`
+}
+
+type BatchRequestBody struct {
+	Kind           string                  `json:"kind"`
+	Id             string                  `json:"id"`
+	IdempotencyKey *promise.IdempotencyKey `json:"idempotencyKey"`
+	Strict         bool                    `json:"strict"`
+	Param          promise.Value           `json:"param"`
+	Value          promise.Value           `json:"value"`
+	Timeout        int64                   `json:"timeout"`
+	Tags           map[string]string       `json:"tags"`
+}
+
+type CompleteAndCreatePromisesBody struct {
+	Complete *BatchRequestBody   `json:"complete"`
+	Creates  []*BatchRequestBody `json:"creates"`
+}
+
+type ReadPromisesByIdempotencyKeyParams struct {
+	Limit  int    `form:"limit" json:"limit"`
+	Cursor string `form:"cursor" json:"cursor"`
+}
+
+type ReadSubscriptionsParams struct {
+	Limit  int    `form:"limit" json:"limit"`
+	Cursor string `form:"cursor" json:"cursor"`
+}
+
+type CreateSubscriptionBody struct {
+	Id          string                    `json:"id"`
+	Url         string                    `json:"url"`
+	Method      string                    `json:"method,omitempty"`
+	Headers     map[string]string         `json:"headers,omitempty"`
+	Payload     *subscription.Payload     `json:"payload,omitempty"`
+	Secret      string                    `json:"secret,omitempty"`
+	RetryPolicy *subscription.RetryPolicy `json:"retryPolicy"`
+}
+
+type ReadDeadNotificationsParams struct {
+	Limit  int    `form:"limit" json:"limit"`
+	Cursor string `form:"cursor" json:"cursor"`
+}
+
+type ReadNotificationAttemptsParams struct {
+	Limit  int    `form:"limit" json:"limit"`
+	Cursor string `form:"cursor" json:"cursor"`
+}
@@ -1,18 +1,27 @@
 package service
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
 	"github.com/resonatehq/resonate/internal/api"
+	"github.com/resonatehq/resonate/internal/app/events"
 	"github.com/resonatehq/resonate/internal/kernel/bus"
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
 	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
-	"strings"
+	"github.com/resonatehq/resonate/pkg/subscription"
 )
 
 func (e *ValidationError) Error() string { return e.msg }
 
 type Service struct {
 	Api            api.API
+	Events         *events.Events
 	ServerProtocol string
 }
 
@@ -22,7 +31,91 @@ func (s *Service) protocol() string {
 
 // Read Promise
 
-func (s *Service) ReadPromise(id string) (*t_api.ReadPromiseResponse, error) {
+// maxWait is the maximum duration a read waits for a pending promise to
+// be completed, longer waits are clamped.
+const maxWait = 5 * time.Minute
+
+func (s *Service) ReadPromise(ctx context.Context, id string, params *ReadPromiseParams) (*t_api.ReadPromiseResponse, error) {
+	if params.Wait < 0 {
+		return nil, &ValidationError{msg: "wait must be non-negative"}
+	}
+
+	if params.Wait == 0 {
+		return s.readPromise(id)
+	}
+
+	resp, watch, err := s.WatchPromise(id)
+	if err != nil {
+		return nil, err
+	}
+	defer watch.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, min(params.Wait, maxWait))
+	defer cancel()
+
+	if p := watch.Wait(ctx); p != nil {
+		return &t_api.ReadPromiseResponse{
+			Status:  t_api.ResponseOK,
+			Promise: p,
+		}, nil
+	}
+
+	return resp, nil
+}
+
+// Watch waits for a pending promise to be completed.
+type Watch struct {
+	events       *events.Events
+	subscription *events.Subscription
+	pending      bool
+}
+
+// WatchPromise reads a promise and returns a watch that waits for the
+// promise to be completed if the promise is pending. The watch
+// subscribes before the promise is read, otherwise a completion that
+// occurs in between would be missed, and must be closed.
+func (s *Service) WatchPromise(id string) (*t_api.ReadPromiseResponse, *Watch, error) {
+	sub := s.Events.Subscribe(id)
+
+	resp, err := s.readPromise(id)
+	if err != nil {
+		s.Events.Unsubscribe(sub)
+		return nil, nil, err
+	}
+
+	watch := &Watch{
+		events:       s.Events,
+		subscription: sub,
+	}
+
+	if resp.Status == t_api.ResponseOK && resp.Promise.State == promise.Pending {
+		s.Events.Pending(sub, resp.Promise)
+		watch.pending = true
+	}
+
+	return resp, watch, nil
+}
+
+// Wait returns the completed promise, or nil if the promise was not
+// pending or ctx is done before the promise is completed.
+func (w *Watch) Wait(ctx context.Context) *promise.Promise {
+	if !w.pending {
+		return nil
+	}
+
+	select {
+	case p := <-w.subscription.C:
+		return p
+	case <-ctx.Done():
+		return nil
+	}
+}
+
+func (w *Watch) Close() {
+	w.events.Unsubscribe(w.subscription)
+}
+
+func (s *Service) readPromise(id string) (*t_api.ReadPromiseResponse, error) {
 	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
 	defer close(cq)
 
@@ -57,6 +150,11 @@ func (s *Service) SearchPromises(params *SearchPromiseParams) (*t_api.SearchProm
 			return nil, &ValidationError{msg: err.Error()}
 		}
 		searchPromises = cursor.Next
+
+		// the sort of a cursor is not trusted
+		if searchPromises == nil || !searchPromises.SortBy.Valid() || !searchPromises.SortOrder.Valid() {
+			return nil, &ValidationError{msg: "cursor is invalid"}
+		}
 	} else {
 		// validate
 		if params.Q == "" {
@@ -91,15 +189,45 @@ func (s *Service) SearchPromises(params *SearchPromiseParams) (*t_api.SearchProm
 			return nil, &ValidationError{"state must be one of: pending, resolved, rejected"}
 		}
 
+		var sortBy promise.SortBy
+		switch params.SortBy {
+		case "", "sortId":
+			sortBy = promise.SortBySortId
+		case "createdOn":
+			sortBy = promise.SortByCreatedOn
+		case "timeout":
+			sortBy = promise.SortByTimeout
+		default:
+			return nil, &ValidationError{"sortBy must be one of: sortId, createdOn, timeout"}
+		}
+
+		var sortOrder promise.SortOrder
+		switch strings.ToLower(params.SortOrder) {
+		case "", "desc":
+			sortOrder = promise.Descending
+		case "asc":
+			sortOrder = promise.Ascending
+		default:
+			return nil, &ValidationError{"sortOrder must be one of: asc, desc"}
+		}
+
 		limit := params.Limit
 		if limit <= 0 || limit > 100 {
 			limit = 100
 		}
 
 		searchPromises = &t_api.SearchPromisesRequest{
-			Q:      params.Q,
-			States: states,
-			Limit:  limit,
+			Q:               params.Q,
+			States:          states,
+			Tags:            params.Tags,
+			CreatedAfter:    params.CreatedAfter,
+			CreatedBefore:   params.CreatedBefore,
+			CompletedAfter:  params.CompletedAfter,
+			CompletedBefore: params.CompletedBefore,
+			TimeoutBefore:   params.TimeoutBefore,
+			SortBy:          sortBy,
+			SortOrder:       sortOrder,
+			Limit:           limit,
 		}
 	}
 
@@ -127,6 +255,10 @@ func (s *Service) SearchPromises(params *SearchPromiseParams) (*t_api.SearchProm
 // Create Promise
 
 func (s *Service) CreatePromise(id string, header *CreatePromiseHeader, body *CreatePromiseBody) (*t_api.CreatePromiseResponse, error) {
+	if _, err := promise.Retention(body.Tags); err != nil {
+		return nil, &ValidationError{msg: err.Error()}
+	}
+
 	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
 	defer close(cq)
 
@@ -242,6 +374,592 @@ func (s *Service) RejectPromise(id string, header *RejectPromiseHeader, body *Re
 	return cqe.Completion.RejectPromise, nil
 }
 
+// Batch
+
+// the maximum number of requests accepted in a single batch
+const maxBatchSize = 100
+
+func (s *Service) Batch(body *BatchBody) (*t_api.BatchResponse, error) {
+	if len(body.Requests) == 0 {
+		return nil, &ValidationError{msg: "batch must contain at least one request"}
+	}
+	if len(body.Requests) > maxBatchSize {
+		return nil, &ValidationError{msg: fmt.Sprintf("batch must contain at most %d requests", maxBatchSize)}
+	}
+
+	ids := map[string]bool{}
+	reqs := make([]*t_api.Request, len(body.Requests))
+
+	for i, r := range body.Requests {
+		req, err := batchRequest(fmt.Sprintf("requests[%d]", i), r, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		reqs[i] = req
+	}
+
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind: t_api.Batch,
+			Batch: &t_api.BatchRequest{
+				Requests: reqs,
+			},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.Batch != nil, "response must not be nil")
+	return cqe.Completion.Batch, nil
+}
+
+// Complete And Create Promises
+
+func (s *Service) CompleteAndCreatePromises(body *CompleteAndCreatePromisesBody) (*t_api.CompleteAndCreatePromisesResponse, error) {
+	if body.Complete == nil {
+		return nil, &ValidationError{msg: "complete must be provided"}
+	}
+	if body.Complete.Kind != "resolve" && body.Complete.Kind != "reject" && body.Complete.Kind != "cancel" {
+		return nil, &ValidationError{msg: "complete: kind must be one of resolve, reject, cancel"}
+	}
+	if len(body.Creates) > maxBatchSize {
+		return nil, &ValidationError{msg: fmt.Sprintf("creates must contain at most %d requests", maxBatchSize)}
+	}
+
+	ids := map[string]bool{}
+
+	complete, err := batchRequest("complete", body.Complete, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	creates := make([]*t_api.CreatePromiseRequest, len(body.Creates))
+	for i, r := range body.Creates {
+		if r != nil {
+			if r.Kind != "" && r.Kind != "create" {
+				return nil, &ValidationError{msg: fmt.Sprintf("creates[%d]: kind must be create", i)}
+			}
+
+			// kind may be omitted for creates
+			create := *r
+			create.Kind = "create"
+			r = &create
+		}
+
+		req, err := batchRequest(fmt.Sprintf("creates[%d]", i), r, ids)
+		if err != nil {
+			return nil, err
+		}
+
+		creates[i] = req.CreatePromise
+	}
+
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind: t_api.CompleteAndCreatePromises,
+			CompleteAndCreatePromises: &t_api.CompleteAndCreatePromisesRequest{
+				Complete: complete,
+				Creates:  creates,
+			},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.CompleteAndCreatePromises != nil, "response must not be nil")
+	return cqe.Completion.CompleteAndCreatePromises, nil
+}
+
+// batchRequest converts and validates a single request of a batch, ids
+// must be unique across all requests of a batch
+func batchRequest(name string, r *BatchRequestBody, ids map[string]bool) (*t_api.Request, error) {
+	if r == nil || r.Id == "" {
+		return nil, &ValidationError{msg: fmt.Sprintf("%s: id must be provided", name)}
+	}
+
+	// requests of a batch are processed concurrently, the outcome
+	// of multiple requests for the same promise would be undefined
+	if ids[r.Id] {
+		return nil, &ValidationError{msg: fmt.Sprintf("%s: duplicate id %s", name, r.Id)}
+	}
+	ids[r.Id] = true
+
+	switch r.Kind {
+	case "create":
+		if _, err := promise.Retention(r.Tags); err != nil {
+			return nil, &ValidationError{msg: fmt.Sprintf("%s: %s", name, err)}
+		}
+
+		return &t_api.Request{
+			Kind: t_api.CreatePromise,
+			CreatePromise: &t_api.CreatePromiseRequest{
+				Id:             r.Id,
+				IdempotencyKey: r.IdempotencyKey,
+				Strict:         r.Strict,
+				Param:          r.Param,
+				Timeout:        r.Timeout,
+				Tags:           r.Tags,
+			},
+		}, nil
+	case "cancel":
+		return &t_api.Request{
+			Kind: t_api.CancelPromise,
+			CancelPromise: &t_api.CancelPromiseRequest{
+				Id:             r.Id,
+				IdempotencyKey: r.IdempotencyKey,
+				Strict:         r.Strict,
+				Value:          r.Value,
+			},
+		}, nil
+	case "resolve":
+		return &t_api.Request{
+			Kind: t_api.ResolvePromise,
+			ResolvePromise: &t_api.ResolvePromiseRequest{
+				Id:             r.Id,
+				IdempotencyKey: r.IdempotencyKey,
+				Strict:         r.Strict,
+				Value:          r.Value,
+			},
+		}, nil
+	case "reject":
+		return &t_api.Request{
+			Kind: t_api.RejectPromise,
+			RejectPromise: &t_api.RejectPromiseRequest{
+				Id:             r.Id,
+				IdempotencyKey: r.IdempotencyKey,
+				Strict:         r.Strict,
+				Value:          r.Value,
+			},
+		}, nil
+	default:
+		return nil, &ValidationError{msg: fmt.Sprintf("%s: kind must be one of create, cancel, resolve, reject", name)}
+	}
+}
+
+// Read Promises By Idempotency Key
+
+func (s *Service) ReadPromisesByIdempotencyKey(idempotencyKey promise.IdempotencyKey, params *ReadPromisesByIdempotencyKeyParams) (*t_api.ReadPromisesByIdempotencyKeyResponse, error) {
+	var readPromises *t_api.ReadPromisesByIdempotencyKeyRequest
+	if params.Cursor != "" {
+		cursor, err := t_api.NewCursor[t_api.ReadPromisesByIdempotencyKeyRequest](params.Cursor)
+		if err != nil {
+			return nil, &ValidationError{msg: err.Error()}
+		}
+		if cursor.Next == nil || cursor.Next.IdempotencyKey != idempotencyKey {
+			return nil, &ValidationError{msg: "cursor does not match idempotency key"}
+		}
+		readPromises = cursor.Next
+	} else {
+		limit := params.Limit
+		if limit <= 0 || limit > 100 {
+			limit = 100
+		}
+
+		readPromises = &t_api.ReadPromisesByIdempotencyKeyRequest{
+			IdempotencyKey: idempotencyKey,
+			Limit:          limit,
+		}
+	}
+
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind:                         t_api.ReadPromisesByIdempotencyKey,
+			ReadPromisesByIdempotencyKey: readPromises,
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.ReadPromisesByIdempotencyKey != nil, "response must not be nil")
+	return cqe.Completion.ReadPromisesByIdempotencyKey, nil
+}
+
+// Read Subscriptions
+
+func (s *Service) ReadSubscriptions(promiseId string, params *ReadSubscriptionsParams) (*t_api.ReadSubscriptionsResponse, error) {
+	var readSubscriptions *t_api.ReadSubscriptionsRequest
+	if params.Cursor != "" {
+		cursor, err := t_api.NewCursor[t_api.ReadSubscriptionsRequest](params.Cursor)
+		if err != nil {
+			return nil, &ValidationError{msg: err.Error()}
+		}
+		if cursor.Next == nil || cursor.Next.PromiseId != promiseId {
+			return nil, &ValidationError{msg: "cursor does not match promise"}
+		}
+		readSubscriptions = cursor.Next
+	} else {
+		limit := params.Limit
+		if limit <= 0 || limit > 100 {
+			limit = 100
+		}
+
+		readSubscriptions = &t_api.ReadSubscriptionsRequest{
+			PromiseId: promiseId,
+			Limit:     limit,
+		}
+	}
+
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind:              t_api.ReadSubscriptions,
+			ReadSubscriptions: readSubscriptions,
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.ReadSubscriptions != nil, "response must not be nil")
+
+	// secrets are write only
+	for _, subscription := range cqe.Completion.ReadSubscriptions.Subscriptions {
+		subscription.Secret = ""
+	}
+
+	return cqe.Completion.ReadSubscriptions, nil
+}
+
+// Create Subscription
+
+func (s *Service) CreateSubscription(promiseId string, body *CreateSubscriptionBody) (*t_api.CreateSubscriptionResponse, error) {
+	// validate
+	if body.Id == "" {
+		return nil, &ValidationError{msg: "id must be provided"}
+	}
+	if body.Url == "" {
+		return nil, &ValidationError{msg: "url must be provided"}
+	}
+	u, err := url.ParseRequestURI(body.Url)
+	if err != nil || u.Host == "" {
+		return nil, &ValidationError{msg: "url must be a valid absolute url"}
+	}
+	switch body.Method {
+	case "", http.MethodPost, http.MethodPut, http.MethodPatch:
+	default:
+		return nil, &ValidationError{msg: "method must be one of POST, PUT, PATCH"}
+	}
+	// grpc notifications invoke NotificationReceiver.Notify and are
+	// not signed
+	if u.Scheme == subscription.GrpcScheme {
+		if body.Method != "" {
+			return nil, &ValidationError{msg: "method must not be provided for grpc urls"}
+		}
+		if body.Secret != "" {
+			return nil, &ValidationError{msg: "secret must not be provided for grpc urls"}
+		}
+	}
+	for _, header := range util.OrderedRangeKV(body.Headers) {
+		if header.Key == "" || strings.ContainsAny(header.Key, " \t\r\n:") {
+			return nil, &ValidationError{msg: fmt.Sprintf("header %q must be a valid header name", header.Key)}
+		}
+		switch strings.ToLower(header.Key) {
+		case "webhook-id", "webhook-timestamp", "webhook-signature":
+			return nil, &ValidationError{msg: fmt.Sprintf("header %q is reserved", header.Key)}
+		}
+	}
+	if body.Secret != "" {
+		if _, err := subscription.ParseSecret(body.Secret); err != nil {
+			return nil, &ValidationError{msg: err.Error()}
+		}
+	}
+	if body.RetryPolicy != nil {
+		if err := body.RetryPolicy.Validate(); err != nil {
+			return nil, &ValidationError{msg: err.Error()}
+		}
+	}
+
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind: t_api.CreateSubscription,
+			CreateSubscription: &t_api.CreateSubscriptionRequest{
+				Id:          body.Id,
+				PromiseId:   promiseId,
+				Url:         body.Url,
+				Method:      body.Method,
+				Headers:     body.Headers,
+				Payload:     body.Payload,
+				Secret:      body.Secret,
+				RetryPolicy: body.RetryPolicy,
+			},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.CreateSubscription != nil, "response must not be nil")
+
+	// secrets are write only
+	if cqe.Completion.CreateSubscription.Subscription != nil {
+		cqe.Completion.CreateSubscription.Subscription.Secret = ""
+	}
+
+	return cqe.Completion.CreateSubscription, nil
+}
+
+// Delete Subscription
+
+func (s *Service) DeleteSubscription(promiseId string, id string) (*t_api.DeleteSubscriptionResponse, error) {
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind: t_api.DeleteSubscription,
+			DeleteSubscription: &t_api.DeleteSubscriptionRequest{
+				Id:        id,
+				PromiseId: promiseId,
+			},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.DeleteSubscription != nil, "response must not be nil")
+	return cqe.Completion.DeleteSubscription, nil
+}
+
+// Read Dead Notifications
+
+func (s *Service) ReadDeadNotifications(params *ReadDeadNotificationsParams) (*t_api.ReadDeadNotificationsResponse, error) {
+	var readDeadNotifications *t_api.ReadDeadNotificationsRequest
+	if params.Cursor != "" {
+		cursor, err := t_api.NewCursor[t_api.ReadDeadNotificationsRequest](params.Cursor)
+		if err != nil {
+			return nil, &ValidationError{msg: err.Error()}
+		}
+		if cursor.Next == nil {
+			return nil, &ValidationError{msg: "cursor is invalid"}
+		}
+		readDeadNotifications = cursor.Next
+	} else {
+		limit := params.Limit
+		if limit <= 0 || limit > 100 {
+			limit = 100
+		}
+
+		readDeadNotifications = &t_api.ReadDeadNotificationsRequest{
+			Limit: limit,
+		}
+	}
+
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind:                  t_api.ReadDeadNotifications,
+			ReadDeadNotifications: readDeadNotifications,
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.ReadDeadNotifications != nil, "response must not be nil")
+	return cqe.Completion.ReadDeadNotifications, nil
+}
+
+// Read Dead Notification
+
+func (s *Service) ReadDeadNotification(promiseId string, id string) (*t_api.ReadDeadNotificationResponse, error) {
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind: t_api.ReadDeadNotification,
+			ReadDeadNotification: &t_api.ReadDeadNotificationRequest{
+				Id:        id,
+				PromiseId: promiseId,
+			},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.ReadDeadNotification != nil, "response must not be nil")
+	return cqe.Completion.ReadDeadNotification, nil
+}
+
+// Replay Dead Notification
+
+func (s *Service) ReplayDeadNotification(promiseId string, id string) (*t_api.ReplayDeadNotificationResponse, error) {
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind: t_api.ReplayDeadNotification,
+			ReplayDeadNotification: &t_api.ReplayDeadNotificationRequest{
+				Id:        id,
+				PromiseId: promiseId,
+			},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.ReplayDeadNotification != nil, "response must not be nil")
+	return cqe.Completion.ReplayDeadNotification, nil
+}
+
+// Delete Dead Notification
+
+func (s *Service) DeleteDeadNotification(promiseId string, id string) (*t_api.DeleteDeadNotificationResponse, error) {
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind: t_api.DeleteDeadNotification,
+			DeleteDeadNotification: &t_api.DeleteDeadNotificationRequest{
+				Id:        id,
+				PromiseId: promiseId,
+			},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.DeleteDeadNotification != nil, "response must not be nil")
+	return cqe.Completion.DeleteDeadNotification, nil
+}
+
+// Delete Dead Notifications
+
+func (s *Service) DeleteDeadNotifications() (*t_api.DeleteDeadNotificationsResponse, error) {
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind:                    t_api.DeleteDeadNotifications,
+			DeleteDeadNotifications: &t_api.DeleteDeadNotificationsRequest{},
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.DeleteDeadNotifications != nil, "response must not be nil")
+	return cqe.Completion.DeleteDeadNotifications, nil
+}
+
+// Read Notification Attempts
+
+func (s *Service) ReadNotificationAttempts(promiseId string, id string, params *ReadNotificationAttemptsParams) (*t_api.ReadNotificationAttemptsResponse, error) {
+	var readNotificationAttempts *t_api.ReadNotificationAttemptsRequest
+	if params.Cursor != "" {
+		cursor, err := t_api.NewCursor[t_api.ReadNotificationAttemptsRequest](params.Cursor)
+		if err != nil {
+			return nil, &ValidationError{msg: err.Error()}
+		}
+		if cursor.Next == nil || cursor.Next.PromiseId != promiseId || cursor.Next.Id != id {
+			return nil, &ValidationError{msg: "cursor does not match subscription"}
+		}
+		readNotificationAttempts = cursor.Next
+	} else {
+		limit := params.Limit
+		if limit <= 0 || limit > 100 {
+			limit = 100
+		}
+
+		readNotificationAttempts = &t_api.ReadNotificationAttemptsRequest{
+			Id:        id,
+			PromiseId: promiseId,
+			Limit:     limit,
+		}
+	}
+
+	cq := make(chan *bus.CQE[t_api.Request, t_api.Response])
+	defer close(cq)
+
+	s.Api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+		Tags: s.protocol(),
+		Submission: &t_api.Request{
+			Kind:                     t_api.ReadNotificationAttempts,
+			ReadNotificationAttempts: readNotificationAttempts,
+		},
+		Callback: s.sendOrPanic(cq),
+	})
+
+	cqe := <-cq
+	if cqe.Error != nil {
+		return nil, cqe.Error
+	}
+
+	util.Assert(cqe.Completion.ReadNotificationAttempts != nil, "response must not be nil")
+	return cqe.Completion.ReadNotificationAttempts, nil
+}
+
 func (s *Service) sendOrPanic(cq chan *bus.CQE[t_api.Request, t_api.Response]) func(*t_api.Response, error) {
 	return func(completion *t_api.Response, err error) {
 		cqe := &bus.CQE[t_api.Request, t_api.Response]{
@@ -10,3 +10,7 @@ func IdempotencyKeyToPointer(s string) *promise.IdempotencyKey {
 func Int64ToPointer(i int64) *int64 {
 	return &i
 }
+
+func IntToPointer(i int) *int {
+	return &i
+}
@@ -41,8 +41,12 @@ func (s *Scheduler) Tick(t int64, batchSize int) {
 		cqe.Callback(cqe.Completion, cqe.Error)
 	}
 
-	// enqueue cqes
-	for _, coroutine := range s.coroutines {
+	// enqueue cqes, the init function of a coroutine may add coroutines
+	// while iterating, these are initialized in the same tick just like
+	// coroutines added by callbacks above, ranging over the coroutines
+	// would drop them when the coroutines are replaced below
+	for i := 0; i < len(s.coroutines); i++ {
+		coroutine := s.coroutines[i]
 		if !coroutine.initialized {
 			coroutine.init(s, coroutine)
 			coroutine.initialized = true
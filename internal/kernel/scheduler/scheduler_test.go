@@ -0,0 +1,65 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeAIO struct {
+	sqes []*bus.SQE[t_aio.Submission, t_aio.Completion]
+}
+
+func (a *fakeAIO) String() string {
+	return "aio"
+}
+
+func (a *fakeAIO) Enqueue(sqe *bus.SQE[t_aio.Submission, t_aio.Completion]) {
+	a.sqes = append(a.sqes, sqe)
+}
+
+func (a *fakeAIO) Dequeue(int) []*bus.CQE[t_aio.Submission, t_aio.Completion] {
+	return nil
+}
+
+func (a *fakeAIO) Flush(int64) {}
+
+func (a *fakeAIO) Shutdown() {}
+
+func TestSchedulerAddDuringInit(t *testing.T) {
+	aio := &fakeAIO{}
+	s := NewScheduler(aio, metrics.New(prometheus.NewRegistry()))
+
+	var initialized []string
+
+	child := NewCoroutine("child", func(s *Scheduler, c *Coroutine) {
+		initialized = append(initialized, "child")
+		c.Yield(&t_aio.Submission{
+			Kind: t_aio.Echo,
+			Echo: &t_aio.EchoSubmission{Data: "child"},
+		}, func(*t_aio.Completion, error) {})
+	})
+
+	s.Add(NewCoroutine("parent", func(s *Scheduler, c *Coroutine) {
+		initialized = append(initialized, "parent")
+		s.Add(child)
+	}))
+
+	s.Tick(0, 1)
+
+	// a coroutine added by the init function of another coroutine is
+	// initialized in the same tick and kept until it is done
+	assert.Equal(t, []string{"parent", "child"}, initialized)
+	assert.Len(t, aio.sqes, 1)
+	assert.Equal(t, "child", aio.sqes[0].Submission.Echo.Data)
+	assert.False(t, s.Done())
+
+	aio.sqes[0].Callback(&t_aio.Completion{Kind: t_aio.Echo, Echo: &t_aio.EchoCompletion{Data: "child"}}, nil)
+	s.Tick(1, 1)
+
+	assert.True(t, s.Done())
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/api"
+	"github.com/resonatehq/resonate/internal/app/events"
 	"github.com/resonatehq/resonate/internal/metrics"
 
 	"github.com/resonatehq/resonate/internal/kernel/scheduler"
@@ -17,14 +18,28 @@ type Config struct {
 	NotificationCacheSize int
 	SubmissionBatchSize   int
 	CompletionBatchSize   int
+	Retention             time.Duration
+	RetentionBatchSize    int
+	Archive               bool
+	IdempotencyKeyTTL     time.Duration
+	NotificationSecrets   []string
+
+	// Events receives promises as they are completed by the
+	// coroutines, it is shared with the api subsystems.
+	Events *events.Events
 }
 
 func (c *Config) String() string {
 	return fmt.Sprintf(
-		"Config(ncs=%d, sbs=%d, cbs=%d)",
+		"Config(ncs=%d, sbs=%d, cbs=%d, r=%s, rbs=%d, a=%t, ikttl=%s, ns=%d)",
 		c.NotificationCacheSize,
 		c.SubmissionBatchSize,
 		c.CompletionBatchSize,
+		c.Retention,
+		c.RetentionBatchSize,
+		c.Archive,
+		c.IdempotencyKeyTTL,
+		len(c.NotificationSecrets),
 	)
 }
 
@@ -34,7 +49,7 @@ type System struct {
 	config    *Config
 	metrics   *metrics.Metrics
 	scheduler *scheduler.Scheduler
-	onRequest map[t_api.Kind]func(*t_api.Request, func(*t_api.Response, error)) *scheduler.Coroutine
+	onRequest map[t_api.Kind]func(*Config, *t_api.Request, func(*t_api.Response, error)) *scheduler.Coroutine
 	onTick    map[int][]func(*Config) *scheduler.Coroutine
 	ticks     int64
 }
@@ -46,7 +61,7 @@ func New(api api.API, aio aio.AIO, config *Config, metrics *metrics.Metrics) *Sy
 		config:    config,
 		metrics:   metrics,
 		scheduler: scheduler.NewScheduler(aio, metrics),
-		onRequest: map[t_api.Kind]func(*t_api.Request, func(*t_api.Response, error)) *scheduler.Coroutine{},
+		onRequest: map[t_api.Kind]func(*Config, *t_api.Request, func(*t_api.Response, error)) *scheduler.Coroutine{},
 		onTick:    map[int][]func(*Config) *scheduler.Coroutine{},
 	}
 }
@@ -72,7 +87,7 @@ func (s *System) Tick(t int64, timeoutCh <-chan time.Time) {
 		// add request coroutines
 		for _, sqe := range s.api.Dequeue(s.config.SubmissionBatchSize, timeoutCh) {
 			if coroutine, ok := s.onRequest[sqe.Submission.Kind]; ok {
-				s.scheduler.Add(coroutine(sqe.Submission, sqe.Callback))
+				s.scheduler.Add(coroutine(s.config, sqe.Submission, sqe.Callback))
 			} else {
 				panic("invalid api request")
 			}
@@ -97,7 +112,7 @@ func (s *System) Shutdown() {
 	s.aio.Shutdown()
 }
 
-func (s *System) AddOnRequest(kind t_api.Kind, constructor func(*t_api.Request, func(*t_api.Response, error)) *scheduler.Coroutine) {
+func (s *System) AddOnRequest(kind t_api.Kind, constructor func(*Config, *t_api.Request, func(*t_api.Response, error)) *scheduler.Coroutine) {
 	s.onRequest[kind] = constructor
 }
 
@@ -6,6 +6,7 @@ const (
 	Echo Kind = iota
 	Network
 	Store
+	Sink
 )
 
 func (k Kind) String() string {
@@ -16,6 +17,8 @@ func (k Kind) String() string {
 		return "network"
 	case Store:
 		return "store"
+	case Sink:
+		return "sink"
 	default:
 		panic("invalid aio")
 	}
@@ -26,6 +29,7 @@ type Submission struct {
 	Echo    *EchoSubmission
 	Network *NetworkSubmission
 	Store   *StoreSubmission
+	Sink    *SinkSubmission
 }
 
 func (s *Submission) String() string {
@@ -36,6 +40,8 @@ func (s *Submission) String() string {
 		return s.Network.String()
 	case Store:
 		return s.Store.String()
+	case Sink:
+		return s.Sink.String()
 	default:
 		panic("invalid aio submission")
 	}
@@ -46,6 +52,7 @@ type Completion struct {
 	Echo    *EchoCompletion
 	Network *NetworkCompletion
 	Store   *StoreCompletion
+	Sink    *SinkCompletion
 }
 
 func (c *Completion) String() string {
@@ -56,6 +63,8 @@ func (c *Completion) String() string {
 		return c.Network.String()
 	case Store:
 		return c.Store.String()
+	case Sink:
+		return c.Sink.String()
 	default:
 		panic("invalid aio completion")
 	}
@@ -3,37 +3,65 @@ package t_aio
 import (
 	"fmt"
 	"net/http"
+
+	"github.com/resonatehq/resonate/pkg/promise"
 )
 
 type NetworkKind int
 
 const (
 	Http NetworkKind = iota
+	Grpc
 )
 
 type NetworkSubmission struct {
 	Kind NetworkKind
 	Http *HttpRequest
+	Grpc *GrpcRequest
 }
 
 func (s *NetworkSubmission) String() string {
 	switch s.Kind {
 	case Http:
 		return fmt.Sprintf("Network(http=Http(method=%s, url=%s))", s.Http.Method, s.Http.Url)
+	case Grpc:
+		return fmt.Sprintf("Network(grpc=Grpc(url=%s))", s.Grpc.Url)
 	default:
 		panic("invalid aio network submission")
 	}
 }
 
+// NetworkCompletion only holds a response for http requests, a request
+// that does not succeed completes with an error and a completion that
+// only holds the latency.
 type NetworkCompletion struct {
 	Kind NetworkKind
 	Http *http.Response
+
+	// Latency is the duration of the request in milliseconds, as
+	// measured by the network subsystem.
+	Latency int64
+
+	// Deferred is set when a request is not sent because its host is
+	// unavailable, the request may be retried after the delay in
+	// milliseconds.
+	Deferred bool
+	Delay    int64
 }
 
 func (c *NetworkCompletion) String() string {
+	if c.Deferred {
+		return fmt.Sprintf("Network(deferred=true, delay=%d)", c.Delay)
+	}
+
 	switch c.Kind {
 	case Http:
-		return fmt.Sprintf("Network(http=Http(status=%d))", c.Http.StatusCode)
+		if c.Http == nil {
+			return fmt.Sprintf("Network(http=Http(latency=%d))", c.Latency)
+		}
+		return fmt.Sprintf("Network(http=Http(status=%d, latency=%d))", c.Http.StatusCode, c.Latency)
+	case Grpc:
+		return fmt.Sprintf("Network(grpc=Grpc(latency=%d))", c.Latency)
 	default:
 		panic("invalid aio network completion")
 	}
@@ -45,3 +73,9 @@ type HttpRequest struct {
 	Url     string
 	Body    []byte
 }
+
+type GrpcRequest struct {
+	Headers map[string]string
+	Url     string
+	Promise *promise.Promise
+}
@@ -0,0 +1,24 @@
+package t_aio
+
+import (
+	"fmt"
+
+	"github.com/resonatehq/resonate/pkg/promise"
+)
+
+type SinkSubmission struct {
+	Records []*promise.PromiseRecord
+}
+
+func (s *SinkSubmission) String() string {
+	return fmt.Sprintf("Sink(records=%d)", len(s.Records))
+}
+
+type SinkCompletion struct {
+	Path        string
+	RowsWritten int64
+}
+
+func (c *SinkCompletion) String() string {
+	return fmt.Sprintf("Sink(path=%s, rowsWritten=%d)", c.Path, c.RowsWritten)
+}
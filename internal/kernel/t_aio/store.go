@@ -2,6 +2,7 @@ package t_aio
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/resonatehq/resonate/pkg/notification"
 	"github.com/resonatehq/resonate/pkg/promise"
@@ -31,6 +32,18 @@ const (
 	TimeoutPromises
 	TimeoutDeleteSubscriptions
 	TimeoutCreateNotifications
+	DeletePromises
+	ReadExpiredPromises
+	DeletePromise
+	CreateNotification
+	ReadPromisesByIdempotencyKey
+	ReadDeadNotification
+	ReadDeadNotifications
+	CreateDeadNotification
+	DeleteDeadNotification
+	DeleteDeadNotifications
+	ReadNotificationAttempts
+	CreateNotificationAttempt
 )
 
 func (k StoreKind) String() string {
@@ -73,6 +86,30 @@ func (k StoreKind) String() string {
 		return "TimeoutDeleteSubscriptions"
 	case TimeoutCreateNotifications:
 		return "TimeoutCreateNotifications"
+	case DeletePromises:
+		return "DeletePromises"
+	case ReadExpiredPromises:
+		return "ReadExpiredPromises"
+	case DeletePromise:
+		return "DeletePromise"
+	case CreateNotification:
+		return "CreateNotification"
+	case ReadPromisesByIdempotencyKey:
+		return "ReadPromisesByIdempotencyKey"
+	case ReadDeadNotification:
+		return "ReadDeadNotification"
+	case ReadDeadNotifications:
+		return "ReadDeadNotifications"
+	case CreateDeadNotification:
+		return "CreateDeadNotification"
+	case DeleteDeadNotification:
+		return "DeleteDeadNotification"
+	case DeleteDeadNotifications:
+		return "DeleteDeadNotifications"
+	case ReadNotificationAttempts:
+		return "ReadNotificationAttempts"
+	case CreateNotificationAttempt:
+		return "CreateNotificationAttempt"
 	default:
 		panic("invalid store kind")
 	}
@@ -83,7 +120,7 @@ type StoreSubmission struct {
 }
 
 func (s *StoreSubmission) String() string {
-	return fmt.Sprintf("Store(transaction=Transaction(commands=%s))", s.Transaction.Commands)
+	return fmt.Sprintf("Store(transaction=Transaction(commands=%s, atomic=%t))", s.Transaction.Commands, s.Transaction.Atomic)
 }
 
 type StoreCompletion struct {
@@ -96,29 +133,46 @@ func (c *StoreCompletion) String() string {
 
 type Transaction struct {
 	Commands []*Command
+
+	// an atomic transaction is rolled back, without affecting other
+	// transactions in the same batch, if any create or update promise
+	// command affects zero rows
+	Atomic bool
 }
 
 type Command struct {
-	Kind                       StoreKind
-	ReadPromise                *ReadPromiseCommand
-	SearchPromises             *SearchPromisesCommand
-	CreatePromise              *CreatePromiseCommand
-	UpdatePromise              *UpdatePromiseCommand
-	ReadTimeouts               *ReadTimeoutsCommand
-	CreateTimeout              *CreateTimeoutCommand
-	DeleteTimeout              *DeleteTimeoutCommand
-	ReadSubscription           *ReadSubscriptionCommand
-	ReadSubscriptions          *ReadSubscriptionsCommand
-	CreateSubscription         *CreateSubscriptionCommand
-	DeleteSubscription         *DeleteSubscriptionCommand
-	DeleteSubscriptions        *DeleteSubscriptionsCommand
-	ReadNotifications          *ReadNotificationsCommand
-	CreateNotifications        *CreateNotificationsCommand
-	UpdateNotification         *UpdateNotificationCommand
-	DeleteNotification         *DeleteNotificationCommand
-	TimeoutPromises            *TimeoutPromisesCommand
-	TimeoutDeleteSubscriptions *TimeoutDeleteSubscriptionsCommand
-	TimeoutCreateNotifications *TimeoutCreateNotificationsCommand
+	Kind                         StoreKind
+	ReadPromise                  *ReadPromiseCommand
+	SearchPromises               *SearchPromisesCommand
+	CreatePromise                *CreatePromiseCommand
+	UpdatePromise                *UpdatePromiseCommand
+	ReadTimeouts                 *ReadTimeoutsCommand
+	CreateTimeout                *CreateTimeoutCommand
+	DeleteTimeout                *DeleteTimeoutCommand
+	ReadSubscription             *ReadSubscriptionCommand
+	ReadSubscriptions            *ReadSubscriptionsCommand
+	CreateSubscription           *CreateSubscriptionCommand
+	DeleteSubscription           *DeleteSubscriptionCommand
+	DeleteSubscriptions          *DeleteSubscriptionsCommand
+	ReadNotifications            *ReadNotificationsCommand
+	CreateNotifications          *CreateNotificationsCommand
+	UpdateNotification           *UpdateNotificationCommand
+	DeleteNotification           *DeleteNotificationCommand
+	TimeoutPromises              *TimeoutPromisesCommand
+	TimeoutDeleteSubscriptions   *TimeoutDeleteSubscriptionsCommand
+	TimeoutCreateNotifications   *TimeoutCreateNotificationsCommand
+	DeletePromises               *DeletePromisesCommand
+	ReadExpiredPromises          *ReadExpiredPromisesCommand
+	DeletePromise                *DeletePromiseCommand
+	CreateNotification           *CreateNotificationCommand
+	ReadPromisesByIdempotencyKey *ReadPromisesByIdempotencyKeyCommand
+	ReadDeadNotification         *ReadDeadNotificationCommand
+	ReadDeadNotifications        *ReadDeadNotificationsCommand
+	CreateDeadNotification       *CreateDeadNotificationCommand
+	DeleteDeadNotification       *DeleteDeadNotificationCommand
+	DeleteDeadNotifications      *DeleteDeadNotificationsCommand
+	ReadNotificationAttempts     *ReadNotificationAttemptsCommand
+	CreateNotificationAttempt    *CreateNotificationAttemptCommand
 }
 
 func (c *Command) String() string {
@@ -126,26 +180,38 @@ func (c *Command) String() string {
 }
 
 type Result struct {
-	Kind                       StoreKind
-	ReadPromise                *QueryPromisesResult
-	SearchPromises             *QueryPromisesResult
-	CreatePromise              *AlterPromisesResult
-	UpdatePromise              *AlterPromisesResult
-	ReadTimeouts               *QueryTimeoutsResult
-	CreateTimeout              *AlterTimeoutsResult
-	DeleteTimeout              *AlterTimeoutsResult
-	ReadSubscription           *QuerySubscriptionsResult
-	ReadSubscriptions          *QuerySubscriptionsResult
-	CreateSubscription         *AlterSubscriptionsResult
-	DeleteSubscription         *AlterSubscriptionsResult
-	DeleteSubscriptions        *AlterSubscriptionsResult
-	ReadNotifications          *QueryNotificationsResult
-	CreateNotifications        *AlterNotificationsResult
-	UpdateNotification         *AlterNotificationsResult
-	DeleteNotification         *AlterNotificationsResult
-	TimeoutPromises            *AlterPromisesResult
-	TimeoutDeleteSubscriptions *AlterSubscriptionsResult
-	TimeoutCreateNotifications *AlterNotificationsResult
+	Kind                         StoreKind
+	ReadPromise                  *QueryPromisesResult
+	SearchPromises               *QueryPromisesResult
+	CreatePromise                *AlterPromisesResult
+	UpdatePromise                *AlterPromisesResult
+	ReadTimeouts                 *QueryTimeoutsResult
+	CreateTimeout                *AlterTimeoutsResult
+	DeleteTimeout                *AlterTimeoutsResult
+	ReadSubscription             *QuerySubscriptionsResult
+	ReadSubscriptions            *QuerySubscriptionsResult
+	CreateSubscription           *AlterSubscriptionsResult
+	DeleteSubscription           *AlterSubscriptionsResult
+	DeleteSubscriptions          *AlterSubscriptionsResult
+	ReadNotifications            *QueryNotificationsResult
+	CreateNotifications          *AlterNotificationsResult
+	UpdateNotification           *AlterNotificationsResult
+	DeleteNotification           *AlterNotificationsResult
+	TimeoutPromises              *AlterPromisesResult
+	TimeoutDeleteSubscriptions   *AlterSubscriptionsResult
+	TimeoutCreateNotifications   *AlterNotificationsResult
+	DeletePromises               *AlterPromisesResult
+	ReadExpiredPromises          *QueryPromisesResult
+	DeletePromise                *AlterPromisesResult
+	CreateNotification           *AlterNotificationsResult
+	ReadPromisesByIdempotencyKey *QueryPromisesResult
+	ReadDeadNotification         *QueryDeadNotificationsResult
+	ReadDeadNotifications        *QueryDeadNotificationsResult
+	CreateDeadNotification       *AlterDeadNotificationsResult
+	DeleteDeadNotification       *AlterDeadNotificationsResult
+	DeleteDeadNotifications      *AlterDeadNotificationsResult
+	ReadNotificationAttempts     *QueryNotificationAttemptsResult
+	CreateNotificationAttempt    *AlterNotificationAttemptsResult
 }
 
 func (r *Result) String() string {
@@ -159,10 +225,19 @@ type ReadPromiseCommand struct {
 }
 
 type SearchPromisesCommand struct {
-	Q      string
-	States []promise.State
-	Limit  int
-	SortId *int64
+	Q               string
+	States          []promise.State
+	Tags            map[string]string
+	CreatedAfter    *int64
+	CreatedBefore   *int64
+	CompletedAfter  *int64
+	CompletedBefore *int64
+	TimeoutBefore   *int64
+	SortBy          promise.SortBy
+	SortOrder       promise.SortOrder
+	Limit           int
+	SortId          *int64
+	SortValue       *int64
 }
 
 type CreatePromiseCommand struct {
@@ -183,6 +258,53 @@ type UpdatePromiseCommand struct {
 	CompletedOn    int64
 }
 
+// DeletePromisesCommand deletes up to limit completed promises, along
+// with their subscriptions and notification attempts, whose retention
+// has elapsed at time. Promises without a retention tag use the default
+// retention and are kept if the default retention is nil. Promises with
+// pending or dead notifications are kept until the notifications are
+// delivered or deleted.
+type DeletePromisesCommand struct {
+	Time      int64
+	Retention *int64
+	Limit     int
+}
+
+// DefaultRetention converts a default retention to milliseconds,
+// promises without a retention tag are kept forever when the default
+// retention is zero.
+func DefaultRetention(retention time.Duration) *int64 {
+	if retention <= 0 {
+		return nil
+	}
+
+	ms := retention.Milliseconds()
+	return &ms
+}
+
+// ReadExpiredPromisesCommand reads the promises that a delete promises
+// command with the same fields would delete.
+type ReadExpiredPromisesCommand struct {
+	Time      int64
+	Retention *int64
+	Limit     int
+}
+
+// DeletePromiseCommand deletes a completed promise, along with its
+// subscriptions and notification attempts. Pending promises and
+// promises with pending or dead notifications are not deleted.
+type DeletePromiseCommand struct {
+	Id string
+}
+
+// ReadPromisesByIdempotencyKeyCommand reads the promises created or
+// completed with an idempotency key, in descending sort id order.
+type ReadPromisesByIdempotencyKeyCommand struct {
+	IdempotencyKey promise.IdempotencyKey
+	Limit          int
+	SortId         *int64
+}
+
 // Promise results
 
 type QueryPromisesResult struct {
@@ -250,6 +372,10 @@ type CreateSubscriptionCommand struct {
 	Id          string
 	PromiseId   string
 	Url         string
+	Method      string
+	Headers     map[string]string
+	Payload     *subscription.Payload
+	Secret      string
 	RetryPolicy *subscription.RetryPolicy
 	CreatedOn   int64
 }
@@ -286,6 +412,21 @@ type CreateNotificationsCommand struct {
 	Time      int64
 }
 
+// CreateNotificationCommand creates a single notification, unlike
+// create notifications the notification does not need a subscription.
+type CreateNotificationCommand struct {
+	Id          string
+	PromiseId   string
+	Url         string
+	Method      string
+	Headers     map[string]string
+	Payload     *subscription.Payload
+	Secret      string
+	RetryPolicy *subscription.RetryPolicy
+	Time        int64
+	Attempt     int64
+}
+
 type UpdateNotificationCommand struct {
 	Id        string
 	PromiseId string
@@ -308,3 +449,89 @@ type QueryNotificationsResult struct {
 type AlterNotificationsResult struct {
 	RowsAffected int64
 }
+
+// Dead notification commands
+
+type ReadDeadNotificationCommand struct {
+	Id        string
+	PromiseId string
+}
+
+type ReadDeadNotificationsCommand struct {
+	Limit  int
+	SortId *int64
+}
+
+// CreateDeadNotificationCommand records a notification that was not
+// delivered before its retry policy was exhausted, along with the
+// status code or error of the last attempt.
+type CreateDeadNotificationCommand struct {
+	Id          string
+	PromiseId   string
+	Url         string
+	Method      string
+	Headers     map[string]string
+	Payload     *subscription.Payload
+	Secret      string
+	RetryPolicy *subscription.RetryPolicy
+	Attempts    int64
+	StatusCode  *int
+	Error       *string
+	CreatedOn   int64
+}
+
+type DeleteDeadNotificationCommand struct {
+	Id        string
+	PromiseId string
+}
+
+// DeleteDeadNotificationsCommand deletes all dead notifications.
+type DeleteDeadNotificationsCommand struct{}
+
+// Dead notification results
+
+type QueryDeadNotificationsResult struct {
+	RowsReturned int64
+	LastSortId   int64
+	Records      []*notification.DeadNotificationRecord
+}
+
+type AlterDeadNotificationsResult struct {
+	RowsAffected int64
+}
+
+// Notification attempt commands
+
+// ReadNotificationAttemptsCommand reads the delivery attempts of the
+// notifications of a subscription, in descending sort id order. An
+// empty id reads the attempts of all notifications of the promise.
+type ReadNotificationAttemptsCommand struct {
+	Id        string
+	PromiseId string
+	Limit     int
+	SortId    *int64
+}
+
+type CreateNotificationAttemptCommand struct {
+	Id         string
+	PromiseId  string
+	Attempt    int64
+	Url        string
+	StatusCode *int
+	Latency    int64
+	Body       []byte
+	Error      *string
+	Time       int64
+}
+
+// Notification attempt results
+
+type QueryNotificationAttemptsResult struct {
+	RowsReturned int64
+	LastSortId   int64
+	Records      []*notification.NotificationAttemptRecord
+}
+
+type AlterNotificationAttemptsResult struct {
+	RowsAffected int64
+}
@@ -2,6 +2,8 @@ package t_api
 
 type Kind int
 
+// Kinds are appended to the end so that the values of existing kinds,
+// which appear in metrics, do not change.
 const (
 	// Promise
 	ReadPromise Kind = iota
@@ -18,4 +20,17 @@ const (
 
 	// Echo
 	Echo
+
+	// Promise
+	Batch
+	CompleteAndCreatePromises
+	ReadPromisesByIdempotencyKey
+
+	// Notification
+	ReadDeadNotifications
+	ReadDeadNotification
+	ReplayDeadNotification
+	DeleteDeadNotification
+	DeleteDeadNotifications
+	ReadNotificationAttempts
 )
@@ -9,17 +9,26 @@ import (
 )
 
 type Request struct {
-	Kind               Kind
-	ReadPromise        *ReadPromiseRequest
-	SearchPromises     *SearchPromisesRequest
-	CreatePromise      *CreatePromiseRequest
-	CancelPromise      *CancelPromiseRequest
-	ResolvePromise     *ResolvePromiseRequest
-	RejectPromise      *RejectPromiseRequest
-	ReadSubscriptions  *ReadSubscriptionsRequest
-	CreateSubscription *CreateSubscriptionRequest
-	DeleteSubscription *DeleteSubscriptionRequest
-	Echo               *EchoRequest
+	Kind                         Kind
+	ReadPromise                  *ReadPromiseRequest
+	SearchPromises               *SearchPromisesRequest
+	CreatePromise                *CreatePromiseRequest
+	CancelPromise                *CancelPromiseRequest
+	ResolvePromise               *ResolvePromiseRequest
+	RejectPromise                *RejectPromiseRequest
+	Batch                        *BatchRequest
+	CompleteAndCreatePromises    *CompleteAndCreatePromisesRequest
+	ReadPromisesByIdempotencyKey *ReadPromisesByIdempotencyKeyRequest
+	ReadSubscriptions            *ReadSubscriptionsRequest
+	CreateSubscription           *CreateSubscriptionRequest
+	DeleteSubscription           *DeleteSubscriptionRequest
+	ReadDeadNotifications        *ReadDeadNotificationsRequest
+	ReadDeadNotification         *ReadDeadNotificationRequest
+	ReplayDeadNotification       *ReplayDeadNotificationRequest
+	DeleteDeadNotification       *DeleteDeadNotificationRequest
+	DeleteDeadNotifications      *DeleteDeadNotificationsRequest
+	ReadNotificationAttempts     *ReadNotificationAttemptsRequest
+	Echo                         *EchoRequest
 }
 
 type ReadPromiseRequest struct {
@@ -27,10 +36,19 @@ type ReadPromiseRequest struct {
 }
 
 type SearchPromisesRequest struct {
-	Q      string          `json:"q"`
-	States []promise.State `json:"states"`
-	Limit  int             `json:"limit"`
-	SortId *int64          `json:"sortId"`
+	Q               string            `json:"q"`
+	States          []promise.State   `json:"states"`
+	Tags            map[string]string `json:"tags,omitempty"`
+	CreatedAfter    *int64            `json:"createdAfter,omitempty"`
+	CreatedBefore   *int64            `json:"createdBefore,omitempty"`
+	CompletedAfter  *int64            `json:"completedAfter,omitempty"`
+	CompletedBefore *int64            `json:"completedBefore,omitempty"`
+	TimeoutBefore   *int64            `json:"timeoutBefore,omitempty"`
+	SortBy          promise.SortBy    `json:"sortBy,omitempty"`
+	SortOrder       promise.SortOrder `json:"sortOrder,omitempty"`
+	Limit           int               `json:"limit"`
+	SortId          *int64            `json:"sortId"`
+	SortValue       *int64            `json:"sortValue,omitempty"`
 }
 
 type CreatePromiseRequest struct {
@@ -63,6 +81,24 @@ type RejectPromiseRequest struct {
 	Value          promise.Value           `json:"value,omitempty"`
 }
 
+type BatchRequest struct {
+	Requests []*Request `json:"requests"`
+}
+
+// CompleteAndCreatePromisesRequest completes one promise and creates
+// zero or more promises atomically, the complete request must be a
+// resolve, reject, or cancel promise request.
+type CompleteAndCreatePromisesRequest struct {
+	Complete *Request                `json:"complete"`
+	Creates  []*CreatePromiseRequest `json:"creates"`
+}
+
+type ReadPromisesByIdempotencyKeyRequest struct {
+	IdempotencyKey promise.IdempotencyKey `json:"idempotencyKey"`
+	Limit          int                    `json:"limit"`
+	SortId         *int64                 `json:"sortId"`
+}
+
 type ReadSubscriptionsRequest struct {
 	PromiseId string `json:"promiseId"`
 	Limit     int    `json:"limit"`
@@ -73,6 +109,10 @@ type CreateSubscriptionRequest struct {
 	Id          string                    `json:"id"`
 	PromiseId   string                    `json:"promiseId"`
 	Url         string                    `json:"url"`
+	Method      string                    `json:"method,omitempty"`
+	Headers     map[string]string         `json:"headers,omitempty"`
+	Payload     *subscription.Payload     `json:"payload,omitempty"`
+	Secret      string                    `json:"secret,omitempty"`
 	RetryPolicy *subscription.RetryPolicy `json:"retryPolicy"`
 }
 
@@ -81,6 +121,40 @@ type DeleteSubscriptionRequest struct {
 	PromiseId string `json:"promiseId"`
 }
 
+type ReadDeadNotificationsRequest struct {
+	Limit  int    `json:"limit"`
+	SortId *int64 `json:"sortId"`
+}
+
+type ReadDeadNotificationRequest struct {
+	Id        string `json:"id"`
+	PromiseId string `json:"promiseId"`
+}
+
+// ReplayDeadNotificationRequest moves a dead notification back to the
+// notifications, the notification is retried from the first attempt.
+type ReplayDeadNotificationRequest struct {
+	Id        string `json:"id"`
+	PromiseId string `json:"promiseId"`
+}
+
+type DeleteDeadNotificationRequest struct {
+	Id        string `json:"id"`
+	PromiseId string `json:"promiseId"`
+}
+
+// DeleteDeadNotificationsRequest purges all dead notifications.
+type DeleteDeadNotificationsRequest struct{}
+
+// ReadNotificationAttemptsRequest reads the delivery attempts of the
+// notifications of a subscription, most recent first.
+type ReadNotificationAttemptsRequest struct {
+	Id        string `json:"id"`
+	PromiseId string `json:"promiseId"`
+	Limit     int    `json:"limit"`
+	SortId    *int64 `json:"sortId"`
+}
+
 type EchoRequest struct {
 	Data string `json:"data"`
 }
@@ -99,9 +173,12 @@ func (r *Request) String() string {
 		}
 
 		return fmt.Sprintf(
-			"SearchPromises(q=%s, states=%s, limit=%d, sortId=%s)",
+			"SearchPromises(q=%s, states=%s, tags=%s, sortBy=%s, sortOrder=%s, limit=%d, sortId=%s)",
 			r.SearchPromises.Q,
 			r.SearchPromises.States,
+			r.SearchPromises.Tags,
+			r.SearchPromises.SortBy,
+			r.SearchPromises.SortOrder,
 			r.SearchPromises.Limit,
 			sortId,
 		)
@@ -134,6 +211,29 @@ func (r *Request) String() string {
 			r.RejectPromise.IdempotencyKey,
 			r.RejectPromise.Strict,
 		)
+	case Batch:
+		return fmt.Sprintf(
+			"Batch(requests=%s)",
+			r.Batch.Requests,
+		)
+	case CompleteAndCreatePromises:
+		return fmt.Sprintf(
+			"CompleteAndCreatePromises(complete=%s, creates=%d)",
+			r.CompleteAndCreatePromises.Complete,
+			len(r.CompleteAndCreatePromises.Creates),
+		)
+	case ReadPromisesByIdempotencyKey:
+		sortId := "<nil>"
+		if r.ReadPromisesByIdempotencyKey.SortId != nil {
+			sortId = strconv.FormatInt(*r.ReadPromisesByIdempotencyKey.SortId, 10)
+		}
+
+		return fmt.Sprintf(
+			"ReadPromisesByIdempotencyKey(idempotencyKey=%s, limit=%d, sortId=%s)",
+			r.ReadPromisesByIdempotencyKey.IdempotencyKey,
+			r.ReadPromisesByIdempotencyKey.Limit,
+			sortId,
+		)
 	case ReadSubscriptions:
 		sortId := "<nil>"
 		if r.ReadSubscriptions.SortId != nil {
@@ -148,10 +248,11 @@ func (r *Request) String() string {
 		)
 	case CreateSubscription:
 		return fmt.Sprintf(
-			"CreateSubscription(id=%s, promiseId=%s, url=%s)",
+			"CreateSubscription(id=%s, promiseId=%s, url=%s, method=%s)",
 			r.CreateSubscription.Id,
 			r.CreateSubscription.PromiseId,
 			r.CreateSubscription.Url,
+			r.CreateSubscription.Method,
 		)
 	case DeleteSubscription:
 		return fmt.Sprintf(
@@ -159,6 +260,50 @@ func (r *Request) String() string {
 			r.DeleteSubscription.Id,
 			r.DeleteSubscription.PromiseId,
 		)
+	case ReadDeadNotifications:
+		sortId := "<nil>"
+		if r.ReadDeadNotifications.SortId != nil {
+			sortId = strconv.FormatInt(*r.ReadDeadNotifications.SortId, 10)
+		}
+
+		return fmt.Sprintf(
+			"ReadDeadNotifications(limit=%d, sortId=%s)",
+			r.ReadDeadNotifications.Limit,
+			sortId,
+		)
+	case ReadDeadNotification:
+		return fmt.Sprintf(
+			"ReadDeadNotification(id=%s, promiseId=%s)",
+			r.ReadDeadNotification.Id,
+			r.ReadDeadNotification.PromiseId,
+		)
+	case ReplayDeadNotification:
+		return fmt.Sprintf(
+			"ReplayDeadNotification(id=%s, promiseId=%s)",
+			r.ReplayDeadNotification.Id,
+			r.ReplayDeadNotification.PromiseId,
+		)
+	case DeleteDeadNotification:
+		return fmt.Sprintf(
+			"DeleteDeadNotification(id=%s, promiseId=%s)",
+			r.DeleteDeadNotification.Id,
+			r.DeleteDeadNotification.PromiseId,
+		)
+	case DeleteDeadNotifications:
+		return "DeleteDeadNotifications()"
+	case ReadNotificationAttempts:
+		sortId := "<nil>"
+		if r.ReadNotificationAttempts.SortId != nil {
+			sortId = strconv.FormatInt(*r.ReadNotificationAttempts.SortId, 10)
+		}
+
+		return fmt.Sprintf(
+			"ReadNotificationAttempts(id=%s, promiseId=%s, limit=%d, sortId=%s)",
+			r.ReadNotificationAttempts.Id,
+			r.ReadNotificationAttempts.PromiseId,
+			r.ReadNotificationAttempts.Limit,
+			sortId,
+		)
 	case Echo:
 		return fmt.Sprintf(
 			"Echo(data=%s)",
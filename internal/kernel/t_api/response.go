@@ -3,22 +3,32 @@ package t_api
 import (
 	"fmt"
 
+	"github.com/resonatehq/resonate/pkg/notification"
 	"github.com/resonatehq/resonate/pkg/promise"
 	"github.com/resonatehq/resonate/pkg/subscription"
 )
 
 type Response struct {
-	Kind               Kind
-	ReadPromise        *ReadPromiseResponse
-	SearchPromises     *SearchPromisesResponse
-	CreatePromise      *CreatePromiseResponse
-	CancelPromise      *CancelPromiseResponse
-	ResolvePromise     *ResolvePromiseResponse
-	RejectPromise      *RejectPromiseResponse
-	ReadSubscriptions  *ReadSubscriptionsResponse
-	CreateSubscription *CreateSubscriptionResponse
-	DeleteSubscription *DeleteSubscriptionResponse
-	Echo               *EchoResponse
+	Kind                         Kind
+	ReadPromise                  *ReadPromiseResponse
+	SearchPromises               *SearchPromisesResponse
+	CreatePromise                *CreatePromiseResponse
+	CancelPromise                *CancelPromiseResponse
+	ResolvePromise               *ResolvePromiseResponse
+	RejectPromise                *RejectPromiseResponse
+	Batch                        *BatchResponse
+	CompleteAndCreatePromises    *CompleteAndCreatePromisesResponse
+	ReadPromisesByIdempotencyKey *ReadPromisesByIdempotencyKeyResponse
+	ReadSubscriptions            *ReadSubscriptionsResponse
+	CreateSubscription           *CreateSubscriptionResponse
+	DeleteSubscription           *DeleteSubscriptionResponse
+	ReadDeadNotifications        *ReadDeadNotificationsResponse
+	ReadDeadNotification         *ReadDeadNotificationResponse
+	ReplayDeadNotification       *ReplayDeadNotificationResponse
+	DeleteDeadNotification       *DeleteDeadNotificationResponse
+	DeleteDeadNotifications      *DeleteDeadNotificationsResponse
+	ReadNotificationAttempts     *ReadNotificationAttemptsResponse
+	Echo                         *EchoResponse
 }
 
 type ResponseStatus int
@@ -29,6 +39,7 @@ const (
 	ResponseNoContent ResponseStatus = 204
 	ResponseForbidden ResponseStatus = 403
 	ResponseNotFound  ResponseStatus = 404
+	ResponseConflict  ResponseStatus = 409
 )
 
 type ReadPromiseResponse struct {
@@ -62,6 +73,29 @@ type RejectPromiseResponse struct {
 	Promise *promise.Promise `json:"promise,omitempty"`
 }
 
+// BatchResponse has a response for each request of a batch, if a
+// request failed its response is nil and its error is set instead.
+type BatchResponse struct {
+	Status    ResponseStatus `json:"status"`
+	Responses []*Response    `json:"responses"`
+	Errors    []error        `json:"-"`
+}
+
+// CompleteAndCreatePromisesResponse has status conflict if any item
+// failed, in which case nothing is written and the items that would
+// have been written also have status conflict.
+type CompleteAndCreatePromisesResponse struct {
+	Status   ResponseStatus           `json:"status"`
+	Complete *Response                `json:"complete"`
+	Creates  []*CreatePromiseResponse `json:"creates"`
+}
+
+type ReadPromisesByIdempotencyKeyResponse struct {
+	Status   ResponseStatus                               `json:"status"`
+	Cursor   *Cursor[ReadPromisesByIdempotencyKeyRequest] `json:"cursor,omitempty"`
+	Promises []*promise.Promise                           `json:"promises,omitempty"`
+}
+
 type ReadSubscriptionsResponse struct {
 	Status        ResponseStatus                    `json:"status"`
 	Cursor        *Cursor[ReadSubscriptionsRequest] `json:"cursor,omitempty"`
@@ -77,6 +111,37 @@ type DeleteSubscriptionResponse struct {
 	Status ResponseStatus `json:"status"`
 }
 
+type ReadDeadNotificationsResponse struct {
+	Status            ResponseStatus                        `json:"status"`
+	Cursor            *Cursor[ReadDeadNotificationsRequest] `json:"cursor,omitempty"`
+	DeadNotifications []*notification.DeadNotification      `json:"deadNotifications,omitempty"`
+}
+
+type ReadDeadNotificationResponse struct {
+	Status           ResponseStatus                 `json:"status"`
+	DeadNotification *notification.DeadNotification `json:"deadNotification,omitempty"`
+}
+
+type ReplayDeadNotificationResponse struct {
+	Status           ResponseStatus                 `json:"status"`
+	DeadNotification *notification.DeadNotification `json:"deadNotification,omitempty"`
+}
+
+type DeleteDeadNotificationResponse struct {
+	Status ResponseStatus `json:"status"`
+}
+
+type DeleteDeadNotificationsResponse struct {
+	Status  ResponseStatus `json:"status"`
+	Deleted int64          `json:"deleted"`
+}
+
+type ReadNotificationAttemptsResponse struct {
+	Status   ResponseStatus                           `json:"status"`
+	Cursor   *Cursor[ReadNotificationAttemptsRequest] `json:"cursor,omitempty"`
+	Attempts []*notification.NotificationAttempt      `json:"attempts,omitempty"`
+}
+
 type EchoResponse struct {
 	Data string `json:"data"`
 }
@@ -120,6 +185,26 @@ func (r *Response) String() string {
 			r.RejectPromise.Status,
 			r.RejectPromise.Promise,
 		)
+	case Batch:
+		return fmt.Sprintf(
+			"Batch(status=%d, responses=%s)",
+			r.Batch.Status,
+			r.Batch.Responses,
+		)
+	case CompleteAndCreatePromises:
+		return fmt.Sprintf(
+			"CompleteAndCreatePromises(status=%d, complete=%s, creates=%d)",
+			r.CompleteAndCreatePromises.Status,
+			r.CompleteAndCreatePromises.Complete,
+			len(r.CompleteAndCreatePromises.Creates),
+		)
+	case ReadPromisesByIdempotencyKey:
+		return fmt.Sprintf(
+			"ReadPromisesByIdempotencyKey(status=%d, cursor=%s, promises=%s)",
+			r.ReadPromisesByIdempotencyKey.Status,
+			r.ReadPromisesByIdempotencyKey.Cursor,
+			r.ReadPromisesByIdempotencyKey.Promises,
+		)
 	case ReadSubscriptions:
 		return fmt.Sprintf(
 			"ReadSubscriptions(status=%d, subscriptions=%s)",
@@ -137,6 +222,41 @@ func (r *Response) String() string {
 			"DeleteSubscription(status=%d)",
 			r.DeleteSubscription.Status,
 		)
+	case ReadDeadNotifications:
+		return fmt.Sprintf(
+			"ReadDeadNotifications(status=%d, deadNotifications=%s)",
+			r.ReadDeadNotifications.Status,
+			r.ReadDeadNotifications.DeadNotifications,
+		)
+	case ReadDeadNotification:
+		return fmt.Sprintf(
+			"ReadDeadNotification(status=%d, deadNotification=%s)",
+			r.ReadDeadNotification.Status,
+			r.ReadDeadNotification.DeadNotification,
+		)
+	case ReplayDeadNotification:
+		return fmt.Sprintf(
+			"ReplayDeadNotification(status=%d, deadNotification=%s)",
+			r.ReplayDeadNotification.Status,
+			r.ReplayDeadNotification.DeadNotification,
+		)
+	case DeleteDeadNotification:
+		return fmt.Sprintf(
+			"DeleteDeadNotification(status=%d)",
+			r.DeleteDeadNotification.Status,
+		)
+	case DeleteDeadNotifications:
+		return fmt.Sprintf(
+			"DeleteDeadNotifications(status=%d, deleted=%d)",
+			r.DeleteDeadNotifications.Status,
+			r.DeleteDeadNotifications.Deleted,
+		)
+	case ReadNotificationAttempts:
+		return fmt.Sprintf(
+			"ReadNotificationAttempts(status=%d, attempts=%s)",
+			r.ReadNotificationAttempts.Status,
+			r.ReadNotificationAttempts.Attempts,
+		)
 	case Echo:
 		return fmt.Sprintf(
 			"Echo(data=%s)",
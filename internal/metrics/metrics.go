@@ -3,12 +3,14 @@ package metrics
 import "github.com/prometheus/client_golang/prometheus"
 
 type Metrics struct {
-	AioTotal           *prometheus.CounterVec
-	AioInFlight        *prometheus.GaugeVec
-	ApiTotal           *prometheus.CounterVec
-	ApiInFlight        *prometheus.GaugeVec
-	CoroutinesTotal    *prometheus.CounterVec
-	CoroutinesInFlight *prometheus.GaugeVec
+	AioTotal               *prometheus.CounterVec
+	AioInFlight            *prometheus.GaugeVec
+	AioBatchTotal          *prometheus.CounterVec
+	AioNetworkCircuitState *prometheus.GaugeVec
+	ApiTotal               *prometheus.CounterVec
+	ApiInFlight            *prometheus.GaugeVec
+	CoroutinesTotal        *prometheus.CounterVec
+	CoroutinesInFlight     *prometheus.GaugeVec
 }
 
 func New(reg prometheus.Registerer) *Metrics {
@@ -21,6 +23,14 @@ func New(reg prometheus.Registerer) *Metrics {
 			Name: "aio_in_flight_submissions",
 			Help: "Number of in flight aio submissions",
 		}, []string{"type"}),
+		AioBatchTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "aio_total_batches",
+			Help: "Total number of aio batches",
+		}, []string{"type", "status"}),
+		AioNetworkCircuitState: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "aio_network_circuit_state",
+			Help: "Circuit breaker state of a network host",
+		}, []string{"host"}),
 		ApiTotal: prometheus.NewCounterVec(prometheus.CounterOpts{
 			Name: "api_total_requests",
 			Help: "Total number of api requests",
@@ -46,6 +56,8 @@ func New(reg prometheus.Registerer) *Metrics {
 func (m *Metrics) Enable(reg prometheus.Registerer) {
 	reg.MustRegister(m.AioTotal)
 	reg.MustRegister(m.AioInFlight)
+	reg.MustRegister(m.AioBatchTotal)
+	reg.MustRegister(m.AioNetworkCircuitState)
 	reg.MustRegister(m.ApiTotal)
 	reg.MustRegister(m.ApiInFlight)
 	reg.MustRegister(m.CoroutinesTotal)
@@ -55,6 +67,8 @@ func (m *Metrics) Enable(reg prometheus.Registerer) {
 func (m *Metrics) Disable(reg prometheus.Registerer) {
 	reg.Unregister(m.AioTotal)
 	reg.Unregister(m.AioInFlight)
+	reg.Unregister(m.AioBatchTotal)
+	reg.Unregister(m.AioNetworkCircuitState)
 	reg.Unregister(m.ApiTotal)
 	reg.Unregister(m.ApiInFlight)
 	reg.Unregister(m.CoroutinesTotal)
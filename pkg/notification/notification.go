@@ -2,6 +2,7 @@ package notification
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/resonatehq/resonate/pkg/subscription"
 )
@@ -10,6 +11,10 @@ type Notification struct {
 	Id          string                    `json:"id"`
 	PromiseId   string                    `json:"promiseId"`
 	Url         string                    `json:"url"`
+	Method      string                    `json:"method,omitempty"`
+	Headers     map[string]string         `json:"headers,omitempty"`
+	Payload     *subscription.Payload     `json:"payload,omitempty"`
+	Secret      string                    `json:"secret,omitempty"`
 	RetryPolicy *subscription.RetryPolicy `json:"retryPolicy"`
 	Time        int64                     `json:"time"`
 	Attempt     int64                     `json:"attempt"`
@@ -17,12 +22,80 @@ type Notification struct {
 
 func (n *Notification) String() string {
 	return fmt.Sprintf(
-		"Notification(id=%s, promiseId=%s, url=%s, retryPolicy=%s, time=%d, attempt=%d)",
+		"Notification(id=%s, promiseId=%s, url=%s, method=%s, retryPolicy=%s, time=%d, attempt=%d)",
 		n.Id,
 		n.PromiseId,
 		n.Url,
+		n.Method,
 		n.RetryPolicy,
 		n.Time,
 		n.Attempt,
 	)
 }
+
+// DeadNotification is a notification that was not delivered before its
+// retry policy was exhausted, the status code or error of the last
+// attempt is recorded.
+type DeadNotification struct {
+	Id          string                    `json:"id"`
+	PromiseId   string                    `json:"promiseId"`
+	Url         string                    `json:"url"`
+	Method      string                    `json:"method,omitempty"`
+	Headers     map[string]string         `json:"headers,omitempty"`
+	Payload     *subscription.Payload     `json:"payload,omitempty"`
+	Secret      string                    `json:"-"` // write only
+	RetryPolicy *subscription.RetryPolicy `json:"retryPolicy"`
+	Attempts    int64                     `json:"attempts"`
+	StatusCode  *int                      `json:"statusCode,omitempty"`
+	Error       *string                   `json:"error,omitempty"`
+	CreatedOn   int64                     `json:"createdOn"`
+	SortId      int64                     `json:"-"` // unexported
+}
+
+func (n *DeadNotification) String() string {
+	statusCode := "<nil>"
+	if n.StatusCode != nil {
+		statusCode = strconv.Itoa(*n.StatusCode)
+	}
+
+	return fmt.Sprintf(
+		"DeadNotification(id=%s, promiseId=%s, url=%s, attempts=%d, statusCode=%s)",
+		n.Id,
+		n.PromiseId,
+		n.Url,
+		n.Attempts,
+		statusCode,
+	)
+}
+
+// NotificationAttempt is a delivery attempt of a notification, the response body
+// is truncated.
+type NotificationAttempt struct {
+	Id         string  `json:"id"`
+	PromiseId  string  `json:"promiseId"`
+	Attempt    int64   `json:"attempt"`
+	Url        string  `json:"url"`
+	StatusCode *int    `json:"statusCode,omitempty"`
+	Latency    int64   `json:"latency"`
+	Body       string  `json:"body,omitempty"`
+	Error      *string `json:"error,omitempty"`
+	Time       int64   `json:"time"`
+	SortId     int64   `json:"-"` // unexported
+}
+
+func (a *NotificationAttempt) String() string {
+	statusCode := "<nil>"
+	if a.StatusCode != nil {
+		statusCode = strconv.Itoa(*a.StatusCode)
+	}
+
+	return fmt.Sprintf(
+		"NotificationAttempt(id=%s, promiseId=%s, attempt=%d, url=%s, statusCode=%s, latency=%d)",
+		a.Id,
+		a.PromiseId,
+		a.Attempt,
+		a.Url,
+		statusCode,
+		a.Latency,
+	)
+}
@@ -10,12 +10,21 @@ type NotificationRecord struct {
 	Id          string
 	PromiseId   string
 	Url         string
+	Method      string
+	Headers     []byte
+	Payload     []byte
+	Secret      string
 	RetryPolicy []byte
 	Time        int64
 	Attempt     int64
 }
 
 func (r *NotificationRecord) Notification() (*Notification, error) {
+	headers, payload, err := subscription.UnmarshalDelivery(r.Headers, r.Payload)
+	if err != nil {
+		return nil, err
+	}
+
 	var retryPolicy *subscription.RetryPolicy
 	if err := json.Unmarshal(r.RetryPolicy, &retryPolicy); err != nil {
 		return nil, err
@@ -25,8 +34,84 @@ func (r *NotificationRecord) Notification() (*Notification, error) {
 		Id:          r.Id,
 		PromiseId:   r.PromiseId,
 		Url:         r.Url,
+		Method:      r.Method,
+		Headers:     headers,
+		Payload:     payload,
+		Secret:      r.Secret,
 		RetryPolicy: retryPolicy,
 		Time:        r.Time,
 		Attempt:     r.Attempt,
 	}, nil
 }
+
+type DeadNotificationRecord struct {
+	Id          string
+	PromiseId   string
+	Url         string
+	Method      string
+	Headers     []byte
+	Payload     []byte
+	Secret      string
+	RetryPolicy []byte
+	Attempts    int64
+	StatusCode  *int
+	Error       *string
+	CreatedOn   int64
+	SortId      int64
+}
+
+func (r *DeadNotificationRecord) DeadNotification() (*DeadNotification, error) {
+	headers, payload, err := subscription.UnmarshalDelivery(r.Headers, r.Payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var retryPolicy *subscription.RetryPolicy
+	if err := json.Unmarshal(r.RetryPolicy, &retryPolicy); err != nil {
+		return nil, err
+	}
+
+	return &DeadNotification{
+		Id:          r.Id,
+		PromiseId:   r.PromiseId,
+		Url:         r.Url,
+		Method:      r.Method,
+		Headers:     headers,
+		Payload:     payload,
+		Secret:      r.Secret,
+		RetryPolicy: retryPolicy,
+		Attempts:    r.Attempts,
+		StatusCode:  r.StatusCode,
+		Error:       r.Error,
+		CreatedOn:   r.CreatedOn,
+		SortId:      r.SortId,
+	}, nil
+}
+
+type NotificationAttemptRecord struct {
+	Id         string
+	PromiseId  string
+	Attempt    int64
+	Url        string
+	StatusCode *int
+	Latency    int64
+	Body       []byte
+	Error      *string
+	Time       int64
+	SortId     int64
+}
+
+func (r *NotificationAttemptRecord) NotificationAttempt() *NotificationAttempt {
+	return &NotificationAttempt{
+		Id:         r.Id,
+		PromiseId:  r.PromiseId,
+		Attempt:    r.Attempt,
+		Url:        r.Url,
+		StatusCode: r.StatusCode,
+		Latency:    r.Latency,
+		Body:       string(r.Body),
+		Error:      r.Error,
+		Time:       r.Time,
+		SortId:     r.SortId,
+	}
+}
@@ -33,6 +33,26 @@ func (p *Promise) String() string {
 	)
 }
 
+// Timedout returns the promise as it is once its timeout has elapsed,
+// the promise is completed on its timeout with an empty value.
+func (p *Promise) Timedout() *Promise {
+	return &Promise{
+		Id:    p.Id,
+		State: Timedout,
+		Param: p.Param,
+		Value: Value{
+			Headers: map[string]string{},
+			Data:    []byte{},
+		},
+		Timeout:                   p.Timeout,
+		IdempotencyKeyForCreate:   p.IdempotencyKeyForCreate,
+		IdempotencyKeyForComplete: p.IdempotencyKeyForComplete,
+		Tags:                      p.Tags,
+		CreatedOn:                 p.CreatedOn,
+		CompletedOn:               &p.Timeout,
+	}
+}
+
 type State int
 
 const (
@@ -0,0 +1,60 @@
+package promise
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetentionTag overrides the default retention of a promise, the value
+// is a go duration or a number of days, for example 36h or 7d.
+const RetentionTag = "resonate:retention"
+
+// Retention returns the retention in milliseconds set by the retention
+// tag, or nil if the tag is not set. An error is returned if the tag
+// cannot be parsed.
+func Retention(tags map[string]string) (*int64, error) {
+	value, ok := tags[RetentionTag]
+	if !ok {
+		return nil, nil
+	}
+
+	retention, err := parseRetention(value)
+	if err != nil {
+		return nil, fmt.Errorf("tag %s must be a non-negative duration or number of days, for example 36h or 7d", RetentionTag)
+	}
+
+	ms := retention.Milliseconds()
+	return &ms, nil
+}
+
+// parseRetention parses a retention, in addition to go durations a
+// number of days is accepted.
+func parseRetention(value string) (time.Duration, error) {
+	var retention time.Duration
+
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.ParseInt(days, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		if n > int64(math.MaxInt64/(24*time.Hour)) {
+			return 0, fmt.Errorf("retention is out of range")
+		}
+		retention = time.Duration(n) * 24 * time.Hour
+	} else {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return 0, err
+		}
+		retention = d
+	}
+
+	if retention < 0 {
+		return 0, fmt.Errorf("retention must be non-negative")
+	}
+
+	return retention, nil
+}
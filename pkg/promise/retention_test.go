@@ -0,0 +1,41 @@
+package promise
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetention(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		tags      map[string]string
+		retention *int64
+		err       bool
+	}{
+		{name: "None", tags: map[string]string{}},
+		{name: "Duration", tags: map[string]string{RetentionTag: "36h"}, retention: int64ToPointer(36 * 60 * 60 * 1000)},
+		{name: "Days", tags: map[string]string{RetentionTag: "7d"}, retention: int64ToPointer(7 * 24 * 60 * 60 * 1000)},
+		{name: "Zero", tags: map[string]string{RetentionTag: "0s"}, retention: int64ToPointer(0)},
+		{name: "Negative", tags: map[string]string{RetentionTag: "-1h"}, err: true},
+		{name: "NegativeDays", tags: map[string]string{RetentionTag: "-1d"}, err: true},
+		{name: "OverflowDays", tags: map[string]string{RetentionTag: "1000000d"}, err: true},
+		{name: "Invalid", tags: map[string]string{RetentionTag: "7days"}, err: true},
+		{name: "Empty", tags: map[string]string{RetentionTag: ""}, err: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			retention, err := Retention(tc.tags)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.retention, retention)
+		})
+	}
+}
+
+func int64ToPointer(i int64) *int64 {
+	return &i
+}
@@ -0,0 +1,69 @@
+package promise
+
+type SortBy int
+
+const (
+	SortBySortId SortBy = iota
+	SortByCreatedOn
+	SortByTimeout
+)
+
+func (s SortBy) String() string {
+	switch s {
+	case SortBySortId:
+		return "sortId"
+	case SortByCreatedOn:
+		return "createdOn"
+	case SortByTimeout:
+		return "timeout"
+	default:
+		panic("invalid sort by")
+	}
+}
+
+// Valid returns true if the sort by is one of the known columns, sort
+// by values from cursors are not trusted.
+func (s SortBy) Valid() bool {
+	return s == SortBySortId || s == SortByCreatedOn || s == SortByTimeout
+}
+
+// Value returns the value of the sort column for the given record,
+// used to resume a search from a cursor.
+func (s SortBy) Value(r *PromiseRecord) int64 {
+	switch s {
+	case SortBySortId:
+		return r.SortId
+	case SortByCreatedOn:
+		if r.CreatedOn == nil {
+			return 0
+		}
+		return *r.CreatedOn
+	case SortByTimeout:
+		return r.Timeout
+	default:
+		panic("invalid sort by")
+	}
+}
+
+type SortOrder int
+
+const (
+	Descending SortOrder = iota
+	Ascending
+)
+
+func (s SortOrder) String() string {
+	switch s {
+	case Descending:
+		return "desc"
+	case Ascending:
+		return "asc"
+	default:
+		panic("invalid sort order")
+	}
+}
+
+// Valid returns true if the sort order is one of the known directions.
+func (s SortOrder) Valid() bool {
+	return s == Descending || s == Ascending
+}
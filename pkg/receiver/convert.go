@@ -0,0 +1,55 @@
+package receiver
+
+import "github.com/resonatehq/resonate/pkg/promise"
+
+// FromPromise converts a promise to its protobuf message, the message
+// is shared by the grpc api and grpc notifications.
+func FromPromise(p *promise.Promise) *Promise {
+	if p == nil {
+		return nil
+	}
+
+	var idempotencyKeyForCreate, idempotencyKeyForComplete string
+	if p.IdempotencyKeyForCreate != nil {
+		idempotencyKeyForCreate = string(*p.IdempotencyKeyForCreate)
+	}
+	if p.IdempotencyKeyForComplete != nil {
+		idempotencyKeyForComplete = string(*p.IdempotencyKeyForComplete)
+	}
+
+	return &Promise{
+		Id:    p.Id,
+		State: FromState(p.State),
+		Param: &Value{
+			Headers: p.Param.Headers,
+			Data:    p.Param.Data,
+		},
+		Value: &Value{
+			Headers: p.Value.Headers,
+			Data:    p.Value.Data,
+		},
+		Timeout:                   p.Timeout,
+		IdempotencyKeyForCreate:   idempotencyKeyForCreate,
+		IdempotencyKeyForComplete: idempotencyKeyForComplete,
+		Tags:                      p.Tags,
+		CreatedOn:                 p.CreatedOn,
+		CompletedOn:               p.CompletedOn,
+	}
+}
+
+func FromState(state promise.State) State {
+	switch state {
+	case promise.Pending:
+		return State_PENDING
+	case promise.Resolved:
+		return State_RESOLVED
+	case promise.Rejected:
+		return State_REJECTED
+	case promise.Timedout:
+		return State_REJECTED_TIMEDOUT
+	case promise.Canceled:
+		return State_REJECTED_CANCELED
+	default:
+		panic("invalid state")
+	}
+}
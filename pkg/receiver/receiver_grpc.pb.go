@@ -0,0 +1,109 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.23.3
+// source: pkg/receiver/receiver.proto
+
+package receiver
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	NotificationReceiver_Notify_FullMethodName = "/promise.NotificationReceiver/Notify"
+)
+
+// NotificationReceiverClient is the client API for NotificationReceiver service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NotificationReceiverClient interface {
+	Notify(ctx context.Context, in *Promise, opts ...grpc.CallOption) (*NotifyResponse, error)
+}
+
+type notificationReceiverClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNotificationReceiverClient(cc grpc.ClientConnInterface) NotificationReceiverClient {
+	return &notificationReceiverClient{cc}
+}
+
+func (c *notificationReceiverClient) Notify(ctx context.Context, in *Promise, opts ...grpc.CallOption) (*NotifyResponse, error) {
+	out := new(NotifyResponse)
+	err := c.cc.Invoke(ctx, NotificationReceiver_Notify_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NotificationReceiverServer is the server API for NotificationReceiver service.
+// All implementations must embed UnimplementedNotificationReceiverServer
+// for forward compatibility
+type NotificationReceiverServer interface {
+	Notify(context.Context, *Promise) (*NotifyResponse, error)
+	mustEmbedUnimplementedNotificationReceiverServer()
+}
+
+// UnimplementedNotificationReceiverServer must be embedded to have forward compatible implementations.
+type UnimplementedNotificationReceiverServer struct {
+}
+
+func (UnimplementedNotificationReceiverServer) Notify(context.Context, *Promise) (*NotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notify not implemented")
+}
+func (UnimplementedNotificationReceiverServer) mustEmbedUnimplementedNotificationReceiverServer() {}
+
+// UnsafeNotificationReceiverServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NotificationReceiverServer will
+// result in compilation errors.
+type UnsafeNotificationReceiverServer interface {
+	mustEmbedUnimplementedNotificationReceiverServer()
+}
+
+func RegisterNotificationReceiverServer(s grpc.ServiceRegistrar, srv NotificationReceiverServer) {
+	s.RegisterService(&NotificationReceiver_ServiceDesc, srv)
+}
+
+func _NotificationReceiver_Notify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Promise)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationReceiverServer).Notify(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationReceiver_Notify_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationReceiverServer).Notify(ctx, req.(*Promise))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NotificationReceiver_ServiceDesc is the grpc.ServiceDesc for NotificationReceiver service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NotificationReceiver_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "promise.NotificationReceiver",
+	HandlerType: (*NotificationReceiverServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Notify",
+			Handler:    _NotificationReceiver_Notify_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/receiver/receiver.proto",
+}
@@ -6,12 +6,21 @@ type SubscriptionRecord struct {
 	Id          string
 	PromiseId   string
 	Url         string
+	Method      string
+	Headers     []byte
+	Payload     []byte
+	Secret      string
 	RetryPolicy []byte
 	CreatedOn   int64
 	SortId      int64
 }
 
 func (r *SubscriptionRecord) Subscription() (*Subscription, error) {
+	headers, payload, err := UnmarshalDelivery(r.Headers, r.Payload)
+	if err != nil {
+		return nil, err
+	}
+
 	var retryPolicy *RetryPolicy
 	if err := json.Unmarshal(r.RetryPolicy, &retryPolicy); err != nil {
 		return nil, err
@@ -21,8 +30,55 @@ func (r *SubscriptionRecord) Subscription() (*Subscription, error) {
 		Id:          r.Id,
 		PromiseId:   r.PromiseId,
 		Url:         r.Url,
+		Method:      r.Method,
+		Headers:     headers,
+		Payload:     payload,
+		Secret:      r.Secret,
 		RetryPolicy: retryPolicy,
 		CreatedOn:   r.CreatedOn,
 		SortId:      r.SortId,
 	}, nil
 }
+
+// UnmarshalDelivery unmarshals the headers and payload of a
+// subscription or notification record, both are optional and are nil
+// for records created before they were introduced.
+func UnmarshalDelivery(rawHeaders []byte, rawPayload []byte) (map[string]string, *Payload, error) {
+	var headers map[string]string
+	if rawHeaders != nil {
+		if err := json.Unmarshal(rawHeaders, &headers); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var payload *Payload
+	if rawPayload != nil {
+		if err := json.Unmarshal(rawPayload, &payload); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return headers, payload, nil
+}
+
+// MarshalDelivery is the inverse of UnmarshalDelivery, empty headers
+// and a nil payload are marshalled as nil.
+func MarshalDelivery(headers map[string]string, payload *Payload) ([]byte, []byte, error) {
+	var rawHeaders []byte
+	if len(headers) > 0 {
+		var err error
+		if rawHeaders, err = json.Marshal(headers); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	var rawPayload []byte
+	if payload != nil {
+		var err error
+		if rawPayload, err = json.Marshal(payload); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return rawHeaders, rawPayload, nil
+}
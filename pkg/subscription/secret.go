@@ -0,0 +1,50 @@
+package subscription
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SecretPrefix prefixes the base64 encoded key of a secret, secrets
+// and signatures follow the standard webhooks spec used by svix.
+const SecretPrefix = "whsec_"
+
+func ParseSecret(secret string) ([]byte, error) {
+	encoded, ok := strings.CutPrefix(secret, SecretPrefix)
+	if !ok {
+		return nil, fmt.Errorf("secret must start with %s", SecretPrefix)
+	}
+
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("secret must be base64 encoded")
+	}
+
+	if len(key) < 24 || len(key) > 64 {
+		return nil, fmt.Errorf("secret must be between 24 and 64 bytes")
+	}
+
+	return key, nil
+}
+
+// Sign returns the webhook-signature header value for a message, the
+// message is signed with each key and the signatures are space
+// delimited so that receivers can verify with either key while a key
+// is rotated.
+func Sign(keys [][]byte, id string, timestamp int64, body []byte) string {
+	signatures := make([]string, len(keys))
+
+	for i, key := range keys {
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(id + "." + strconv.FormatInt(timestamp, 10) + "."))
+		mac.Write(body)
+
+		signatures[i] = "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	}
+
+	return strings.Join(signatures, " ")
+}
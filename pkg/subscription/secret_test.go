@@ -0,0 +1,36 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSign(t *testing.T) {
+	// example from the standard webhooks spec
+	key, err := ParseSecret("whsec_MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signature := "v1,g0hM9SsE+OTPJTGt/tmIKtSyZlE3uFJELVlNIOLJ1OE="
+	body := []byte(`{"test": 2432232314}`)
+
+	assert.Equal(t, signature, Sign([][]byte{key}, "msg_p5jXN8AQM9LWM0D4loKWxJek", 1614265330, body))
+	assert.Equal(t, signature+" "+signature, Sign([][]byte{key, key}, "msg_p5jXN8AQM9LWM0D4loKWxJek", 1614265330, body))
+	assert.Equal(t, "", Sign(nil, "msg_p5jXN8AQM9LWM0D4loKWxJek", 1614265330, body))
+}
+
+func TestParseSecret(t *testing.T) {
+	for _, tc := range []struct {
+		secret string
+		err    string
+	}{
+		{secret: "MfKQ9r8GKYqrTwjUPD8ILPZIo2LaLaSw", err: "secret must start with whsec_"},
+		{secret: "whsec_!", err: "secret must be base64 encoded"},
+		{secret: "whsec_Zm9v", err: "secret must be between 24 and 64 bytes"},
+	} {
+		_, err := ParseSecret(tc.secret)
+		assert.EqualError(t, err, tc.err)
+	}
+}
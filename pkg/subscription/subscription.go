@@ -1,35 +1,140 @@
 package subscription
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+)
 
 type Subscription struct {
-	Id          string       `json:"id"`
-	PromiseId   string       `json:"promiseId"`
-	Url         string       `json:"url"`
-	RetryPolicy *RetryPolicy `json:"retryPolicy"`
-	CreatedOn   int64        `json:"createdOn"`
-	SortId      int64        `json:"-"` // unexported
+	Id          string            `json:"id"`
+	PromiseId   string            `json:"promiseId"`
+	Url         string            `json:"url"`
+	Method      string            `json:"method,omitempty"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Payload     *Payload          `json:"payload,omitempty"`
+	Secret      string            `json:"secret,omitempty"`
+	RetryPolicy *RetryPolicy      `json:"retryPolicy"`
+	CreatedOn   int64             `json:"createdOn"`
+	SortId      int64             `json:"-"` // unexported
 }
 
+// GrpcScheme is the url scheme of subscriptions that are notified with
+// the NotificationReceiver grpc service instead of a webhook.
+const GrpcScheme = "grpc"
+
+// Retry policy kinds, a retry policy without a kind is exponential.
+const (
+	Exponential = "exponential"
+	Linear      = "linear"
+	Schedule    = "schedule"
+)
+
+// RetryPolicy determines how often and when a notification is retried,
+// delays are in milliseconds. Exponential policies may cap the delay
+// with a max delay and apply full jitter, schedule policies take the
+// delay of each retry from the schedule and repeat the last delay of
+// the schedule once it is exhausted.
 type RetryPolicy struct {
-	Delay    int64 `json:"delay"`
-	Attempts int64 `json:"attempts"`
+	Kind     string  `json:"kind,omitempty"`
+	Delay    int64   `json:"delay"`
+	Attempts int64   `json:"attempts"`
+	MaxDelay int64   `json:"maxDelay,omitempty"`
+	Jitter   bool    `json:"jitter,omitempty"`
+	Schedule []int64 `json:"schedule,omitempty"`
+}
+
+// Payload is a template for the body of a notification, the body is
+// the promise with param and value data omitted if excluded.
+type Payload struct {
+	ExcludeParamData bool `json:"excludeParamData,omitempty"`
+	ExcludeValueData bool `json:"excludeValueData,omitempty"`
 }
 
 func (s *Subscription) String() string {
 	return fmt.Sprintf(
-		"Subscription(id=%s, promiseId=%s, url=%s, retryPolicy=%s)",
+		"Subscription(id=%s, promiseId=%s, url=%s, method=%s, retryPolicy=%s)",
 		s.Id,
 		s.PromiseId,
 		s.Url,
+		s.Method,
 		s.RetryPolicy,
 	)
 }
 
 func (r *RetryPolicy) String() string {
 	return fmt.Sprintf(
-		"RetryPolicy(delay=%d, attempts=%d)",
+		"RetryPolicy(kind=%s, delay=%d, attempts=%d)",
+		r.Kind,
 		r.Delay,
 		r.Attempts,
 	)
 }
+
+func (r *RetryPolicy) Validate() error {
+	switch r.Kind {
+	case "", Exponential, Linear, Schedule:
+	default:
+		return fmt.Errorf("retry policy kind must be one of %s, %s, %s", Exponential, Linear, Schedule)
+	}
+
+	if r.Delay < 0 {
+		return fmt.Errorf("retry policy delay must be non-negative")
+	}
+	if r.Attempts < 0 {
+		return fmt.Errorf("retry policy attempts must be non-negative")
+	}
+	if r.MaxDelay < 0 {
+		return fmt.Errorf("retry policy max delay must be non-negative")
+	}
+	if (r.MaxDelay != 0 || r.Jitter) && !r.exponential() {
+		return fmt.Errorf("retry policy max delay and jitter require kind %s", Exponential)
+	}
+
+	if r.Kind == Schedule {
+		if len(r.Schedule) == 0 {
+			return fmt.Errorf("retry policy schedule must not be empty")
+		}
+		for _, delay := range r.Schedule {
+			if delay < 0 {
+				return fmt.Errorf("retry policy schedule delays must be non-negative")
+			}
+		}
+	} else if len(r.Schedule) != 0 {
+		return fmt.Errorf("retry policy schedule requires kind %s", Schedule)
+	}
+
+	return nil
+}
+
+// Backoff returns the delay before a retry, attempt is the zero based
+// number of the failed attempt. Jitter must be in [0, 1) and scales the
+// delay if the policy applies full jitter.
+func (r *RetryPolicy) Backoff(attempt int64, jitter float64) int64 {
+	var delay float64
+
+	switch r.Kind {
+	case Linear:
+		delay = float64(r.Delay) * float64(attempt+1)
+	case Schedule:
+		delay = float64(r.Schedule[min(attempt, int64(len(r.Schedule)-1))])
+	default:
+		delay = float64(r.Delay) * math.Pow(2, float64(attempt))
+		if r.MaxDelay > 0 {
+			delay = math.Min(delay, float64(r.MaxDelay))
+		}
+		if r.Jitter {
+			delay *= jitter
+		}
+	}
+
+	// float64(math.MaxInt64) rounds up to 2^63
+	if delay >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+
+	return int64(delay)
+}
+
+func (r *RetryPolicy) exponential() bool {
+	return r.Kind == "" || r.Kind == Exponential
+}
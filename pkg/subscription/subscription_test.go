@@ -0,0 +1,53 @@
+package subscription
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		retryPolicy *RetryPolicy
+		jitter      float64
+		delays      []int64
+	}{
+		{
+			name:        "Default",
+			retryPolicy: &RetryPolicy{Delay: 100},
+			delays:      []int64{100, 200, 400, 800},
+		},
+		{
+			name:        "Exponential",
+			retryPolicy: &RetryPolicy{Kind: Exponential, Delay: 100, MaxDelay: 500},
+			delays:      []int64{100, 200, 400, 500},
+		},
+		{
+			name:        "ExponentialJitter",
+			retryPolicy: &RetryPolicy{Kind: Exponential, Delay: 100, MaxDelay: 500, Jitter: true},
+			jitter:      0.5,
+			delays:      []int64{50, 100, 200, 250},
+		},
+		{
+			name:        "Linear",
+			retryPolicy: &RetryPolicy{Kind: Linear, Delay: 100},
+			delays:      []int64{100, 200, 300, 400},
+		},
+		{
+			name:        "Schedule",
+			retryPolicy: &RetryPolicy{Kind: Schedule, Schedule: []int64{10, 1000, 100}},
+			delays:      []int64{10, 1000, 100, 100},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			for i, delay := range tc.delays {
+				assert.Equal(t, delay, tc.retryPolicy.Backoff(int64(i), tc.jitter))
+			}
+		})
+	}
+
+	// delays saturate instead of overflowing
+	assert.Equal(t, int64(math.MaxInt64), (&RetryPolicy{Delay: 100}).Backoff(100, 0))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/resonatehq/resonate/internal/aio"
 	"github.com/resonatehq/resonate/internal/api"
 	"github.com/resonatehq/resonate/internal/app/coroutines"
+	"github.com/resonatehq/resonate/internal/app/events"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/network"
 	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/sqlite"
 	"github.com/resonatehq/resonate/internal/kernel/system"
@@ -29,6 +30,7 @@ func TestDST(t *testing.T) {
 		NotificationCacheSize: 100,
 		SubmissionBatchSize:   100,
 		CompletionBatchSize:   100,
+		Events:                events.New(),
 	}
 
 	// instatiate api/aio
@@ -69,7 +69,12 @@ func NewGenerator(r *rand.Rand, config *Config) *Generator {
 
 	urlSet := make([]string, config.Urls)
 	for i := 0; i < config.Urls; i++ {
-		urlSet[i] = fmt.Sprintf("https://resonatehq.io/%d", i)
+		// alternate between webhook and grpc subscriptions
+		if i%2 == 0 {
+			urlSet[i] = fmt.Sprintf("https://resonatehq.io/%d", i)
+		} else {
+			urlSet[i] = fmt.Sprintf("grpc://resonatehq.io:%d", i)
+		}
 	}
 
 	return &Generator{
@@ -148,13 +153,39 @@ func (g *Generator) GenerateSearchPromises(r *rand.Rand, t int64) *t_api.Request
 		}
 	}
 
+	// half of all searches filter by tags
+	var tags map[string]string
+	if r.Intn(2) == 0 {
+		tags = g.tagsSet[r.Intn(len(g.tagsSet))]
+	}
+
+	req := &t_api.SearchPromisesRequest{
+		Q:         query,
+		States:    states,
+		Tags:      tags,
+		SortBy:    promise.SortBy(r.Intn(3)),
+		SortOrder: promise.SortOrder(r.Intn(2)),
+		Limit:     limit,
+	}
+
+	// some searches filter by time
+	time := RangeInt63n(r, 0, g.ticks)
+	switch r.Intn(10) {
+	case 0:
+		req.CreatedAfter = &time
+	case 1:
+		req.CreatedBefore = &time
+	case 2:
+		req.CompletedAfter = &time
+	case 3:
+		req.CompletedBefore = &time
+	case 4:
+		req.TimeoutBefore = &time
+	}
+
 	return &t_api.Request{
-		Kind: t_api.SearchPromises,
-		SearchPromises: &t_api.SearchPromisesRequest{
-			Q:      query,
-			States: states,
-			Limit:  limit,
-		},
+		Kind:           t_api.SearchPromises,
+		SearchPromises: req,
 	}
 }
 
@@ -266,16 +297,30 @@ func (g *Generator) GenerateCreateSubscription(r *rand.Rand, t int64) *t_api.Req
 	delay := g.retrySet[r.Intn(len(g.retrySet))]
 	attempts := RangeIntn(r, 1, 4)
 
+	retryPolicy := &subscription.RetryPolicy{
+		Delay:    int64(delay),
+		Attempts: int64(attempts),
+	}
+
+	switch r.Intn(4) {
+	case 1:
+		retryPolicy.Kind = subscription.Linear
+	case 2:
+		retryPolicy.Kind = subscription.Exponential
+		retryPolicy.MaxDelay = int64(delay) * 2
+		retryPolicy.Jitter = true
+	case 3:
+		retryPolicy.Kind = subscription.Schedule
+		retryPolicy.Schedule = []int64{int64(delay), int64(delay) * 2}
+	}
+
 	return &t_api.Request{
 		Kind: t_api.CreateSubscription,
 		CreateSubscription: &t_api.CreateSubscriptionRequest{
-			Id:        id,
-			PromiseId: promiseId,
-			Url:       url,
-			RetryPolicy: &subscription.RetryPolicy{
-				Delay:    int64(delay),
-				Attempts: int64(attempts),
-			},
+			Id:          id,
+			PromiseId:   promiseId,
+			Url:         url,
+			RetryPolicy: retryPolicy,
 		},
 	}
 }
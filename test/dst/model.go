@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/util"
 	"github.com/resonatehq/resonate/pkg/promise"
 	"github.com/resonatehq/resonate/pkg/subscription"
 )
@@ -78,6 +79,10 @@ func (m *Model) Step(req *t_api.Request, res *t_api.Response, err error) error {
 			return nil
 		case "subsystem:store:sqlite submission queue full":
 			return nil
+		case "subsystem:store:memory submission queue full":
+			return nil
+		case "subsystem:store:bolt submission queue full":
+			return nil
 		case "subsystem:network:dst submission queue full":
 			return nil
 		default:
@@ -144,8 +149,16 @@ func (m *Model) ValidateSearchPromises(req *t_api.Request, res *t_api.Response)
 			if _, ok := states[p.State]; !ok {
 				return fmt.Errorf("unexpected state %s, searched for %s", p.State, req.SearchPromises.States)
 			}
-			if req.SearchPromises.SortId != nil && *req.SearchPromises.SortId <= p.SortId {
-				return fmt.Errorf("unexpected sortId, promise sortId %d is greater than the request sortId %d", *req.SearchPromises.SortId, p.SortId)
+			for _, tag := range util.OrderedRangeKV(req.SearchPromises.Tags) {
+				if v, ok := p.Tags[tag.Key]; !ok || v != tag.Value {
+					return fmt.Errorf("unexpected tags %s, searched for %s", p.Tags, req.SearchPromises.Tags)
+				}
+			}
+			if err := validateSearchPromisesTime(req.SearchPromises, p); err != nil {
+				return err
+			}
+			if err := validateSearchPromisesCursor(req.SearchPromises, p); err != nil {
+				return err
 			}
 			if pm.completed() && p.State == promise.Pending {
 				return fmt.Errorf("invalid state transition (%s -> %s)", pm.promise.State, p.State)
@@ -160,6 +173,61 @@ func (m *Model) ValidateSearchPromises(req *t_api.Request, res *t_api.Response)
 	}
 }
 
+func validateSearchPromisesTime(req *t_api.SearchPromisesRequest, p *promise.Promise) error {
+	if req.CreatedAfter != nil && (p.CreatedOn == nil || *p.CreatedOn < *req.CreatedAfter) {
+		return fmt.Errorf("unexpected createdOn, promise was created before %d", *req.CreatedAfter)
+	}
+	if req.CreatedBefore != nil && (p.CreatedOn == nil || *p.CreatedOn >= *req.CreatedBefore) {
+		return fmt.Errorf("unexpected createdOn, promise was not created before %d", *req.CreatedBefore)
+	}
+	if req.CompletedAfter != nil && (p.CompletedOn == nil || *p.CompletedOn < *req.CompletedAfter) {
+		return fmt.Errorf("unexpected completedOn, promise was not completed after %d", *req.CompletedAfter)
+	}
+	if req.CompletedBefore != nil && (p.CompletedOn == nil || *p.CompletedOn >= *req.CompletedBefore) {
+		return fmt.Errorf("unexpected completedOn, promise was not completed before %d", *req.CompletedBefore)
+	}
+	if req.TimeoutBefore != nil && p.Timeout >= *req.TimeoutBefore {
+		return fmt.Errorf("unexpected timeout %d, searched for timeout before %d", p.Timeout, *req.TimeoutBefore)
+	}
+	return nil
+}
+
+func validateSearchPromisesCursor(req *t_api.SearchPromisesRequest, p *promise.Promise) error {
+	if req.SortId == nil {
+		return nil
+	}
+
+	var value int64
+	switch req.SortBy {
+	case promise.SortBySortId:
+		value = p.SortId
+	case promise.SortByCreatedOn:
+		if p.CreatedOn != nil {
+			value = *p.CreatedOn
+		}
+	case promise.SortByTimeout:
+		value = p.Timeout
+	}
+
+	sortValue := *req.SortId
+	if req.SortValue != nil {
+		sortValue = *req.SortValue
+	}
+
+	var ok bool
+	switch req.SortOrder {
+	case promise.Descending:
+		ok = value < sortValue || (value == sortValue && p.SortId < *req.SortId)
+	case promise.Ascending:
+		ok = value > sortValue || (value == sortValue && p.SortId > *req.SortId)
+	}
+
+	if !ok {
+		return fmt.Errorf("unexpected promise (%s=%d, sortId=%d), expected promise after cursor (%s=%d, sortId=%d, order=%s)", req.SortBy, value, p.SortId, req.SortBy, sortValue, *req.SortId, req.SortOrder)
+	}
+	return nil
+}
+
 func (m *Model) ValidatCreatePromise(req *t_api.Request, res *t_api.Response) error {
 	pm := m.promises.Get(req.CreatePromise.Id)
 
@@ -0,0 +1,123 @@
+package system
+
+import (
+	"math/rand" // nosemgrep
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/resonatehq/resonate/internal/aio"
+	"github.com/resonatehq/resonate/internal/api"
+	"github.com/resonatehq/resonate/internal/app/coroutines"
+	"github.com/resonatehq/resonate/internal/app/events"
+	"github.com/resonatehq/resonate/internal/app/subsystems/aio/store/memory"
+	"github.com/resonatehq/resonate/internal/kernel/bus"
+	"github.com/resonatehq/resonate/internal/kernel/system"
+	"github.com/resonatehq/resonate/internal/kernel/t_aio"
+	"github.com/resonatehq/resonate/internal/kernel/t_api"
+	"github.com/resonatehq/resonate/internal/metrics"
+	"github.com/resonatehq/resonate/pkg/promise"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdempotencyKeyTTL(t *testing.T) {
+	metrics := metrics.New(prometheus.NewRegistry())
+
+	api := api.New(100, metrics)
+	aio := aio.NewDST(rand.New(rand.NewSource(0)), metrics)
+
+	store, err := memory.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	aio.AddSubsystem(t_aio.Store, store)
+
+	if err := api.Start(); err != nil {
+		t.Fatal(err)
+	}
+	if err := aio.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	config := &system.Config{
+		SubmissionBatchSize: 1,
+		CompletionBatchSize: 1,
+		IdempotencyKeyTTL:   10 * time.Millisecond,
+		Events:              events.New(),
+	}
+
+	system := system.New(api, aio, config, metrics)
+	system.AddOnRequest(t_api.CreatePromise, coroutines.CreatePromise)
+	system.AddOnRequest(t_api.ResolvePromise, coroutines.ResolvePromise)
+
+	create := &t_api.Request{
+		Kind: t_api.CreatePromise,
+		CreatePromise: &t_api.CreatePromiseRequest{
+			Id:             "foo",
+			IdempotencyKey: idempotencyKey("create"),
+			Timeout:        1000,
+		},
+	}
+
+	resolve := &t_api.Request{
+		Kind: t_api.ResolvePromise,
+		ResolvePromise: &t_api.ResolvePromiseRequest{
+			Id:             "foo",
+			IdempotencyKey: idempotencyKey("resolve"),
+		},
+	}
+
+	// the key of the create is stored at 0 and the key of the resolve
+	// at 20, both keys expire after 10 milliseconds
+	for _, tc := range []struct {
+		name   string
+		time   int64
+		req    *t_api.Request
+		status t_api.ResponseStatus
+	}{
+		{name: "Create", time: 0, req: create, status: t_api.ResponseCreated},
+		{name: "CreateInsideTTL", time: 9, req: create, status: t_api.ResponseOK},
+		{name: "CreatePastTTL", time: 10, req: create, status: t_api.ResponseForbidden},
+		{name: "Resolve", time: 20, req: resolve, status: t_api.ResponseCreated},
+		{name: "ResolveInsideTTL", time: 29, req: resolve, status: t_api.ResponseOK},
+		{name: "ResolvePastTTL", time: 30, req: resolve, status: t_api.ResponseForbidden},
+	} {
+		var res *t_api.Response
+
+		api.Enqueue(&bus.SQE[t_api.Request, t_api.Response]{
+			Tags:       "test",
+			Submission: tc.req,
+			Callback: func(r *t_api.Response, err error) {
+				assert.Nil(t, err)
+				res = r
+			},
+		})
+
+		// a request completes within a few ticks
+		for i := 0; i < 10 && res == nil; i++ {
+			system.Tick(tc.time, nil)
+		}
+
+		if res == nil {
+			t.Fatalf("%s: no response", tc.name)
+		}
+
+		switch res.Kind {
+		case t_api.CreatePromise:
+			assert.Equal(t, tc.status, res.CreatePromise.Status, tc.name)
+		case t_api.ResolvePromise:
+			assert.Equal(t, tc.status, res.ResolvePromise.Status, tc.name)
+		}
+	}
+
+	if err := api.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	if err := aio.Stop(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func idempotencyKey(key string) *promise.IdempotencyKey {
+	return (*promise.IdempotencyKey)(&key)
+}